@@ -2,13 +2,9 @@ package google
 
 import (
 	"fmt"
-	"log"
 	"regexp"
-	"strconv"
 	"time"
 
-	"github.com/hashicorp/terraform/helper/resource"
-
 	"google.golang.org/api/appengine/v1"
 )
 
@@ -18,66 +14,30 @@ var (
 
 type AppEngineOperationWaiter struct {
 	Service *appengine.APIService
-	Op      *appengine.Operation
 	AppId   string
+	CommonOperationWaiter
 }
 
-func (w *AppEngineOperationWaiter) RefreshFunc() resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		matches := appEngineOperationIdRegexp.FindStringSubmatch(w.Op.Name)
-		if len(matches) != 2 {
-			return nil, "", fmt.Errorf("Expected %d results of parsing operation name, got %d from %s", 2, len(matches), w.Op.Name)
-		}
-		op, err := w.Service.Apps.Operations.Get(w.AppId, matches[1]).Do()
-		if err != nil {
-			return nil, "", err
-		}
-
-		log.Printf("[DEBUG] Got %v when asking for operation %q", op.Done, w.Op.Name)
-		return op, strconv.FormatBool(op.Done), nil
-	}
-}
-
-func (w *AppEngineOperationWaiter) Conf() *resource.StateChangeConf {
-	return &resource.StateChangeConf{
-		Pending: []string{"false"},
-		Target:  []string{"true"},
-		Refresh: w.RefreshFunc(),
+func (w *AppEngineOperationWaiter) QueryOp() (interface{}, error) {
+	matches := appEngineOperationIdRegexp.FindStringSubmatch(w.Op.Name)
+	if len(matches) != 2 {
+		return nil, fmt.Errorf("Expected %d results of parsing operation name, got %d from %s", 2, len(matches), w.Op.Name)
 	}
+	return w.Service.Apps.Operations.Get(w.AppId, matches[1]).Do()
 }
 
-// AppEngineOperationError wraps appengine.Status and implements the
-// error interface so it can be returned.
-type AppEngineOperationError appengine.Status
-
-func (e AppEngineOperationError) Error() string {
-	return e.Message
+func appEngineOperationWait(config *Config, op *appengine.Operation, appId, activity string) error {
+	return appEngineOperationWaitTime(config, op, appId, activity, 4*time.Minute)
 }
 
-func appEngineOperationWait(client *appengine.APIService, op *appengine.Operation, appId, activity string) error {
-	return appEngineOperationWaitTime(client, op, appId, activity, 4)
-}
-
-func appEngineOperationWaitTime(client *appengine.APIService, op *appengine.Operation, appId, activity string, timeoutMin int) error {
+func appEngineOperationWaitTime(config *Config, op *appengine.Operation, appId, activity string, timeout time.Duration) error {
 	w := &AppEngineOperationWaiter{
-		Service: client,
-		Op:      op,
+		Service: config.clientAppEngine,
 		AppId:   appId,
 	}
-
-	state := w.Conf()
-	state.Delay = 10 * time.Second
-	state.Timeout = time.Duration(timeoutMin) * time.Minute
-	state.MinTimeout = 2 * time.Second
-	opRaw, err := state.WaitForState()
-	if err != nil {
-		return fmt.Errorf("Error waiting for %s: %s", activity, err)
-	}
-
-	resultOp := opRaw.(*appengine.Operation)
-	if resultOp.Error != nil {
-		return AppEngineOperationError(*resultOp.Error)
+	if err := w.SetOp(op); err != nil {
+		return err
 	}
 
-	return nil
+	return OperationWait(config, w, activity, timeout)
 }
@@ -11,12 +11,23 @@ import (
 type BigtableClientFactory struct {
 	UserAgent   string
 	TokenSource oauth2.TokenSource
+
+	// Endpoint overrides the gRPC address of the Bigtable admin API if set.
+	Endpoint string
 }
 
 func (s BigtableClientFactory) NewInstanceAdminClient(project string) (*bigtable.InstanceAdminClient, error) {
-	return bigtable.NewInstanceAdminClient(context.Background(), project, option.WithTokenSource(s.TokenSource), option.WithUserAgent(s.UserAgent))
+	return bigtable.NewInstanceAdminClient(context.Background(), project, s.clientOptions()...)
 }
 
 func (s BigtableClientFactory) NewAdminClient(project, instance string) (*bigtable.AdminClient, error) {
-	return bigtable.NewAdminClient(context.Background(), project, instance, option.WithTokenSource(s.TokenSource), option.WithUserAgent(s.UserAgent))
+	return bigtable.NewAdminClient(context.Background(), project, instance, s.clientOptions()...)
+}
+
+func (s BigtableClientFactory) clientOptions() []option.ClientOption {
+	opts := []option.ClientOption{option.WithTokenSource(s.TokenSource), option.WithUserAgent(s.UserAgent)}
+	if s.Endpoint != "" {
+		opts = append(opts, option.WithEndpoint(s.Endpoint))
+	}
+	return opts
 }
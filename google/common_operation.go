@@ -0,0 +1,178 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"google.golang.org/api/googleapi"
+)
+
+// The shortest interval between two polls of an operation. Polls start at
+// this interval and back off exponentially up to 10 seconds.
+const operationPollMinInterval = 2 * time.Second
+
+// OperationWaiter is implemented for the long-running operations of each API
+// so that OperationWait can poll them. QueryOp fetches the latest version of
+// the operation, and Error extracts the error it finished with.
+type OperationWaiter interface {
+	// QueryOp fetches the operation from the API.
+	QueryOp() (interface{}, error)
+	// SetOp records the operation returned by QueryOp, or by the API call
+	// that started it.
+	SetOp(op interface{}) error
+	// State returns the state of the recorded operation, one of
+	// PendingStates or TargetStates.
+	State() string
+	PendingStates() []string
+	TargetStates() []string
+	// Error returns the error the recorded operation finished with, if any.
+	Error() error
+	OpName() string
+	// Progress returns the completion of the recorded operation as a
+	// percentage, or -1 if the API doesn't report it.
+	Progress() int
+}
+
+// OperationError is returned when waiting for an operation fails, either
+// because the operation itself failed or because it could not be polled.
+type OperationError struct {
+	Activity string
+	OpName   string
+	// Progress is the last completion percentage reported for the
+	// operation, or -1 if unknown.
+	Progress int
+	Err      error
+}
+
+func (e *OperationError) Error() string {
+	return fmt.Sprintf("Error waiting for %s: %s", e.Activity, e.Err)
+}
+
+// WrappedErrors implements errwrap.Wrapper so that the errors returned by
+// each API can be recovered with errwrap.GetType.
+func (e *OperationError) WrappedErrors() []error {
+	return []error{e.Err}
+}
+
+func newOperationError(w OperationWaiter, activity string, err error) *OperationError {
+	opErr := &OperationError{
+		Activity: activity,
+		OpName:   w.OpName(),
+		Progress: w.Progress(),
+		Err:      err,
+	}
+	log.Printf("[DEBUG] Operation %q for %s failed at %d%% progress: %s", opErr.OpName, activity, opErr.Progress, err)
+	return opErr
+}
+
+func operationRefreshFunc(w OperationWaiter, activity string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		op, err := w.QueryOp()
+		if err != nil {
+			return nil, "", fmt.Errorf("error while retrieving operation: %s", err)
+		}
+		if err := w.SetOp(op); err != nil {
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] Operation %q for %s is %s (%d%% progress)", w.OpName(), activity, w.State(), w.Progress())
+		return op, w.State(), nil
+	}
+}
+
+// OperationWait polls the operation recorded by w until it reaches one of its
+// target states and returns the error it finished with, if any. Waiting stops
+// once timeout elapses, usually the schema.ResourceTimeout of the resource, or
+// when the provider is stopped.
+func OperationWait(config *Config, w OperationWaiter, activity string, timeout time.Duration) error {
+	state := &resource.StateChangeConf{
+		Pending:    w.PendingStates(),
+		Target:     w.TargetStates(),
+		Refresh:    operationRefreshFunc(w, activity),
+		Timeout:    timeout,
+		MinTimeout: operationPollMinInterval,
+	}
+	if vcr != nil && vcr.replaying() {
+		// Replayed polls get their recorded responses, so there is no point
+		// in waiting between them.
+		state.MinTimeout = 0
+	}
+	if _, err := waitForStateContext(config.stopContext(), state); err != nil {
+		return newOperationError(w, activity, err)
+	}
+
+	if err := w.Error(); err != nil {
+		return newOperationError(w, activity, err)
+	}
+
+	return nil
+}
+
+// CommonOperation holds the fields of google.longrunning.Operation, which
+// most APIs use for their long-running operations.
+type CommonOperation struct {
+	Name     string                 `json:"name,omitempty"`
+	Done     bool                   `json:"done,omitempty"`
+	Error    *CommonOperationStatus `json:"error,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	Response googleapi.RawMessage   `json:"response,omitempty"`
+}
+
+type CommonOperationStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// CommonOperationWaiter implements OperationWaiter, except for QueryOp, for
+// operations following google.longrunning.Operation. Waiters for those APIs
+// embed it and fetch the operation with their own client.
+type CommonOperationWaiter struct {
+	Op CommonOperation
+}
+
+func (w *CommonOperationWaiter) SetOp(op interface{}) error {
+	var commonOp CommonOperation
+	if err := Convert(op, &commonOp); err != nil {
+		return err
+	}
+	w.Op = commonOp
+	return nil
+}
+
+func (w *CommonOperationWaiter) State() string {
+	return fmt.Sprint(w.Op.Done)
+}
+
+func (w *CommonOperationWaiter) PendingStates() []string {
+	return []string{"false"}
+}
+
+func (w *CommonOperationWaiter) TargetStates() []string {
+	return []string{"true"}
+}
+
+func (w *CommonOperationWaiter) Error() error {
+	if w.Op.Error != nil {
+		return fmt.Errorf("Error code %v, message: %s", w.Op.Error.Code, w.Op.Error.Message)
+	}
+	return nil
+}
+
+func (w *CommonOperationWaiter) OpName() string {
+	return w.Op.Name
+}
+
+// Progress reads the progressPercent that some APIs report in the metadata
+// of their operations, either directly or in a progress message.
+func (w *CommonOperationWaiter) Progress() int {
+	metadata := w.Op.Metadata
+	if progress, ok := metadata["progress"].(map[string]interface{}); ok {
+		metadata = progress
+	}
+	if percent, ok := metadata["progressPercent"].(float64); ok {
+		return int(percent)
+	}
+	return -1
+}
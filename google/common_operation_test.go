@@ -0,0 +1,135 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/errwrap"
+)
+
+type testOperationError struct {
+	Code string
+}
+
+func (e testOperationError) Error() string {
+	return e.Code
+}
+
+// testOperationWaiter reports the states in polls, one per query.
+type testOperationWaiter struct {
+	polls []string
+	state string
+	err   error
+}
+
+func (w *testOperationWaiter) QueryOp() (interface{}, error) {
+	if len(w.polls) == 0 {
+		return nil, fmt.Errorf("operation not found")
+	}
+	state := w.polls[0]
+	w.polls = w.polls[1:]
+	return state, nil
+}
+
+func (w *testOperationWaiter) SetOp(op interface{}) error {
+	w.state = op.(string)
+	return nil
+}
+
+func (w *testOperationWaiter) State() string {
+	return w.state
+}
+
+func (w *testOperationWaiter) PendingStates() []string {
+	return []string{"RUNNING"}
+}
+
+func (w *testOperationWaiter) TargetStates() []string {
+	return []string{"DONE"}
+}
+
+func (w *testOperationWaiter) Error() error {
+	if w.state == "DONE" {
+		return w.err
+	}
+	return nil
+}
+
+func (w *testOperationWaiter) OpName() string {
+	return "operation-1234"
+}
+
+func (w *testOperationWaiter) Progress() int {
+	if w.state == "DONE" {
+		return 100
+	}
+	return 50
+}
+
+func TestOperationWait(t *testing.T) {
+	config := &Config{context: context.Background()}
+
+	w := &testOperationWaiter{polls: []string{"RUNNING", "DONE"}}
+	if err := OperationWait(config, w, "test operation", time.Minute); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	w = &testOperationWaiter{
+		polls: []string{"DONE"},
+		err:   testOperationError{Code: "RESOURCE_NOT_FOUND"},
+	}
+	err := OperationWait(config, w, "test operation", time.Minute)
+	opErr, ok := err.(*OperationError)
+	if !ok {
+		t.Fatalf("expected an *OperationError, got %#v", err)
+	}
+	if opErr.OpName != "operation-1234" || opErr.Progress != 100 {
+		t.Errorf("expected the error to describe the operation, got %#v", opErr)
+	}
+	if _, ok := errwrap.GetType(err, testOperationError{}).(testOperationError); !ok {
+		t.Errorf("expected the error of the operation to be recoverable, got %#v", err)
+	}
+
+	w = &testOperationWaiter{}
+	if err := OperationWait(config, w, "test operation", time.Minute); err == nil {
+		t.Errorf("expected an error when the operation can't be queried")
+	}
+}
+
+func TestCommonOperationWaiter(t *testing.T) {
+	w := &CommonOperationWaiter{}
+	err := w.SetOp(map[string]interface{}{
+		"name": "operations/1234",
+		"metadata": map[string]interface{}{
+			"progress": map[string]interface{}{
+				"progressPercent": 40,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+	if w.OpName() != "operations/1234" || w.State() != "false" || w.Progress() != 40 || w.Error() != nil {
+		t.Errorf("unexpected pending operation %#v", w.Op)
+	}
+
+	err = w.SetOp(map[string]interface{}{
+		"name": "operations/1234",
+		"done": true,
+		"error": map[string]interface{}{
+			"code":    9,
+			"message": "failed precondition",
+		},
+	})
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+	if w.State() != "true" || w.Progress() != -1 {
+		t.Errorf("unexpected finished operation %#v", w.Op)
+	}
+	if err := w.Error(); err == nil || err.Error() != "Error code 9, message: failed precondition" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
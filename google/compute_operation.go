@@ -3,11 +3,8 @@ package google
 import (
 	"bytes"
 	"fmt"
-	"log"
 	"time"
 
-	"github.com/hashicorp/terraform/helper/resource"
-
 	computeBeta "google.golang.org/api/compute/v0.beta"
 	"google.golang.org/api/compute/v1"
 )
@@ -18,35 +15,51 @@ type ComputeOperationWaiter struct {
 	Project string
 }
 
-func (w *ComputeOperationWaiter) RefreshFunc() resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		var op *compute.Operation
-		var err error
-
-		if w.Op.Zone != "" {
-			zone := GetResourceNameFromSelfLink(w.Op.Zone)
-			op, err = w.Service.ZoneOperations.Get(w.Project, zone, w.Op.Name).Do()
-		} else if w.Op.Region != "" {
-			region := GetResourceNameFromSelfLink(w.Op.Region)
-			op, err = w.Service.RegionOperations.Get(w.Project, region, w.Op.Name).Do()
-		} else {
-			op, err = w.Service.GlobalOperations.Get(w.Project, w.Op.Name).Do()
-		}
-		if err != nil {
-			return nil, "", err
-		}
-
-		log.Printf("[DEBUG] Got %q when asking for operation %q", op.Status, w.Op.Name)
-		return op, op.Status, nil
+func (w *ComputeOperationWaiter) QueryOp() (interface{}, error) {
+	if w.Op.Zone != "" {
+		zone := GetResourceNameFromSelfLink(w.Op.Zone)
+		return w.Service.ZoneOperations.Get(w.Project, zone, w.Op.Name).Do()
+	} else if w.Op.Region != "" {
+		region := GetResourceNameFromSelfLink(w.Op.Region)
+		return w.Service.RegionOperations.Get(w.Project, region, w.Op.Name).Do()
+	}
+	return w.Service.GlobalOperations.Get(w.Project, w.Op.Name).Do()
+}
+
+func (w *ComputeOperationWaiter) SetOp(op interface{}) error {
+	var ok bool
+	w.Op, ok = op.(*compute.Operation)
+	if !ok {
+		return fmt.Errorf("Expected a *compute.Operation, got %T", op)
 	}
+	return nil
+}
+
+func (w *ComputeOperationWaiter) State() string {
+	return w.Op.Status
+}
+
+func (w *ComputeOperationWaiter) PendingStates() []string {
+	return []string{"PENDING", "RUNNING"}
+}
+
+func (w *ComputeOperationWaiter) TargetStates() []string {
+	return []string{"DONE"}
 }
 
-func (w *ComputeOperationWaiter) Conf() *resource.StateChangeConf {
-	return &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING"},
-		Target:  []string{"DONE"},
-		Refresh: w.RefreshFunc(),
+func (w *ComputeOperationWaiter) Error() error {
+	if w.Op.Error != nil {
+		return ComputeOperationError(*w.Op.Error)
 	}
+	return nil
+}
+
+func (w *ComputeOperationWaiter) OpName() string {
+	return w.Op.Name
+}
+
+func (w *ComputeOperationWaiter) Progress() int {
+	return int(w.Op.Progress)
 }
 
 // ComputeOperationError wraps compute.OperationError and implements the
@@ -62,40 +75,26 @@ func (e ComputeOperationError) Error() string {
 	return buf.String()
 }
 
-func computeOperationWait(client *compute.Service, op *compute.Operation, project, activity string) error {
-	return computeOperationWaitTime(client, op, project, activity, 4)
+func computeOperationWait(config *Config, op *compute.Operation, project, activity string) error {
+	return computeOperationWaitTime(config, op, project, activity, 4*time.Minute)
 }
 
-func computeOperationWaitTime(client *compute.Service, op *compute.Operation, project, activity string, timeoutMin int) error {
+func computeOperationWaitTime(config *Config, op *compute.Operation, project, activity string, timeout time.Duration) error {
 	w := &ComputeOperationWaiter{
-		Service: client,
+		Service: config.clientCompute,
 		Op:      op,
 		Project: project,
 	}
 
-	state := w.Conf()
-	state.Delay = 10 * time.Second
-	state.Timeout = time.Duration(timeoutMin) * time.Minute
-	state.MinTimeout = 2 * time.Second
-	opRaw, err := state.WaitForState()
-	if err != nil {
-		return fmt.Errorf("Error waiting for %s: %s", activity, err)
-	}
-
-	resultOp := opRaw.(*compute.Operation)
-	if resultOp.Error != nil {
-		return ComputeOperationError(*resultOp.Error)
-	}
-
-	return nil
+	return OperationWait(config, w, activity, timeout)
 }
 
-func computeBetaOperationWaitTime(client *compute.Service, op *computeBeta.Operation, project, activity string, timeoutMin int) error {
+func computeBetaOperationWaitTime(config *Config, op *computeBeta.Operation, project, activity string, timeout time.Duration) error {
 	opV1 := &compute.Operation{}
 	err := Convert(op, opV1)
 	if err != nil {
 		return err
 	}
 
-	return computeOperationWaitTime(client, opV1, project, activity, timeoutMin)
+	return computeOperationWaitTime(config, opV1, project, activity, timeout)
 }
@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"math"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/logging"
 	"github.com/hashicorp/terraform/helper/pathorcontents"
@@ -47,10 +49,29 @@ import (
 // Config is the configuration structure used to instantiate the Google
 // provider.
 type Config struct {
-	Credentials string
-	Project     string
-	Region      string
-	Zone        string
+	Credentials                        string
+	AccessToken                        string
+	Scopes                             []string
+	ImpersonateServiceAccount          string
+	ImpersonateServiceAccountDelegates []string
+	Project                            string
+	Region                             string
+	Zone                               string
+	DefaultLabels                      map[string]string
+	UserProjectOverride                bool
+	BillingProject                     string
+	RequestTimeout                     time.Duration
+	MaxRetries                         int
+	RequestRateLimits                  map[string]float64
+	CustomEndpoints                    map[string]string
+	BigtableCustomEndpoint             string
+
+	// How long modifications of the same IAM policy are collected before
+	// being applied together. Zero disables batching.
+	BatchingSendAfter time.Duration
+
+	// Cancelled when Terraform stops the provider, e.g. on interrupt.
+	context context.Context
 
 	client    *http.Client
 	userAgent string
@@ -86,67 +107,105 @@ type Config struct {
 	clientAppEngine              *appengine.APIService
 
 	bigtableClientFactory *BigtableClientFactory
+
+	iamBatcher *iamPolicyBatcher
+	// Paces the reads checking that changes of IAM policies have propagated,
+	// across every IAM resource.
+	iamPropagationLimiter *rateLimiter
+}
+
+// The scope of the access tokens needed to identify the account the provider
+// authenticates as, see iamCallerMember.
+const userInfoEmailScope = "https://www.googleapis.com/auth/userinfo.email"
+
+// The OAuth scopes requested when none are set in the provider configuration.
+var defaultClientScopes = []string{
+	"https://www.googleapis.com/auth/compute",
+	"https://www.googleapis.com/auth/cloud-platform",
+	"https://www.googleapis.com/auth/ndev.clouddns.readwrite",
+	"https://www.googleapis.com/auth/devstorage.full_control",
+	userInfoEmailScope,
 }
 
+// Credential file types accepted in the `credentials` provider argument.
+const (
+	serviceAccountCredentialsType  = "service_account"
+	authorizedUserCredentialsType  = "authorized_user"
+	externalAccountCredentialsType = "external_account"
+)
+
 func (c *Config) loadAndValidate() error {
-	var account accountFile
-	clientScopes := []string{
-		"https://www.googleapis.com/auth/compute",
-		"https://www.googleapis.com/auth/cloud-platform",
-		"https://www.googleapis.com/auth/ndev.clouddns.readwrite",
-		"https://www.googleapis.com/auth/devstorage.full_control",
+	clientScopes := c.Scopes
+	if len(clientScopes) == 0 {
+		clientScopes = defaultClientScopes
+	} else if c.AccessToken == "" && c.ImpersonateServiceAccount == "" && !containsString(clientScopes, userInfoEmailScope) {
+		// The authoritative IAM policy resources identify the caller by its
+		// access token, so that it can't lock itself out of their policy.
+		return fmt.Errorf("`scopes` must include %s, which identifies the account the provider authenticates as", userInfoEmailScope)
 	}
 
-	var client *http.Client
 	var tokenSource oauth2.TokenSource
-
-	if c.Credentials != "" {
-		contents, _, err := pathorcontents.Read(c.Credentials)
+	var err error
+	if vcr != nil && vcr.replaying() {
+		// Replayed tests run without credentials.
+		tokenSource = oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "vcr"})
+	} else {
+		tokenSource, err = c.getTokenSource(clientScopes)
 		if err != nil {
-			return fmt.Errorf("Error loading credentials: %s", err)
-		}
-
-		// Assume account_file is a JSON string
-		if err := parseJSON(&account, contents); err != nil {
-			return fmt.Errorf("Error parsing credentials '%s': %s", contents, err)
+			return err
 		}
+	}
+	c.tokenSource = tokenSource
 
-		// Get the token for use in our requests
-		log.Printf("[INFO] Requesting Google token...")
-		log.Printf("[INFO]   -- Email: %s", account.ClientEmail)
-		log.Printf("[INFO]   -- Scopes: %s", clientScopes)
-		log.Printf("[INFO]   -- Private Key Length: %d", len(account.PrivateKey))
+	client := oauth2.NewClient(context.Background(), tokenSource)
 
-		conf := jwt.Config{
-			Email:      account.ClientEmail,
-			PrivateKey: []byte(account.PrivateKey),
-			Scopes:     clientScopes,
-			TokenURL:   "https://accounts.google.com/o/oauth2/token",
+	// Acceptance tests record or replay every request sent by the clients
+	// created below.
+	if vcr != nil {
+		client.Transport = &vcrTransport{
+			recorder: vcr,
+			base:     client.Transport,
 		}
+	}
 
-		// Initiate an http.Client. The following GET request will be
-		// authorized and authenticated on the behalf of
-		// your service account.
-		client = conf.Client(context.Background())
+	client.Transport = logging.NewTransport("Google", client.Transport)
 
-		tokenSource = conf.TokenSource(context.Background())
-	} else {
-		log.Printf("[INFO] Authenticating using DefaultClient")
-		err := error(nil)
-		client, err = google.DefaultClient(context.Background(), clientScopes...)
-		if err != nil {
-			return err
-		}
+	// Rate limits are applied to each attempt of a request, retries included.
+	limiters, err := c.requestRateLimiters()
+	if err != nil {
+		return err
+	}
+	if len(limiters) > 0 {
+		client.Transport = newRateLimitTransport(client.Transport, limiters)
+	}
 
-		tokenSource, err = google.DefaultTokenSource(context.Background(), clientScopes...)
-		if err != nil {
-			return err
-		}
+	// IAM propagation checks share the rate limit of the Resource Manager
+	// API if one is configured, on top of the token taken by each of their
+	// requests, so that they use at most half of it.
+	c.iamPropagationLimiter = limiters[rateLimitPrefix(c.basePath(ResourceManagerBasePathKey))]
+	if c.iamPropagationLimiter == nil {
+		c.iamPropagationLimiter = newRateLimiter(iamPropagationCheckQps, 1)
 	}
 
-	c.tokenSource = tokenSource
+	// Transient errors are retried for every client created below, and the
+	// request timeout bounds each API call including its retries.
+	client.Transport = newRetryTransport(client.Transport, c.MaxRetries)
+	client.Timeout = c.RequestTimeout
+
+	// The billing project applies to every client created below, which
+	// otherwise bill the provider project. Requests sent through sendRequest
+	// fall back to the project of the resource instead.
+	if userProject := c.userProject(c.Project); userProject != "" {
+		client.Transport = &userProjectTransport{
+			project: userProject,
+			base:    client.Transport,
+		}
+	}
 
-	client.Transport = logging.NewTransport("Google", client.Transport)
+	client.Transport = &stopContextTransport{
+		ctx:  c.stopContext(),
+		base: client.Transport,
+	}
 
 	projectURL := "https://www.terraform.io"
 	userAgent := fmt.Sprintf("Terraform/%s (+%s)",
@@ -155,14 +214,13 @@ func (c *Config) loadAndValidate() error {
 	c.client = client
 	c.userAgent = userAgent
 
-	var err error
-
 	log.Printf("[INFO] Instantiating GCE client...")
 	c.clientCompute, err = compute.New(client)
 	if err != nil {
 		return err
 	}
 	c.clientCompute.UserAgent = userAgent
+	c.clientCompute.BasePath = c.clientBasePath(ComputeBasePathKey)
 
 	log.Printf("[INFO] Instantiating GCE Beta client...")
 	c.clientComputeBeta, err = computeBeta.New(client)
@@ -170,6 +228,7 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientComputeBeta.UserAgent = userAgent
+	c.clientComputeBeta.BasePath = c.clientBasePath(ComputeBetaBasePathKey)
 
 	log.Printf("[INFO] Instantiating GKE client...")
 	c.clientContainer, err = container.New(client)
@@ -177,6 +236,7 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientContainer.UserAgent = userAgent
+	c.clientContainer.BasePath = c.clientBasePath(ContainerBasePathKey)
 
 	log.Printf("[INFO] Instantiating GKE Beta client...")
 	c.clientContainerBeta, err = containerBeta.New(client)
@@ -184,6 +244,7 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientContainerBeta.UserAgent = userAgent
+	c.clientContainerBeta.BasePath = c.clientBasePath(ContainerBetaBasePathKey)
 
 	log.Printf("[INFO] Instantiating Google Cloud DNS client...")
 	c.clientDns, err = dns.New(client)
@@ -191,6 +252,7 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientDns.UserAgent = userAgent
+	c.clientDns.BasePath = c.clientBasePath(DnsBasePathKey)
 
 	log.Printf("[INFO] Instantiating Google Cloud KMS Client...")
 	c.clientKms, err = cloudkms.New(client)
@@ -198,6 +260,7 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientKms.UserAgent = userAgent
+	c.clientKms.BasePath = c.clientBasePath(KmsBasePathKey)
 
 	log.Printf("[INFO] Instantiating Google Stackdriver Logging client...")
 	c.clientLogging, err = cloudlogging.New(client)
@@ -205,6 +268,7 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientLogging.UserAgent = userAgent
+	c.clientLogging.BasePath = c.clientBasePath(LoggingBasePathKey)
 
 	log.Printf("[INFO] Instantiating Google Storage Client...")
 	c.clientStorage, err = storage.New(client)
@@ -212,6 +276,7 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientStorage.UserAgent = userAgent
+	c.clientStorage.BasePath = c.clientBasePath(StorageBasePathKey)
 
 	log.Printf("[INFO] Instantiating Google SqlAdmin Client...")
 	c.clientSqlAdmin, err = sqladmin.New(client)
@@ -219,6 +284,7 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientSqlAdmin.UserAgent = userAgent
+	c.clientSqlAdmin.BasePath = c.clientBasePath(SqlBasePathKey)
 
 	log.Printf("[INFO] Instantiating Google Pubsub Client...")
 	c.clientPubsub, err = pubsub.New(client)
@@ -226,6 +292,7 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientPubsub.UserAgent = userAgent
+	c.clientPubsub.BasePath = c.clientBasePath(PubsubBasePathKey)
 
 	log.Printf("[INFO] Instantiating Google Dataflow Client...")
 	c.clientDataflow, err = dataflow.New(client)
@@ -233,6 +300,7 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientDataflow.UserAgent = userAgent
+	c.clientDataflow.BasePath = c.clientBasePath(DataflowBasePathKey)
 
 	log.Printf("[INFO] Instantiating Google Cloud Redis Client...")
 	c.clientRedis, err = redis.New(client)
@@ -240,6 +308,7 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientRedis.UserAgent = userAgent
+	c.clientRedis.BasePath = c.clientBasePath(RedisBasePathKey)
 
 	log.Printf("[INFO] Instantiating Google Cloud ResourceManager Client...")
 	c.clientResourceManager, err = cloudresourcemanager.New(client)
@@ -247,6 +316,7 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientResourceManager.UserAgent = userAgent
+	c.clientResourceManager.BasePath = c.clientBasePath(ResourceManagerBasePathKey)
 
 	log.Printf("[INFO] Instantiating Google Cloud ResourceManager V Client...")
 	c.clientResourceManagerV2Beta1, err = resourceManagerV2Beta1.New(client)
@@ -254,6 +324,7 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientResourceManagerV2Beta1.UserAgent = userAgent
+	c.clientResourceManagerV2Beta1.BasePath = c.clientBasePath(ResourceManagerV2Beta1BasePathKey)
 
 	log.Printf("[INFO] Instantiating Google Cloud Runtimeconfig Client...")
 	c.clientRuntimeconfig, err = runtimeconfig.New(client)
@@ -261,6 +332,7 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientRuntimeconfig.UserAgent = userAgent
+	c.clientRuntimeconfig.BasePath = c.clientBasePath(RuntimeconfigBasePathKey)
 
 	log.Printf("[INFO] Instantiating Google Cloud IAM Client...")
 	c.clientIAM, err = iam.New(client)
@@ -268,6 +340,7 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientIAM.UserAgent = userAgent
+	c.clientIAM.BasePath = c.clientBasePath(IamBasePathKey)
 
 	log.Printf("[INFO] Instantiating Google Cloud Service Management Client...")
 	c.clientServiceMan, err = servicemanagement.New(client)
@@ -275,6 +348,7 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientServiceMan.UserAgent = userAgent
+	c.clientServiceMan.BasePath = c.clientBasePath(ServiceManagementBasePathKey)
 
 	log.Printf("[INFO] Instantiating Google Cloud Service Usage Client...")
 	c.clientServiceUsage, err = serviceusage.New(client)
@@ -282,6 +356,7 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientServiceUsage.UserAgent = userAgent
+	c.clientServiceUsage.BasePath = c.clientBasePath(ServiceUsageBasePathKey)
 
 	log.Printf("[INFO] Instantiating Google Cloud Billing Client...")
 	c.clientBilling, err = cloudbilling.New(client)
@@ -289,6 +364,7 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientBilling.UserAgent = userAgent
+	c.clientBilling.BasePath = c.clientBasePath(CloudBillingBasePathKey)
 
 	log.Printf("[INFO] Instantiating Google Cloud Build Client...")
 	c.clientBuild, err = cloudbuild.New(client)
@@ -296,6 +372,7 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientBuild.UserAgent = userAgent
+	c.clientBuild.BasePath = c.clientBasePath(CloudBuildBasePathKey)
 
 	log.Printf("[INFO] Instantiating Google Cloud BigQuery Client...")
 	c.clientBigQuery, err = bigquery.New(client)
@@ -303,6 +380,7 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientBigQuery.UserAgent = userAgent
+	c.clientBigQuery.BasePath = c.clientBasePath(BigQueryBasePathKey)
 
 	log.Printf("[INFO] Instantiating Google Cloud CloudFunctions Client...")
 	c.clientCloudFunctions, err = cloudfunctions.New(client)
@@ -310,10 +388,16 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientCloudFunctions.UserAgent = userAgent
+	c.clientCloudFunctions.BasePath = c.clientBasePath(CloudFunctionsBasePathKey)
 
 	c.bigtableClientFactory = &BigtableClientFactory{
 		UserAgent:   userAgent,
 		TokenSource: tokenSource,
+		Endpoint:    c.BigtableCustomEndpoint,
+	}
+
+	if c.BatchingSendAfter > 0 {
+		c.iamBatcher = newIamPolicyBatcher(c.BatchingSendAfter)
 	}
 
 	log.Printf("[INFO] Instantiating Google Cloud Source Repo Client...")
@@ -322,6 +406,7 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientSourceRepo.UserAgent = userAgent
+	c.clientSourceRepo.BasePath = c.clientBasePath(SourceRepoBasePathKey)
 
 	log.Printf("[INFO] Instantiating Google Cloud Spanner Client...")
 	c.clientSpanner, err = spanner.New(client)
@@ -329,6 +414,7 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientSpanner.UserAgent = userAgent
+	c.clientSpanner.BasePath = c.clientBasePath(SpannerBasePathKey)
 
 	log.Printf("[INFO] Instantiating Google Cloud Dataproc Client...")
 	c.clientDataproc, err = dataproc.New(client)
@@ -336,6 +422,7 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientDataproc.UserAgent = userAgent
+	c.clientDataproc.BasePath = c.clientBasePath(DataprocBasePathKey)
 
 	log.Printf("[INFO] Instantiating Google Cloud IoT Core Client...")
 	c.clientCloudIoT, err = cloudiot.New(client)
@@ -343,6 +430,7 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientCloudIoT.UserAgent = userAgent
+	c.clientCloudIoT.BasePath = c.clientBasePath(CloudIoTBasePathKey)
 
 	log.Printf("[INFO] Instantiating App Engine Client...")
 	c.clientAppEngine, err = appengine.New(client)
@@ -350,16 +438,210 @@ func (c *Config) loadAndValidate() error {
 		return err
 	}
 	c.clientAppEngine.UserAgent = userAgent
+	c.clientAppEngine.BasePath = c.clientBasePath(AppEngineBasePathKey)
 
 	return nil
 }
 
+// getTokenSource returns the oauth2.TokenSource used to authenticate every
+// client. A raw access token takes precedence over a credentials file, which
+// in turn takes precedence over the Application Default Credentials. If a
+// service account to impersonate is configured, the resulting token source is
+// only used to request short-lived tokens for that account.
+func (c *Config) getTokenSource(clientScopes []string) (oauth2.TokenSource, error) {
+	tokenSource, err := c.getBaseTokenSource(clientScopes)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.ImpersonateServiceAccount == "" {
+		return tokenSource, nil
+	}
+
+	log.Printf("[INFO] Impersonating service account %s...", c.ImpersonateServiceAccount)
+	log.Printf("[INFO]   -- Delegates: %s", c.ImpersonateServiceAccountDelegates)
+	return newImpersonatedTokenSource(tokenSource, c.basePath(IamCredentialsBasePathKey), c.ImpersonateServiceAccount, c.ImpersonateServiceAccountDelegates, clientScopes), nil
+}
+
+func (c *Config) getBaseTokenSource(clientScopes []string) (oauth2.TokenSource, error) {
+	if c.AccessToken != "" {
+		contents, _, err := pathorcontents.Read(c.AccessToken)
+		if err != nil {
+			return nil, fmt.Errorf("Error loading access token: %s", err)
+		}
+
+		log.Printf("[INFO] Authenticating using configured Google JSON 'access_token'...")
+		log.Printf("[INFO]   -- Scopes: %s", clientScopes)
+		token := &oauth2.Token{AccessToken: strings.TrimSpace(contents)}
+		return oauth2.StaticTokenSource(token), nil
+	}
+
+	if c.Credentials != "" {
+		contents, _, err := pathorcontents.Read(c.Credentials)
+		if err != nil {
+			return nil, fmt.Errorf("Error loading credentials: %s", err)
+		}
+
+		// Assume account_file is a JSON string
+		var account accountFile
+		if err := parseJSON(&account, contents); err != nil {
+			return nil, fmt.Errorf("Error parsing credentials '%s': %s", contents, err)
+		}
+
+		return account.tokenSource(clientScopes)
+	}
+
+	log.Printf("[INFO] Authenticating using DefaultClient")
+	log.Printf("[INFO]   -- Scopes: %s", clientScopes)
+	return google.DefaultTokenSource(context.Background(), clientScopes...)
+}
+
+// userProject returns the project to bill for quota on requests made on
+// behalf of a resource of the given project, or an empty string if the API
+// should pick the project itself.
+func (c *Config) userProject(resourceProject string) string {
+	if !c.UserProjectOverride {
+		return ""
+	}
+	if c.BillingProject != "" {
+		return c.BillingProject
+	}
+	return resourceProject
+}
+
+// stopContext returns a context cancelled when Terraform stops the provider.
+func (c *Config) stopContext() context.Context {
+	if c.context == nil {
+		return context.Background()
+	}
+	return c.context
+}
+
+// stopped returns whether Terraform stopped the provider, in which case
+// operations that were being waited for may still be running.
+func (c *Config) stopped() bool {
+	return c.stopContext().Err() != nil
+}
+
+// basePath returns the base path, including the API version, of the API
+// identified by key. It honors any custom endpoint set in the provider
+// configuration.
+func (c *Config) basePath(key string) string {
+	if v, ok := c.CustomEndpoints[key]; ok && v != "" {
+		return v
+	}
+	e, _ := lookupCustomEndpoint(key)
+	return e.defaultBasePath
+}
+
+// requestRateLimiters returns a limiter for each API with a rate limit, keyed
+// by the prefix shared by the URLs of every version of the API. Versions of
+// the same API, e.g. `compute` and `compute_beta`, share their limiter, so
+// they must have the same rate.
+func (c *Config) requestRateLimiters() (map[string]*rateLimiter, error) {
+	limiters := make(map[string]*rateLimiter)
+	services := make(map[string]string)
+	for _, e := range customEndpoints {
+		qps, ok := c.RequestRateLimits[e.argument]
+		if !ok {
+			continue
+		}
+		prefix := rateLimitPrefix(c.basePath(e.key))
+		if l, ok := limiters[prefix]; ok {
+			if l.qps != qps {
+				return nil, fmt.Errorf("request_rate_limits: services %q and %q share the API at %s, and must have the same qps", services[prefix], e.argument, prefix)
+			}
+			continue
+		}
+		limiters[prefix] = newRateLimiter(qps, int(math.Ceil(qps)))
+		services[prefix] = e.argument
+	}
+	return limiters, nil
+}
+
+// clientBasePath returns the BasePath to set on the generated API client of
+// the API identified by key.
+func (c *Config) clientBasePath(key string) string {
+	basePath := c.basePath(key)
+	if e, ok := lookupCustomEndpoint(key); ok && e.clientBasePath != nil {
+		return e.clientBasePath(basePath)
+	}
+	return basePath
+}
+
 // accountFile represents the structure of the account file JSON file.
 type accountFile struct {
+	Type string `json:"type"`
+
+	// Service account fields
 	PrivateKeyId string `json:"private_key_id"`
 	PrivateKey   string `json:"private_key"`
 	ClientEmail  string `json:"client_email"`
 	ClientId     string `json:"client_id"`
+
+	// Authorized user fields, as written by `gcloud auth application-default login`
+	ClientSecret string `json:"client_secret"`
+	RefreshToken string `json:"refresh_token"`
+
+	// External account fields, used by workload identity federation
+	Audience                       string                          `json:"audience"`
+	SubjectTokenType               string                          `json:"subject_token_type"`
+	TokenURL                       string                          `json:"token_url"`
+	ServiceAccountImpersonationURL string                          `json:"service_account_impersonation_url"`
+	CredentialSource               externalAccountCredentialSource `json:"credential_source"`
+}
+
+// validateType returns an error if the account file type is not supported.
+// Files without a type are assumed to be service account keys.
+func (a *accountFile) validateType() error {
+	switch a.Type {
+	case "", serviceAccountCredentialsType, authorizedUserCredentialsType, externalAccountCredentialsType:
+		return nil
+	}
+	return fmt.Errorf("Unsupported credentials type %q, expected one of %q, %q or %q",
+		a.Type, serviceAccountCredentialsType, authorizedUserCredentialsType, externalAccountCredentialsType)
+}
+
+func (a *accountFile) tokenSource(clientScopes []string) (oauth2.TokenSource, error) {
+	if err := a.validateType(); err != nil {
+		return nil, err
+	}
+
+	switch a.Type {
+	case authorizedUserCredentialsType:
+		log.Printf("[INFO] Requesting Google token for authorized user...")
+		log.Printf("[INFO]   -- Client ID: %s", a.ClientId)
+		log.Printf("[INFO]   -- Scopes: %s", clientScopes)
+
+		conf := &oauth2.Config{
+			ClientID:     a.ClientId,
+			ClientSecret: a.ClientSecret,
+			Scopes:       clientScopes,
+			Endpoint:     google.Endpoint,
+		}
+		return conf.TokenSource(context.Background(), &oauth2.Token{RefreshToken: a.RefreshToken}), nil
+	case externalAccountCredentialsType:
+		log.Printf("[INFO] Requesting Google token for external account...")
+		log.Printf("[INFO]   -- Audience: %s", a.Audience)
+		log.Printf("[INFO]   -- Scopes: %s", clientScopes)
+
+		return newExternalAccountTokenSource(*a, clientScopes)
+	}
+
+	// Get the token for use in our requests
+	log.Printf("[INFO] Requesting Google token...")
+	log.Printf("[INFO]   -- Email: %s", a.ClientEmail)
+	log.Printf("[INFO]   -- Scopes: %s", clientScopes)
+	log.Printf("[INFO]   -- Private Key Length: %d", len(a.PrivateKey))
+
+	conf := jwt.Config{
+		Email:      a.ClientEmail,
+		PrivateKey: []byte(a.PrivateKey),
+		Scopes:     clientScopes,
+		TokenURL:   "https://accounts.google.com/o/oauth2/token",
+	}
+
+	return conf.TokenSource(context.Background()), nil
 }
 
 func parseJSON(result interface{}, contents string) error {
@@ -2,6 +2,8 @@ package google
 
 import (
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -48,3 +50,149 @@ func TestConfigLoadAndValidate_accountFileJSONInvalid(t *testing.T) {
 		t.Fatalf("expected error, but got nil")
 	}
 }
+
+func TestConfigLoadAndValidate_accessToken(t *testing.T) {
+	config := Config{
+		AccessToken: "my-access-token",
+		Project:     "my-gce-project",
+		Region:      "us-central1",
+	}
+
+	err := config.loadAndValidate()
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+
+	token, err := config.tokenSource.Token()
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if token.AccessToken != "my-access-token" {
+		t.Fatalf("expected access token %q, got %q", "my-access-token", token.AccessToken)
+	}
+}
+
+func TestConfigLoadAndValidate_customEndpoints(t *testing.T) {
+	config := Config{
+		AccessToken: "my-access-token",
+		Project:     "my-gce-project",
+		Region:      "us-central1",
+		CustomEndpoints: map[string]string{
+			ComputeBasePathKey: "http://localhost:8080/compute/v1/",
+			PubsubBasePathKey:  "http://localhost:8085/v1/",
+		},
+	}
+
+	err := config.loadAndValidate()
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+
+	if expected := "http://localhost:8080/compute/v1/projects/"; config.clientCompute.BasePath != expected {
+		t.Errorf("expected compute base path %q, got %q", expected, config.clientCompute.BasePath)
+	}
+	if expected := "http://localhost:8085/"; config.clientPubsub.BasePath != expected {
+		t.Errorf("expected pubsub base path %q, got %q", expected, config.clientPubsub.BasePath)
+	}
+	if expected := "https://www.googleapis.com/storage/v1/"; config.clientStorage.BasePath != expected {
+		t.Errorf("expected storage base path %q, got %q", expected, config.clientStorage.BasePath)
+	}
+}
+
+func TestConfigLoadAndValidate_userProjectOverride(t *testing.T) {
+	cases := map[string]struct {
+		BillingProject string
+		Override       bool
+		Expected       string
+	}{
+		"no override": {
+			BillingProject: "billing-project",
+			Expected:       "",
+		},
+		"provider project": {
+			Override: true,
+			Expected: "my-gce-project",
+		},
+		"billing project": {
+			BillingProject: "billing-project",
+			Override:       true,
+			Expected:       "billing-project",
+		},
+	}
+
+	for tn, tc := range cases {
+		var got string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = r.Header.Get("X-Goog-User-Project")
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{}`))
+		}))
+
+		config := Config{
+			AccessToken:         "my-access-token",
+			Project:             "my-gce-project",
+			Region:              "us-central1",
+			BillingProject:      tc.BillingProject,
+			UserProjectOverride: tc.Override,
+			CustomEndpoints: map[string]string{
+				ComputeBasePathKey: server.URL + "/compute/v1/",
+			},
+		}
+		if err := config.loadAndValidate(); err != nil {
+			t.Fatalf("error: %v", err)
+		}
+		if _, err := config.clientCompute.Networks.Get("network-project", "default").Do(); err != nil {
+			t.Errorf("bad: %s; unexpected error %s", tn, err)
+		}
+		server.Close()
+
+		if got != tc.Expected {
+			t.Errorf("bad: %s; expected X-Goog-User-Project %q, got %q", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestConfigLoadAndValidate_accountFileUnsupportedType(t *testing.T) {
+	config := Config{
+		Credentials: `{"type": "gdch_service_account"}`,
+		Project:     "my-gce-project",
+		Region:      "us-central1",
+	}
+
+	if config.loadAndValidate() == nil {
+		t.Fatalf("expected error, but got nil")
+	}
+}
+
+func TestConfigLoadAndValidate_authorizedUser(t *testing.T) {
+	config := Config{
+		Credentials: `{"type": "authorized_user", "client_id": "foo", "client_secret": "bar", "refresh_token": "baz"}`,
+		Project:     "my-gce-project",
+		Region:      "us-central1",
+		Scopes:      []string{"https://www.googleapis.com/auth/cloud-platform", "https://www.googleapis.com/auth/userinfo.email"},
+	}
+
+	err := config.loadAndValidate()
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+}
+
+func TestConfigLoadAndValidate_scopesWithoutUserInfoEmail(t *testing.T) {
+	config := Config{
+		Credentials: testFakeCredentialsPath,
+		Project:     "my-gce-project",
+		Region:      "us-central1",
+		Scopes:      []string{"https://www.googleapis.com/auth/cloud-platform"},
+	}
+
+	if config.loadAndValidate() == nil {
+		t.Fatalf("expected error, but got nil")
+	}
+
+	// Tokens of impersonated service accounts don't need to identify them.
+	config.ImpersonateServiceAccount = "terraform@my-gce-project.iam.gserviceaccount.com"
+	if err := config.loadAndValidate(); err != nil {
+		t.Fatalf("error: %v", err)
+	}
+}
@@ -1,11 +1,10 @@
 package google
 
 import (
+	"errors"
 	"fmt"
-	"log"
 	"time"
 
-	"github.com/hashicorp/terraform/helper/resource"
 	"google.golang.org/api/container/v1"
 	containerBeta "google.golang.org/api/container/v1beta1"
 )
@@ -24,62 +23,89 @@ type ContainerBetaOperationWaiter struct {
 	Location string
 }
 
-func (w *ContainerOperationWaiter) Conf() *resource.StateChangeConf {
-	return &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING"},
-		Target:  []string{"DONE"},
-		Refresh: w.RefreshFunc(),
-	}
+func (w *ContainerOperationWaiter) QueryOp() (interface{}, error) {
+	return w.Service.Projects.Zones.Operations.Get(w.Project, w.Zone, w.Op.Name).Do()
 }
 
-func (w *ContainerBetaOperationWaiter) Conf() *resource.StateChangeConf {
-	return &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING"},
-		Target:  []string{"DONE"},
-		Refresh: w.RefreshFunc(),
+func (w *ContainerOperationWaiter) SetOp(op interface{}) error {
+	var ok bool
+	w.Op, ok = op.(*container.Operation)
+	if !ok {
+		return fmt.Errorf("Expected a *container.Operation, got %T", op)
 	}
+	return nil
 }
 
-func (w *ContainerOperationWaiter) RefreshFunc() resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		resp, err := w.Service.Projects.Zones.Operations.Get(
-			w.Project, w.Zone, w.Op.Name).Do()
-
-		if err != nil {
-			return nil, "", err
-		}
+func (w *ContainerOperationWaiter) State() string {
+	return w.Op.Status
+}
 
-		if resp.StatusMessage != "" {
-			return resp, resp.Status, fmt.Errorf(resp.StatusMessage)
-		}
+func (w *ContainerOperationWaiter) PendingStates() []string {
+	return []string{"PENDING", "RUNNING"}
+}
 
-		log.Printf("[DEBUG] Progress of operation %q: %q", w.Op.Name, resp.Status)
+func (w *ContainerOperationWaiter) TargetStates() []string {
+	return []string{"DONE"}
+}
 
-		return resp, resp.Status, err
+func (w *ContainerOperationWaiter) Error() error {
+	if w.Op.StatusMessage != "" {
+		return errors.New(w.Op.StatusMessage)
 	}
+	return nil
 }
 
-func (w *ContainerBetaOperationWaiter) RefreshFunc() resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		name := fmt.Sprintf("projects/%s/locations/%s/operations/%s",
-			w.Project, w.Location, w.Op.Name)
-		resp, err := w.Service.Projects.Locations.Operations.Get(name).Do()
+func (w *ContainerOperationWaiter) OpName() string {
+	return w.Op.Name
+}
+
+func (w *ContainerOperationWaiter) Progress() int {
+	return -1
+}
+
+func (w *ContainerBetaOperationWaiter) QueryOp() (interface{}, error) {
+	name := fmt.Sprintf("projects/%s/locations/%s/operations/%s",
+		w.Project, w.Location, w.Op.Name)
+	return w.Service.Projects.Locations.Operations.Get(name).Do()
+}
+
+func (w *ContainerBetaOperationWaiter) SetOp(op interface{}) error {
+	var ok bool
+	w.Op, ok = op.(*containerBeta.Operation)
+	if !ok {
+		return fmt.Errorf("Expected a *containerBeta.Operation, got %T", op)
+	}
+	return nil
+}
 
-		if err != nil {
-			return nil, "", err
-		}
+func (w *ContainerBetaOperationWaiter) State() string {
+	return w.Op.Status
+}
 
-		if resp.StatusMessage != "" {
-			return resp, resp.Status, fmt.Errorf(resp.StatusMessage)
-		}
+func (w *ContainerBetaOperationWaiter) PendingStates() []string {
+	return []string{"PENDING", "RUNNING"}
+}
 
-		log.Printf("[DEBUG] Progress of operation %q: %q", w.Op.Name, resp.Status)
+func (w *ContainerBetaOperationWaiter) TargetStates() []string {
+	return []string{"DONE"}
+}
 
-		return resp, resp.Status, err
+func (w *ContainerBetaOperationWaiter) Error() error {
+	if w.Op.StatusMessage != "" {
+		return errors.New(w.Op.StatusMessage)
 	}
+	return nil
+}
+
+func (w *ContainerBetaOperationWaiter) OpName() string {
+	return w.Op.Name
+}
+
+func (w *ContainerBetaOperationWaiter) Progress() int {
+	return -1
 }
 
-func containerOperationWait(config *Config, op *container.Operation, project, zone, activity string, timeoutMinutes, minTimeoutSeconds int) error {
+func containerOperationWait(config *Config, op *container.Operation, project, zone, activity string, timeout time.Duration) error {
 	w := &ContainerOperationWaiter{
 		Service: config.clientContainer,
 		Op:      op,
@@ -87,11 +113,10 @@ func containerOperationWait(config *Config, op *container.Operation, project, zo
 		Zone:    zone,
 	}
 
-	state := w.Conf()
-	return waitForState(state, activity, timeoutMinutes, minTimeoutSeconds)
+	return OperationWait(config, w, activity, timeout)
 }
 
-func containerBetaOperationWait(config *Config, op *containerBeta.Operation, project, location, activity string, timeoutMinutes, minTimeoutSeconds int) error {
+func containerBetaOperationWait(config *Config, op *containerBeta.Operation, project, location, activity string, timeout time.Duration) error {
 	w := &ContainerBetaOperationWaiter{
 		Service:  config.clientContainerBeta,
 		Op:       op,
@@ -99,30 +124,19 @@ func containerBetaOperationWait(config *Config, op *containerBeta.Operation, pro
 		Location: location,
 	}
 
-	state := w.Conf()
-	return waitForState(state, activity, timeoutMinutes, minTimeoutSeconds)
-}
-
-func waitForState(state *resource.StateChangeConf, activity string, timeoutMinutes, minTimeoutSeconds int) error {
-	state.Timeout = time.Duration(timeoutMinutes) * time.Minute
-	state.MinTimeout = time.Duration(minTimeoutSeconds) * time.Second
-	_, err := state.WaitForState()
-	if err != nil {
-		return fmt.Errorf("Error waiting for %s: %s", activity, err)
-	}
-	return nil
+	return OperationWait(config, w, activity, timeout)
 }
 
-func containerSharedOperationWait(config *Config, op interface{}, project, location, activity string, timeoutMinutes, minTimeoutSeconds int) error {
+func containerSharedOperationWait(config *Config, op interface{}, project, location, activity string, timeout time.Duration) error {
 	if op == nil {
 		panic("Attempted to wait on an Operation that was nil.")
 	}
 
 	switch op.(type) {
 	case *container.Operation:
-		return containerOperationWait(config, op.(*container.Operation), project, location, activity, timeoutMinutes, minTimeoutSeconds)
+		return containerOperationWait(config, op.(*container.Operation), project, location, activity, timeout)
 	case *containerBeta.Operation:
-		return containerBetaOperationWait(config, op.(*containerBeta.Operation), project, location, activity, timeoutMinutes, minTimeoutSeconds)
+		return containerBetaOperationWait(config, op.(*containerBeta.Operation), project, location, activity, timeout)
 	default:
 		panic("Attempted to wait on an Operation of unknown type.")
 	}
@@ -0,0 +1,177 @@
+package google
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Keys identifying each API the provider talks to. They are used to look up
+// base paths in Config and in `{{<key>BasePath}}` URL templates.
+const (
+	AppEngineBasePathKey              = "AppEngine"
+	BigQueryBasePathKey               = "BigQuery"
+	BigtableAdminBasePathKey          = "BigtableAdmin"
+	CloudBillingBasePathKey           = "CloudBilling"
+	CloudBuildBasePathKey             = "CloudBuild"
+	CloudFunctionsBasePathKey         = "CloudFunctions"
+	CloudIoTBasePathKey               = "CloudIoT"
+	ComputeBasePathKey                = "Compute"
+	ComputeBetaBasePathKey            = "ComputeBeta"
+	ContainerBasePathKey              = "Container"
+	ContainerBetaBasePathKey          = "ContainerBeta"
+	DataflowBasePathKey               = "Dataflow"
+	DataprocBasePathKey               = "Dataproc"
+	DnsBasePathKey                    = "Dns"
+	IamBasePathKey                    = "Iam"
+	IamCredentialsBasePathKey         = "IamCredentials"
+	KmsBasePathKey                    = "Kms"
+	LoggingBasePathKey                = "Logging"
+	PubsubBasePathKey                 = "Pubsub"
+	RedisBasePathKey                  = "Redis"
+	ResourceManagerBasePathKey        = "ResourceManager"
+	ResourceManagerV2Beta1BasePathKey = "ResourceManagerV2Beta1"
+	RuntimeconfigBasePathKey          = "Runtimeconfig"
+	ServiceManagementBasePathKey      = "ServiceManagement"
+	ServiceUsageBasePathKey           = "ServiceUsage"
+	SourceRepoBasePathKey             = "SourceRepo"
+	SpannerBasePathKey                = "Spanner"
+	SqlBasePathKey                    = "Sql"
+	StorageBasePathKey                = "Storage"
+)
+
+// A customEndpoint describes a REST API whose base path can be overridden by
+// the `<argument>_custom_endpoint` provider argument, e.g. to target an
+// emulator or a local fake.
+type customEndpoint struct {
+	key      string
+	argument string
+
+	// Base path including the API version, e.g. `https://pubsub.googleapis.com/v1/`.
+	// This is the prefix of every URL template using `{{<key>BasePath}}`.
+	defaultBasePath string
+
+	// Converts the base path into the BasePath expected by the generated API
+	// client, which may or may not include the API version.
+	clientBasePath func(string) string
+}
+
+var customEndpoints = []customEndpoint{
+	{AppEngineBasePathKey, "app_engine", "https://appengine.googleapis.com/v1/", removeBasePathVersion},
+	{BigQueryBasePathKey, "big_query", "https://www.googleapis.com/bigquery/v2/", nil},
+	{BigtableAdminBasePathKey, "bigtable_admin", "https://bigtableadmin.googleapis.com/v2/", nil},
+	{CloudBillingBasePathKey, "cloud_billing", "https://cloudbilling.googleapis.com/v1/", removeBasePathVersion},
+	{CloudBuildBasePathKey, "cloud_build", "https://cloudbuild.googleapis.com/v1/", removeBasePathVersion},
+	{CloudFunctionsBasePathKey, "cloud_functions", "https://cloudfunctions.googleapis.com/v1/", removeBasePathVersion},
+	{CloudIoTBasePathKey, "cloud_iot", "https://cloudiot.googleapis.com/v1/", removeBasePathVersion},
+	{ComputeBasePathKey, "compute", "https://www.googleapis.com/compute/v1/", addBasePathProjects},
+	{ComputeBetaBasePathKey, "compute_beta", "https://www.googleapis.com/compute/beta/", addBasePathProjects},
+	{ContainerBasePathKey, "container", "https://container.googleapis.com/v1/", removeBasePathVersion},
+	{ContainerBetaBasePathKey, "container_beta", "https://container.googleapis.com/v1beta1/", removeBasePathVersion},
+	{DataflowBasePathKey, "dataflow", "https://dataflow.googleapis.com/v1b3/", removeBasePathVersion},
+	{DataprocBasePathKey, "dataproc", "https://dataproc.googleapis.com/v1/", removeBasePathVersion},
+	{DnsBasePathKey, "dns", "https://www.googleapis.com/dns/v1/", addBasePathProjects},
+	{IamBasePathKey, "iam", "https://iam.googleapis.com/v1/", removeBasePathVersion},
+	{IamCredentialsBasePathKey, "iam_credentials", "https://iamcredentials.googleapis.com/v1/", nil},
+	{KmsBasePathKey, "kms", "https://cloudkms.googleapis.com/v1/", removeBasePathVersion},
+	{LoggingBasePathKey, "logging", "https://logging.googleapis.com/v2/", removeBasePathVersion},
+	{PubsubBasePathKey, "pubsub", "https://pubsub.googleapis.com/v1/", removeBasePathVersion},
+	{RedisBasePathKey, "redis", "https://redis.googleapis.com/v1beta1/", removeBasePathVersion},
+	{ResourceManagerBasePathKey, "resource_manager", "https://cloudresourcemanager.googleapis.com/v1/", removeBasePathVersion},
+	{ResourceManagerV2Beta1BasePathKey, "resource_manager_v2beta1", "https://cloudresourcemanager.googleapis.com/v2beta1/", removeBasePathVersion},
+	{RuntimeconfigBasePathKey, "runtimeconfig", "https://runtimeconfig.googleapis.com/v1beta1/", removeBasePathVersion},
+	{ServiceManagementBasePathKey, "service_management", "https://servicemanagement.googleapis.com/v1/", removeBasePathVersion},
+	{ServiceUsageBasePathKey, "service_usage", "https://serviceusage.googleapis.com/v1beta1/", removeBasePathVersion},
+	{SourceRepoBasePathKey, "source_repo", "https://sourcerepo.googleapis.com/v1/", removeBasePathVersion},
+	{SpannerBasePathKey, "spanner", "https://spanner.googleapis.com/v1/", removeBasePathVersion},
+	{SqlBasePathKey, "sql", "https://www.googleapis.com/sql/v1beta4/", nil},
+	{StorageBasePathKey, "storage", "https://www.googleapis.com/storage/v1/", nil},
+}
+
+func lookupCustomEndpoint(key string) (customEndpoint, bool) {
+	for _, e := range customEndpoints {
+		if e.key == key {
+			return e, true
+		}
+	}
+	return customEndpoint{}, false
+}
+
+// customEndpointArguments returns the name of each API in provider arguments.
+func customEndpointArguments() []string {
+	arguments := make([]string, 0, len(customEndpoints))
+	for _, e := range customEndpoints {
+		arguments = append(arguments, e.argument)
+	}
+	return arguments
+}
+
+// customEndpointsSchema returns the `<service>_custom_endpoint` provider
+// arguments, plus `bigtable_custom_endpoint` which is a gRPC address.
+func customEndpointsSchema() map[string]*schema.Schema {
+	m := map[string]*schema.Schema{
+		"bigtable_custom_endpoint": {
+			Type:     schema.TypeString,
+			Optional: true,
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+				"GOOGLE_BIGTABLE_CUSTOM_ENDPOINT",
+			}, nil),
+			ValidateFunc: validateRegexp(`^[^/:]+:[0-9]+$`),
+		},
+	}
+
+	for _, e := range customEndpoints {
+		m[e.argument+"_custom_endpoint"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+				"GOOGLE_" + strings.ToUpper(e.argument) + "_CUSTOM_ENDPOINT",
+			}, nil),
+			ValidateFunc: validateCustomEndpoint,
+		}
+	}
+
+	return m
+}
+
+// expandCustomEndpoints reads the custom endpoints set in the provider
+// configuration, keyed by base path key.
+func expandCustomEndpoints(d *schema.ResourceData) map[string]string {
+	m := make(map[string]string)
+	for _, e := range customEndpoints {
+		if v, ok := d.GetOk(e.argument + "_custom_endpoint"); ok {
+			m[e.key] = v.(string)
+		}
+	}
+	return m
+}
+
+func validateCustomEndpoint(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errors = append(errors, fmt.Errorf("%q (%q) must be an absolute http or https URL", k, value))
+		return
+	}
+	if !strings.HasSuffix(u.Path, "/") {
+		errors = append(errors, fmt.Errorf("%q (%q) must end with a '/'", k, value))
+	}
+	return
+}
+
+var basePathVersionRegex = regexp.MustCompile("v[0-9]+[a-z0-9]*/$")
+
+// Generated API clients for most services expect a base path without the
+// API version, e.g. `https://pubsub.googleapis.com/`, as the version is part
+// of each method's path.
+func removeBasePathVersion(basePath string) string {
+	return basePathVersionRegex.ReplaceAllString(basePath, "")
+}
+
+// The Compute and DNS API clients expect their base path to end in `projects/`.
+func addBasePathProjects(basePath string) string {
+	return basePath + "projects/"
+}
@@ -0,0 +1,41 @@
+package google
+
+import (
+	"testing"
+)
+
+func TestRemoveBasePathVersion(t *testing.T) {
+	cases := map[string]string{
+		"https://pubsub.googleapis.com/v1/":                    "https://pubsub.googleapis.com/",
+		"https://dataflow.googleapis.com/v1b3/":                "https://dataflow.googleapis.com/",
+		"https://cloudresourcemanager.googleapis.com/v2beta1/": "https://cloudresourcemanager.googleapis.com/",
+		"http://localhost:8085/v1/":                            "http://localhost:8085/",
+		"http://localhost:8085/":                               "http://localhost:8085/",
+	}
+
+	for basePath, expected := range cases {
+		if actual := removeBasePathVersion(basePath); actual != expected {
+			t.Errorf("removeBasePathVersion(%q): expected %q, got %q", basePath, expected, actual)
+		}
+	}
+}
+
+func TestValidateCustomEndpoint(t *testing.T) {
+	cases := map[string]bool{
+		"https://www.googleapis.com/compute/v1/": true,
+		"http://localhost:8085/v1/":              true,
+		"http://localhost:8085/v1":               false,
+		"localhost:8085/v1/":                     false,
+		"ftp://localhost/v1/":                    false,
+	}
+
+	for endpoint, valid := range cases {
+		_, errs := validateCustomEndpoint(endpoint, "compute_custom_endpoint")
+		if valid && len(errs) > 0 {
+			t.Errorf("expected %q to be valid, got %v", endpoint, errs)
+		}
+		if !valid && len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", endpoint)
+		}
+	}
+}
@@ -2,7 +2,6 @@ package google
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"testing"
@@ -17,7 +16,7 @@ func TestAccDataSourceDnsManagedZone_basic(t *testing.T) {
 		CheckDestroy: testAccCheckDnsManagedZoneDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccDataSourceDnsManagedZone_basic(),
+				Config: testAccDataSourceDnsManagedZone_basic(t),
 				Check:  testAccDataSourceDnsManagedZoneCheck("data.google_dns_managed_zone.qa", "google_dns_managed_zone.foo"),
 			},
 		},
@@ -57,7 +56,7 @@ func testAccDataSourceDnsManagedZoneCheck(dsName, rsName string) resource.TestCh
 	}
 }
 
-func testAccDataSourceDnsManagedZone_basic() string {
+func testAccDataSourceDnsManagedZone_basic(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_dns_managed_zone" "foo" {
 	name		= "qa-zone-%s"
@@ -68,5 +67,5 @@ resource "google_dns_managed_zone" "foo" {
 data "google_dns_managed_zone" "qa" {
 	name	= "${google_dns_managed_zone.foo.name}"
 }
-`, acctest.RandString(10))
+`, randString(t, 10))
 }
@@ -5,7 +5,6 @@ import (
 	"os"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -16,9 +15,9 @@ func TestAccDataSourceGoogleCloudFunctionsFunction_basic(t *testing.T) {
 	funcDataNameHttp := "data.google_cloudfunctions_function.function_http"
 	funcDataNamePubSub := "data.google_cloudfunctions_function.function_pubsub"
 	funcDataNameBucket := "data.google_cloudfunctions_function.function_bucket"
-	functionName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	bucketName := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
-	topicName := fmt.Sprintf("tf-test-sub-%s", acctest.RandString(10))
+	functionName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", randInt(t))
+	topicName := fmt.Sprintf("tf-test-sub-%s", randString(t, 10))
 	zipFilePath, err := createZIPArchiveForIndexJs(testHTTPTriggerPath)
 	if err != nil {
 		t.Fatal(err.Error())
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -12,8 +11,8 @@ import (
 func TestAccDataSourceComputeImage(t *testing.T) {
 	t.Parallel()
 
-	family := acctest.RandomWithPrefix("tf-test")
-	name := acctest.RandomWithPrefix("tf-test")
+	family := randomWithPrefix(t, "tf-test")
+	name := randomWithPrefix(t, "tf-test")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
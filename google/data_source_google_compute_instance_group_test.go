@@ -9,7 +9,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -22,7 +21,7 @@ func TestAccDataSourceGoogleComputeInstanceGroup_basic(t *testing.T) {
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCheckDataSourceGoogleComputeInstanceGroupConfig(),
+				Config: testAccCheckDataSourceGoogleComputeInstanceGroupConfig(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDataSourceGoogleComputeInstanceGroup("data.google_compute_instance_group.test"),
 				),
@@ -39,7 +38,7 @@ func TestAccDataSourceGoogleComputeInstanceGroup_withNamedPort(t *testing.T) {
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCheckDataSourceGoogleComputeInstanceGroupConfigWithNamedPort(),
+				Config: testAccCheckDataSourceGoogleComputeInstanceGroupConfigWithNamedPort(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDataSourceGoogleComputeInstanceGroup("data.google_compute_instance_group.test"),
 				),
@@ -56,7 +55,7 @@ func TestAccDataSourceGoogleComputeInstanceGroup_fromIGM(t *testing.T) {
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCheckDataSourceGoogleComputeInstanceGroup_fromIGM(),
+				Config: testAccCheckDataSourceGoogleComputeInstanceGroup_fromIGM(t),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.google_compute_instance_group.test", "instances.#", "10"),
 				),
@@ -191,7 +190,7 @@ func testAccCheckDataSourceGoogleComputeInstanceGroup(dataSourceName string) res
 	}
 }
 
-func testAccCheckDataSourceGoogleComputeInstanceGroupConfig() string {
+func testAccCheckDataSourceGoogleComputeInstanceGroupConfig(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_instance" "test" {
   name         = "tf-test-%s"
@@ -226,10 +225,10 @@ data "google_compute_instance_group" "test" {
   name = "${google_compute_instance_group.test.name}"
   zone = "${google_compute_instance_group.test.zone}"
 }
-`, acctest.RandString(10), acctest.RandString(10))
+`, randString(t, 10), randString(t, 10))
 }
 
-func testAccCheckDataSourceGoogleComputeInstanceGroupConfigWithNamedPort() string {
+func testAccCheckDataSourceGoogleComputeInstanceGroupConfigWithNamedPort(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_instance" "test" {
   name         = "tf-test-%s"
@@ -274,10 +273,10 @@ data "google_compute_instance_group" "test" {
   name = "${google_compute_instance_group.test.name}"
   zone = "${google_compute_instance_group.test.zone}"
 }
-`, acctest.RandString(10), acctest.RandString(10))
+`, randString(t, 10), randString(t, 10))
 }
 
-func testAccCheckDataSourceGoogleComputeInstanceGroup_fromIGM() string {
+func testAccCheckDataSourceGoogleComputeInstanceGroup_fromIGM(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_instance_template" "igm-basic" {
   name = "%s"
@@ -307,5 +306,5 @@ resource "google_compute_instance_group_manager" "igm" {
 data "google_compute_instance_group" "test" {
   self_link = "${google_compute_instance_group_manager.igm.instance_group}"
 }
-`, acctest.RandomWithPrefix("test-igm"), acctest.RandomWithPrefix("test-igm"))
+`, randomWithPrefix(t, "test-igm"), randomWithPrefix(t, "test-igm"))
 }
@@ -4,19 +4,18 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccDataSourceRegionInstanceGroup(t *testing.T) {
 	t.Parallel()
-	name := "acctest-" + acctest.RandString(6)
+	name := "acctest-" + randString(t, 6)
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDataSourceRegionInstanceGroup_basic(name),
+				Config: testAccDataSourceRegionInstanceGroup_basic(t, name),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.google_compute_region_instance_group.data_source", "name", name),
 					resource.TestCheckResourceAttr("data.google_compute_region_instance_group.data_source", "project", getTestProjectFromEnv()),
@@ -26,7 +25,7 @@ func TestAccDataSourceRegionInstanceGroup(t *testing.T) {
 	})
 }
 
-func testAccDataSourceRegionInstanceGroup_basic(instanceManagerName string) string {
+func testAccDataSourceRegionInstanceGroup_basic(t *testing.T, instanceManagerName string) string {
 	return fmt.Sprintf(`
 resource "google_compute_health_check" "autohealing" {
 	name = "%s"
@@ -85,5 +84,5 @@ resource "google_compute_region_instance_group_manager" "foo" {
 data "google_compute_region_instance_group" "data_source" {
 	self_link = "${google_compute_region_instance_group_manager.foo.instance_group}"
 }
-`, acctest.RandomWithPrefix("test-rigm-"), acctest.RandomWithPrefix("test-rigm-"), instanceManagerName)
+`, randomWithPrefix(t, "test-rigm-"), randomWithPrefix(t, "test-rigm-"), instanceManagerName)
 }
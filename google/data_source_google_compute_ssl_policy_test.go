@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -17,7 +16,7 @@ func TestAccDataSourceGoogleSslPolicy(t *testing.T) {
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccDataSourceGoogleSslPolicy(),
+				Config: testAccDataSourceGoogleSslPolicy(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccDataSourceGoogleSslPolicyCheck("data.google_compute_ssl_policy.ssl_policy", "google_compute_ssl_policy.foobar"),
 				),
@@ -66,7 +65,7 @@ func testAccDataSourceGoogleSslPolicyCheck(data_source_name string, resource_nam
 	}
 }
 
-func testAccDataSourceGoogleSslPolicy() string {
+func testAccDataSourceGoogleSslPolicy(t *testing.T) string {
 	return fmt.Sprintf(`
 
 resource "google_compute_ssl_policy" "foobar" {
@@ -79,5 +78,5 @@ resource "google_compute_ssl_policy" "foobar" {
 data "google_compute_ssl_policy" "ssl_policy" {
 	name = "${google_compute_ssl_policy.foobar.name}"
 }
-`, acctest.RandomWithPrefix("test-ssl-policy"))
+`, randomWithPrefix(t, "test-ssl-policy"))
 }
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -17,7 +16,7 @@ func TestAccDataSourceGoogleSubnetwork(t *testing.T) {
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccDataSourceGoogleSubnetwork(),
+				Config: testAccDataSourceGoogleSubnetwork(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccDataSourceGoogleSubnetworkCheck("data.google_compute_subnetwork.my_subnetwork", "google_compute_subnetwork.foobar"),
 				),
@@ -70,7 +69,7 @@ func testAccDataSourceGoogleSubnetworkCheck(data_source_name string, resource_na
 	}
 }
 
-func testAccDataSourceGoogleSubnetwork() string {
+func testAccDataSourceGoogleSubnetwork(t *testing.T) string {
 	return fmt.Sprintf(`
 
 resource "google_compute_network" "foobar" {
@@ -92,5 +91,5 @@ resource "google_compute_subnetwork" "foobar" {
 data "google_compute_subnetwork" "my_subnetwork" {
 	name = "${google_compute_subnetwork.foobar.name}"
 }
-`, acctest.RandomWithPrefix("network-test"))
+`, randomWithPrefix(t, "network-test"))
 }
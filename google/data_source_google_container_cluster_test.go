@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -17,7 +16,7 @@ func TestAccContainerClusterDatasource_zonal(t *testing.T) {
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccContainerClusterDatasource_zonal(),
+				Config: testAccContainerClusterDatasource_zonal(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccDataSourceGoogleContainerClusterCheck("data.google_container_cluster.kubes", "google_container_cluster.kubes"),
 				),
@@ -34,7 +33,7 @@ func TestAccContainerClusterDatasource_regional(t *testing.T) {
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccContainerClusterDatasource_regional(),
+				Config: testAccContainerClusterDatasource_regional(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccDataSourceGoogleContainerClusterCheck("data.google_container_cluster.kubes", "google_container_cluster.kubes"),
 				),
@@ -105,7 +104,7 @@ func testAccDataSourceGoogleContainerClusterCheck(dataSourceName string, resourc
 	}
 }
 
-func testAccContainerClusterDatasource_zonal() string {
+func testAccContainerClusterDatasource_zonal(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "kubes" {
 	name               = "cluster-test-%s"
@@ -122,10 +121,10 @@ data "google_container_cluster" "kubes" {
 	name = "${google_container_cluster.kubes.name}"
 	zone = "${google_container_cluster.kubes.zone}"
 }
-`, acctest.RandString(10))
+`, randString(t, 10))
 }
 
-func testAccContainerClusterDatasource_regional() string {
+func testAccContainerClusterDatasource_regional(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "kubes" {
 	name               = "cluster-test-%s"
@@ -137,5 +136,5 @@ data "google_container_cluster" "kubes" {
 	name   = "${google_container_cluster.kubes.name}"
 	region = "${google_container_cluster.kubes.region}"
 }
-`, acctest.RandString(10))
+`, randString(t, 10))
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"google.golang.org/api/cloudresourcemanager/v1"
 )
 
@@ -24,6 +25,59 @@ var iamBinding *schema.Schema = &schema.Schema{
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+			"condition": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"expression": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var iamAuditConfig *schema.Schema = &schema.Schema{
+	Type:     schema.TypeSet,
+	Optional: true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"audit_log_configs": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"ADMIN_READ", "DATA_READ", "DATA_WRITE"}, false),
+						},
+						"exempted_members": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+					},
+				},
+			},
 		},
 	},
 }
@@ -38,13 +92,24 @@ var iamBinding *schema.Schema = &schema.Schema{
 //     members = [
 //       "user:evanbrown@google.com",
 //     ]
+//     condition {
+//       title      = "expires_after_2019_12_31"
+//       expression = "request.time < timestamp(\"2020-01-01T00:00:00Z\")"
+//     }
+//   }
+//   audit_config {
+//     service = "storage.googleapis.com"
+//     audit_log_configs {
+//       log_type = "DATA_READ"
+//     }
 //   }
 // }
 func dataSourceGoogleIamPolicy() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceGoogleIamPolicyRead,
 		Schema: map[string]*schema.Schema{
-			"binding": iamBinding,
+			"binding":      iamBinding,
+			"audit_config": iamAuditConfig,
 			"policy_data": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -56,26 +121,36 @@ func dataSourceGoogleIamPolicy() *schema.Resource {
 // dataSourceGoogleIamPolicyRead reads a data source from config and writes it
 // to state.
 func dataSourceGoogleIamPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	var policy cloudresourcemanager.Policy
-	var bindings []*cloudresourcemanager.Binding
+	var policy IamPolicy
+	var bindings []*IamBinding
 
 	// The schema supports multiple binding{} blocks
 	bset := d.Get("binding").(*schema.Set)
 
 	// All binding{} blocks will be converted and stored in an array
-	bindings = make([]*cloudresourcemanager.Binding, bset.Len())
+	bindings = make([]*IamBinding, bset.Len())
 	policy.Bindings = bindings
 
-	// Convert each config binding into a cloudresourcemanager.Binding
+	// Convert each config binding into an IamBinding
 	for i, v := range bset.List() {
 		binding := v.(map[string]interface{})
-		policy.Bindings[i] = &cloudresourcemanager.Binding{
-			Role:    binding["role"].(string),
-			Members: convertStringSet(binding["members"].(*schema.Set)),
+		policy.Bindings[i] = &IamBinding{
+			Role:      binding["role"].(string),
+			Members:   convertStringSet(binding["members"].(*schema.Set)),
+			Condition: expandIamCondition(binding["condition"]),
 		}
 	}
 
-	// Marshal cloudresourcemanager.Policy to JSON suitable for storing in state
+	// Convert each audit_config{} block into an AuditConfig
+	for _, v := range d.Get("audit_config").(*schema.Set).List() {
+		config := v.(map[string]interface{})
+		policy.AuditConfigs = append(policy.AuditConfigs, &cloudresourcemanager.AuditConfig{
+			Service:         config["service"].(string),
+			AuditLogConfigs: expandAuditLogConfigs(config["audit_log_configs"].(*schema.Set)),
+		})
+	}
+
+	// Marshal IamPolicy to JSON suitable for storing in state
 	pjson, err := json.Marshal(&policy)
 	if err != nil {
 		// should never happen if the above code is correct
@@ -0,0 +1,30 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceIAMRole(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "google_iam_role" "role" {
+  name = "roles/iam.roleViewer"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_iam_role.role", "id", "roles/iam.roleViewer"),
+					resource.TestCheckResourceAttrSet("data.google_iam_role.role", "title"),
+					resource.TestCheckResourceAttrSet("data.google_iam_role.role", "included_permissions.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,91 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceGoogleIamTestablePermissions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleIamTestablePermissionsRead,
+		Schema: map[string]*schema.Schema{
+			"full_resource_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"stages": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"ALPHA", "BETA", "GA", "DEPRECATED"}, false),
+				},
+			},
+			"custom_support_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      customRolesSupported,
+				ValidateFunc: validation.StringInSlice([]string{customRolesSupported, customRolesTesting, customRolesNotSupported}, false),
+			},
+			"permissions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"custom_support_level": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stage": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleIamTestablePermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	fullResourceName := d.Get("full_resource_name").(string)
+	testable, err := queryTestablePermissions(config, fullResourceName)
+	if err != nil {
+		return fmt.Errorf("Error reading the testable permissions of %s: %s", fullResourceName, err)
+	}
+
+	// All the stages are returned by default.
+	stages := convertStringArr(d.Get("stages").([]interface{}))
+	level := d.Get("custom_support_level").(string)
+	permissions := make([]map[string]interface{}, 0)
+	for _, p := range testable {
+		if customRolesSupportLevel(p) != level || (len(stages) > 0 && !containsString(stages, p.Stage)) {
+			continue
+		}
+		permissions = append(permissions, map[string]interface{}{
+			"name":                 p.Name,
+			"title":                p.Title,
+			"custom_support_level": customRolesSupportLevel(p),
+			"stage":                p.Stage,
+		})
+	}
+
+	d.SetId(fullResourceName)
+	if err := d.Set("permissions", permissions); err != nil {
+		return fmt.Errorf("Error reading the testable permissions of %s: %s", fullResourceName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package google
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDataSourceGoogleIamTestablePermissions_basic(t *testing.T) {
+	t.Parallel()
+
+	project := getTestProjectFromEnv()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "google_iam_testable_permissions" "perms" {
+  full_resource_name = "//cloudresourcemanager.googleapis.com/projects/%s"
+}
+`, project),
+				Check: testAccCheckGoogleIamTestablePermissions("data.google_iam_testable_permissions.perms", "SUPPORTED"),
+			},
+			{
+				Config: fmt.Sprintf(`
+data "google_iam_testable_permissions" "perms" {
+  full_resource_name   = "//cloudresourcemanager.googleapis.com/projects/%s"
+  stages               = ["GA"]
+  custom_support_level = "NOT_SUPPORTED"
+}
+`, project),
+				Check: testAccCheckGoogleIamTestablePermissions("data.google_iam_testable_permissions.perms", "NOT_SUPPORTED"),
+			},
+		},
+	})
+}
+
+// testAccCheckGoogleIamTestablePermissions checks that the data source n has
+// permissions, all with the custom support level level.
+func testAccCheckGoogleIamTestablePermissions(n, level string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		count, err := strconv.Atoi(rs.Primary.Attributes["permissions.#"])
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return fmt.Errorf("No permissions found")
+		}
+		for i := 0; i < count; i++ {
+			if got := rs.Primary.Attributes[fmt.Sprintf("permissions.%d.custom_support_level", i)]; got != level {
+				return fmt.Errorf("Expected permission %s to have support level %s, got %s", rs.Primary.Attributes[fmt.Sprintf("permissions.%d.name", i)], level, got)
+			}
+		}
+		return nil
+	}
+}
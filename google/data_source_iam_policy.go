@@ -0,0 +1,94 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// DataSourceIamPolicy returns a data source reading the IAM policy attached
+// to a resource, which unlike `google_iam_policy` reflects the actual access
+// to it. This is an example of how it would be used in a config:
+//
+// data "google_pubsub_topic_iam_policy" "policy" {
+//   topic = "my-topic"
+// }
+//
+// The arguments of the data source are those of the IAM resources of the
+// resource, given by parentSpecificSchema.
+func DataSourceIamPolicy(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc) *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIamPolicyRead(newUpdaterFunc),
+
+		Schema: mergeSchemas(iamPolicyDataSourceSchema(), iamDataSourceArgumentsSchema(parentSpecificSchema)),
+	}
+}
+
+func iamPolicyDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		// The same binding blocks as the google_iam_policy data source, but
+		// computed.
+		"binding": datasourceSchemaFromResourceSchema(map[string]*schema.Schema{
+			"binding": iamBinding,
+		})["binding"],
+		"policy_data": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"etag": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+// iamDataSourceArgumentsSchema returns a copy of the schema of the arguments
+// of IAM resources which is valid for a data source.
+func iamDataSourceArgumentsSchema(parentSpecificSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	ds := make(map[string]*schema.Schema, len(parentSpecificSchema))
+	for k, v := range parentSpecificSchema {
+		dv := *v
+		dv.ForceNew = false
+		ds[k] = &dv
+	}
+	return ds
+}
+
+func dataSourceIamPolicyRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.ReadFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*Config)
+		updater, err := newUpdaterFunc(d, config)
+		if err != nil {
+			return err
+		}
+
+		policy, err := updater.GetResourceIamPolicy()
+		if err != nil {
+			return err
+		}
+
+		d.SetId(updater.GetResourceId())
+		d.Set("etag", policy.Etag)
+		d.Set("policy_data", marshalIamPolicy(policy))
+		if err := d.Set("binding", flattenIamBindings(policy.Bindings)); err != nil {
+			return fmt.Errorf("Error reading IAM policy for %s: %s", updater.DescribeResource(), err)
+		}
+
+		return nil
+	}
+}
+
+func flattenIamBindings(bindings []*IamBinding) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(bindings))
+	for _, b := range bindings {
+		binding := map[string]interface{}{
+			"role":    b.Role,
+			"members": schema.NewSet(schema.HashString, convertStringArrToInterface(b.Members)),
+		}
+		if b.Condition != nil {
+			binding["condition"] = flattenIamCondition(b.Condition)
+		}
+		result = append(result, binding)
+	}
+	return result
+}
@@ -0,0 +1,64 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-google/google/fakegcp"
+)
+
+// Test that the data source reads the policy attached to the project, with
+// the bindings granted outside of the config.
+func TestFakeDataSourceProjectIamPolicy(t *testing.T) {
+	t.Parallel()
+
+	s := newFakeGcpServer(t)
+	defer s.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testFakeGcpProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeDataSourceProjectIamPolicy(s),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_project_iam_policy.policy", "id", fakeGcpProject),
+					resource.TestCheckResourceAttrSet("data.google_project_iam_policy.policy", "etag"),
+					resource.TestCheckResourceAttrSet("data.google_project_iam_policy.policy", "policy_data"),
+					testFakeDataSourceIamPolicyBindings(s, "data.google_project_iam_policy.policy", "projects/"+fakeGcpProject),
+				),
+			},
+		},
+	})
+}
+
+// testFakeDataSourceIamPolicyBindings checks that the data source has as many
+// bindings as the policy of the named resource in s.
+func testFakeDataSourceIamPolicyBindings(s *fakegcp.Server, name, resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		bindings, _ := s.IamPolicy(resource)["bindings"].([]interface{})
+		if got := rs.Primary.Attributes["binding.#"]; got != fmt.Sprint(len(bindings)) {
+			return fmt.Errorf("Expected %d bindings, got %s", len(bindings), got)
+		}
+		return nil
+	}
+}
+
+func testFakeDataSourceProjectIamPolicy(s *fakegcp.Server) string {
+	return testFakeGcpProviderConfig(s) + fmt.Sprintf(`
+resource "google_project_iam_member" "viewer" {
+	project = "%s"
+	role    = "roles/viewer"
+	member  = "user:jane@example.com"
+}
+
+data "google_project_iam_policy" "policy" {
+	project = "${google_project_iam_member.viewer.project}"
+}
+`, fakeGcpProject)
+}
@@ -11,7 +11,6 @@ import (
 	"net/url"
 
 	"github.com/hashicorp/go-cleanhttp"
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"golang.org/x/oauth2/google"
@@ -119,7 +118,7 @@ func TestAccStorageSignedUrl_basic(t *testing.T) {
 func TestAccStorageSignedUrl_accTest(t *testing.T) {
 	t.Parallel()
 
-	bucketName := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", randInt(t))
 
 	headers := map[string]string{
 		"x-goog-test":                "foo",
@@ -71,7 +71,7 @@ func (w *DataprocJobOperationWaiter) RefreshFunc() resource.StateRefreshFunc {
 	}
 }
 
-func dataprocDeleteOperationWait(config *Config, region, projectId, jobId string, activity string, timeoutMinutes, minTimeoutSeconds int) error {
+func dataprocDeleteOperationWait(config *Config, region, projectId, jobId string, activity string, timeout time.Duration, minTimeoutSeconds int) error {
 	w := &DataprocJobOperationWaiter{
 		Service:   config.clientDataproc,
 		Region:    region,
@@ -80,9 +80,9 @@ func dataprocDeleteOperationWait(config *Config, region, projectId, jobId string
 	}
 
 	state := w.ConfForDelete()
-	state.Timeout = time.Duration(timeoutMinutes) * time.Minute
+	state.Timeout = timeout
 	state.MinTimeout = time.Duration(minTimeoutSeconds) * time.Second
-	_, err := state.WaitForState()
+	_, err := waitForStateContext(config.stopContext(), state)
 	if err != nil {
 		return fmt.Errorf("Error waiting for %s: %s", activity, err)
 	}
@@ -90,7 +90,7 @@ func dataprocDeleteOperationWait(config *Config, region, projectId, jobId string
 	return nil
 }
 
-func dataprocJobOperationWait(config *Config, region, projectId, jobId string, activity string, timeoutMinutes, minTimeoutSeconds int) error {
+func dataprocJobOperationWait(config *Config, region, projectId, jobId string, activity string, timeout time.Duration, minTimeoutSeconds int) error {
 	w := &DataprocJobOperationWaiter{
 		Service:   config.clientDataproc,
 		Region:    region,
@@ -99,9 +99,9 @@ func dataprocJobOperationWait(config *Config, region, projectId, jobId string, a
 	}
 
 	state := w.Conf()
-	state.Timeout = time.Duration(timeoutMinutes) * time.Minute
+	state.Timeout = timeout
 	state.MinTimeout = time.Duration(minTimeoutSeconds) * time.Second
-	_, err := state.WaitForState()
+	_, err := waitForStateContext(config.stopContext(), state)
 	if err != nil {
 		return fmt.Errorf("Error waiting for operation %s: %s", activity, err)
 	}
@@ -0,0 +1,107 @@
+package google
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/errwrap"
+	"google.golang.org/api/googleapi"
+)
+
+// A RetryErrorPredicateFunc returns whether an API call that failed with the
+// given error may succeed if sent again, and if so why.
+type RetryErrorPredicateFunc func(error) (bool, string)
+
+// Predicates used by the retry transport and by retry/retryTime. Errors that
+// require the caller to re-read the resource before trying again, such as
+// fingerprint or etag mismatches, must not be retried here.
+var defaultErrorRetryPredicates = []RetryErrorPredicateFunc{
+	isCommonRetryableErrorCode,
+	isRateLimitExceededError,
+	isResourceNotReadyError,
+	isConnectionResetError,
+}
+
+func isRetryableError(err error, predicates ...RetryErrorPredicateFunc) bool {
+	if err == nil {
+		return false
+	}
+	for _, pred := range predicates {
+		if retry, reason := pred(err); retry {
+			log.Printf("[DEBUG] Retrying after error: %s: %s", reason, err)
+			return true
+		}
+	}
+	return false
+}
+
+func getGoogleApiError(err error) (*googleapi.Error, bool) {
+	gerr, ok := errwrap.GetType(err, &googleapi.Error{}).(*googleapi.Error)
+	return gerr, ok && gerr != nil
+}
+
+func isCommonRetryableErrorCode(err error) (bool, string) {
+	gerr, ok := getGoogleApiError(err)
+	if !ok {
+		return false, ""
+	}
+	switch gerr.Code {
+	case 429, 500, 502, 503:
+		return true, fmt.Sprintf("Retryable error code %d", gerr.Code)
+	}
+	return false, ""
+}
+
+// Quota errors are reported as 403s, which are otherwise not retryable.
+func isRateLimitExceededError(err error) (bool, string) {
+	gerr, ok := getGoogleApiError(err)
+	if !ok {
+		return false, ""
+	}
+	for _, e := range gerr.Errors {
+		if e.Reason == "rateLimitExceeded" || e.Reason == "userRateLimitExceeded" {
+			return true, "Rate limit exceeded"
+		}
+	}
+	return false, ""
+}
+
+// Operations on a resource fail while another operation on it, or on one of
+// its dependencies, is still running.
+func isResourceNotReadyError(err error) (bool, string) {
+	gerr, ok := getGoogleApiError(err)
+	if !ok {
+		return false, ""
+	}
+	for _, e := range gerr.Errors {
+		if e.Reason == "resourceNotReady" {
+			return true, "Resource not ready"
+		}
+	}
+	if gerr.Code == 409 && strings.Contains(gerr.Body, "is not ready") {
+		return true, "Resource not ready"
+	}
+	return false, ""
+}
+
+// IAM policies are updated using their etag for optimistic concurrency, so a
+// conflict means that the policy must be read again before retrying. APIs
+// taking the etag as an If-Match precondition fail with 412 instead of 409.
+func isConcurrentPolicyModificationError(err error) (bool, string) {
+	if isConflictError(err) || isPreconditionFailedError(err) {
+		return true, "Concurrent policy changes"
+	}
+	return false, ""
+}
+
+func isConnectionResetError(err error) (bool, string) {
+	if err == io.ErrUnexpectedEOF {
+		return true, "Connection closed unexpectedly"
+	}
+	if strings.Contains(err.Error(), "connection reset by peer") {
+		return true, "Connection reset"
+	}
+	return false, ""
+}
@@ -0,0 +1,311 @@
+package google
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/api/googleapi"
+)
+
+const (
+	awsSigningAlgorithm        = "AWS4-HMAC-SHA256"
+	awsRequestType             = "aws4_request"
+	awsTimeFormat              = "20060102T150405Z"
+	awsDateFormat              = "20060102"
+	awsMetadataTimeout         = 10 * time.Second
+	awsMetadataTokenTTLHeader  = "X-aws-ec2-metadata-token-ttl-seconds"
+	awsMetadataTokenHeader     = "X-aws-ec2-metadata-token"
+	awsMetadataTokenTTLSeconds = "300"
+)
+
+// awsSecurityCredentials are the credentials of an AWS identity, as returned
+// by the instance metadata.
+type awsSecurityCredentials struct {
+	AccessKeyID     string `json:"AccessKeyId"`
+	SecretAccessKey string `json:"SecretAccessKey"`
+	SessionToken    string `json:"Token"`
+}
+
+// awsRequestHeader is a header of the signed request of an AWS subject token.
+type awsRequestHeader struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// awsRequest is the AWS subject token: a GetCallerIdentity request signed
+// with the AWS credentials, which the Security Token Service sends to AWS to
+// verify the identity.
+type awsRequest struct {
+	URL     string             `json:"url"`
+	Method  string             `json:"method"`
+	Headers []awsRequestHeader `json:"headers"`
+}
+
+func (s externalAccountCredentialSource) isAws() bool {
+	return strings.HasPrefix(s.EnvironmentID, awsEnvironmentIdPrefix)
+}
+
+// awsSubjectToken returns a GetCallerIdentity request signed with the AWS
+// credentials of the environment, or of the role of the EC2 instance.
+func (s *externalAccountTokenSource) awsSubjectToken() (string, error) {
+	source := s.account.CredentialSource
+
+	// IMDSv2 requires a session token for every metadata request.
+	metadataHeaders := make(http.Header)
+	if source.IMDSv2SessionTokenURL != "" && s.awsNeedsMetadata() {
+		req, err := http.NewRequest("PUT", source.IMDSv2SessionTokenURL, nil)
+		if err != nil {
+			return "", err
+		}
+		req.Header.Set(awsMetadataTokenTTLHeader, awsMetadataTokenTTLSeconds)
+		token, err := s.awsMetadata(req)
+		if err != nil {
+			return "", err
+		}
+		metadataHeaders.Set(awsMetadataTokenHeader, token)
+	}
+
+	region, err := s.awsRegion(metadataHeaders)
+	if err != nil {
+		return "", err
+	}
+	creds, err := s.awsSecurityCredentials(metadataHeaders)
+	if err != nil {
+		return "", err
+	}
+
+	rawurl := strings.Replace(source.RegionalCredVerificationURL, "{region}", region, -1)
+	req, err := http.NewRequest("POST", rawurl, nil)
+	if err != nil {
+		return "", err
+	}
+	// Binds the request to the workload identity pool provider, so it can't
+	// be replayed against another one.
+	req.Header.Set("x-goog-cloud-target-resource", s.account.Audience)
+	service := strings.SplitN(req.URL.Host, ".", 2)[0]
+	signAwsRequest(req, region, service, creds, time.Now())
+
+	token := awsRequest{
+		URL:    rawurl,
+		Method: req.Method,
+		Headers: []awsRequestHeader{
+			{Key: "host", Value: req.URL.Host},
+		},
+	}
+	for k := range req.Header {
+		token.Headers = append(token.Headers, awsRequestHeader{Key: k, Value: req.Header.Get(k)})
+	}
+	sort.Slice(token.Headers, func(i, j int) bool {
+		return strings.ToLower(token.Headers[i].Key) < strings.ToLower(token.Headers[j].Key)
+	})
+
+	b, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return url.QueryEscape(string(b)), nil
+}
+
+// awsNeedsMetadata returns whether the region or the credentials are read from
+// the instance metadata instead of the environment.
+func (s *externalAccountTokenSource) awsNeedsMetadata() bool {
+	if os.Getenv("AWS_REGION") == "" && os.Getenv("AWS_DEFAULT_REGION") == "" {
+		return true
+	}
+	return os.Getenv("AWS_ACCESS_KEY_ID") == "" || os.Getenv("AWS_SECRET_ACCESS_KEY") == ""
+}
+
+func (s *externalAccountTokenSource) awsRegion(headers http.Header) (string, error) {
+	if region := os.Getenv("AWS_REGION"); region != "" {
+		return region, nil
+	}
+	if region := os.Getenv("AWS_DEFAULT_REGION"); region != "" {
+		return region, nil
+	}
+
+	source := s.account.CredentialSource
+	if source.RegionURL == "" {
+		return "", fmt.Errorf("Error reading AWS region: AWS_REGION isn't set and the credential source has no 'region_url'")
+	}
+	req, err := http.NewRequest("GET", source.RegionURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header = headers
+	zone, err := s.awsMetadata(req)
+	if err != nil {
+		return "", err
+	}
+	if zone == "" {
+		return "", fmt.Errorf("Error reading AWS region: %s returned no availability zone", source.RegionURL)
+	}
+	// e.g. us-east-2b is a zone of the us-east-2 region.
+	return zone[:len(zone)-1], nil
+}
+
+func (s *externalAccountTokenSource) awsSecurityCredentials(headers http.Header) (awsSecurityCredentials, error) {
+	accessKeyID := os.Getenv("AWS_ACCESS_KEY_ID")
+	secretAccessKey := os.Getenv("AWS_SECRET_ACCESS_KEY")
+	if accessKeyID != "" && secretAccessKey != "" {
+		return awsSecurityCredentials{
+			AccessKeyID:     accessKeyID,
+			SecretAccessKey: secretAccessKey,
+			SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		}, nil
+	}
+
+	source := s.account.CredentialSource
+	if source.URL == "" {
+		return awsSecurityCredentials{}, fmt.Errorf("Error reading AWS credentials: AWS_ACCESS_KEY_ID isn't set and the credential source has no 'url'")
+	}
+
+	// The metadata lists the role of the instance, whose credentials are read
+	// from a URL named after it.
+	req, err := http.NewRequest("GET", source.URL, nil)
+	if err != nil {
+		return awsSecurityCredentials{}, err
+	}
+	req.Header = headers
+	role, err := s.awsMetadata(req)
+	if err != nil {
+		return awsSecurityCredentials{}, err
+	}
+	if role == "" {
+		return awsSecurityCredentials{}, fmt.Errorf("Error reading AWS credentials: %s returned no role", source.URL)
+	}
+
+	req, err = http.NewRequest("GET", strings.TrimSuffix(source.URL, "/")+"/"+role, nil)
+	if err != nil {
+		return awsSecurityCredentials{}, err
+	}
+	req.Header = headers
+	b, err := s.awsMetadata(req)
+	if err != nil {
+		return awsSecurityCredentials{}, err
+	}
+	var creds awsSecurityCredentials
+	if err := json.Unmarshal([]byte(b), &creds); err != nil {
+		return awsSecurityCredentials{}, fmt.Errorf("Error parsing AWS credentials of role %s: %s", role, err)
+	}
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return awsSecurityCredentials{}, fmt.Errorf("Error parsing AWS credentials of role %s: missing access key", role)
+	}
+	return creds, nil
+}
+
+// awsMetadata sends a request to the instance metadata, and returns the body
+// of the response.
+func (s *externalAccountTokenSource) awsMetadata(req *http.Request) (string, error) {
+	res, err := s.metadataClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("Error reading AWS metadata from %s: %s", req.URL, err)
+	}
+	defer googleapi.CloseBody(res)
+	if err := googleapi.CheckResponse(res); err != nil {
+		return "", fmt.Errorf("Error reading AWS metadata from %s: %s", req.URL, err)
+	}
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+// signAwsRequest signs a request without a body with AWS Signature Version 4,
+// see https://docs.aws.amazon.com/general/latest/gr/sigv4_signing.html.
+func signAwsRequest(req *http.Request, region, service string, creds awsSecurityCredentials, now time.Time) {
+	now = now.UTC()
+	req.Header.Set("X-Amz-Date", now.Format(awsTimeFormat))
+	if creds.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", creds.SessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k := range req.Header {
+		headers[strings.ToLower(k)] = strings.Join(strings.Fields(req.Header.Get(k)), " ")
+	}
+	var signedHeaders []string
+	for k := range headers {
+		signedHeaders = append(signedHeaders, k)
+	}
+	sort.Strings(signedHeaders)
+	var canonicalHeaders string
+	for _, k := range signedHeaders {
+		canonicalHeaders += k + ":" + headers[k] + "\n"
+	}
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		awsCanonicalQuery(req.URL.Query()),
+		canonicalHeaders,
+		strings.Join(signedHeaders, ";"),
+		awsSha256Hex(""),
+	}, "\n")
+
+	date := now.Format(awsDateFormat)
+	scope := strings.Join([]string{date, region, service, awsRequestType}, "/")
+	stringToSign := strings.Join([]string{
+		awsSigningAlgorithm,
+		now.Format(awsTimeFormat),
+		scope,
+		awsSha256Hex(canonicalRequest),
+	}, "\n")
+
+	key := []byte("AWS4" + creds.SecretAccessKey)
+	for _, v := range []string{date, region, service, awsRequestType} {
+		key = awsHmacSha256(key, v)
+	}
+	signature := hex.EncodeToString(awsHmacSha256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		awsSigningAlgorithm, creds.AccessKeyID, scope, strings.Join(signedHeaders, ";"), signature))
+}
+
+// awsCanonicalQuery returns the query parameters sorted by name then value,
+// with spaces escaped as %20.
+func awsCanonicalQuery(query url.Values) string {
+	var keys []string
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var params []string
+	for _, k := range keys {
+		values := query[k]
+		sort.Strings(values)
+		for _, v := range values {
+			params = append(params, awsEscape(k)+"="+awsEscape(v))
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+func awsEscape(s string) string {
+	return strings.Replace(url.QueryEscape(s), "+", "%20", -1)
+}
+
+func awsSha256Hex(s string) string {
+	h := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(h[:])
+}
+
+func awsHmacSha256(key []byte, s string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(s))
+	return h.Sum(nil)
+}
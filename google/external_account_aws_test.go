@@ -0,0 +1,138 @@
+package google
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// The get-vanilla case of the AWS Signature Version 4 test suite.
+func TestSignAwsRequest(t *testing.T) {
+	req, err := http.NewRequest("GET", "https://example.amazonaws.com/", nil)
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+	creds := awsSecurityCredentials{
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+	}
+	signAwsRequest(req, "us-east-1", "service", creds, time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC))
+
+	expected := "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31"
+	if actual := req.Header.Get("Authorization"); actual != expected {
+		t.Errorf("expected Authorization %q, got %q", expected, actual)
+	}
+	if actual := req.Header.Get("X-Amz-Date"); actual != "20150830T123600Z" {
+		t.Errorf("expected X-Amz-Date %q, got %q", "20150830T123600Z", actual)
+	}
+}
+
+func TestExternalAccountTokenSource_aws(t *testing.T) {
+	for _, k := range []string{"AWS_REGION", "AWS_DEFAULT_REGION", "AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY", "AWS_SESSION_TOKEN"} {
+		if v, ok := os.LookupEnv(k); ok {
+			defer os.Setenv(k, v)
+			os.Unsetenv(k)
+		}
+	}
+
+	metadata := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/latest/api/token" {
+			if r.Method != "PUT" || r.Header.Get(awsMetadataTokenTTLHeader) == "" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.Write([]byte("session-token"))
+			return
+		}
+		if r.Header.Get(awsMetadataTokenHeader) != "session-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/latest/meta-data/placement/availability-zone":
+			w.Write([]byte("us-east-2b"))
+		case "/latest/meta-data/iam/security-credentials":
+			w.Write([]byte("my-role"))
+		case "/latest/meta-data/iam/security-credentials/my-role":
+			w.Write([]byte(`{"AccessKeyId": "access-key", "SecretAccessKey": "secret", "Token": "aws-session-token"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer metadata.Close()
+
+	var subjectToken string
+	sts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("error parsing form: %s", err)
+		}
+		subjectToken = r.PostForm.Get("subject_token")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "federated-token", "token_type": "Bearer", "expires_in": 3600}`))
+	}))
+	defer sts.Close()
+
+	audience := "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/pool/providers/aws"
+	account := accountFile{
+		Type:             externalAccountCredentialsType,
+		Audience:         audience,
+		SubjectTokenType: "urn:ietf:params:aws:token-type:aws4_request",
+		TokenURL:         sts.URL,
+		CredentialSource: externalAccountCredentialSource{
+			EnvironmentID:               "aws1",
+			RegionURL:                   metadata.URL + "/latest/meta-data/placement/availability-zone",
+			URL:                         metadata.URL + "/latest/meta-data/iam/security-credentials",
+			RegionalCredVerificationURL: "https://sts.{region}.amazonaws.com?Action=GetCallerIdentity&Version=2011-06-15",
+			IMDSv2SessionTokenURL:       metadata.URL + "/latest/api/token",
+		},
+	}
+
+	ts, err := newExternalAccountTokenSource(account, defaultClientScopes)
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+	token, err := ts.Token()
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+	if token.AccessToken != "federated-token" {
+		t.Errorf("expected access token %q, got %q", "federated-token", token.AccessToken)
+	}
+
+	decoded, err := url.QueryUnescape(subjectToken)
+	if err != nil {
+		t.Fatalf("error decoding subject token: %s", err)
+	}
+	var req awsRequest
+	if err := json.Unmarshal([]byte(decoded), &req); err != nil {
+		t.Fatalf("error parsing subject token %s: %s", decoded, err)
+	}
+	if expected := "https://sts.us-east-2.amazonaws.com?Action=GetCallerIdentity&Version=2011-06-15"; req.URL != expected {
+		t.Errorf("expected url %q, got %q", expected, req.URL)
+	}
+	if req.Method != "POST" {
+		t.Errorf("expected method POST, got %q", req.Method)
+	}
+	headers := make(map[string]string)
+	for _, h := range req.Headers {
+		headers[strings.ToLower(h.Key)] = h.Value
+	}
+	expected := map[string]string{
+		"host":                         "sts.us-east-2.amazonaws.com",
+		"x-amz-security-token":         "aws-session-token",
+		"x-goog-cloud-target-resource": audience,
+	}
+	for k, v := range expected {
+		if headers[k] != v {
+			t.Errorf("expected header %s to be %q, got %q", k, v, headers[k])
+		}
+	}
+	if auth := headers["authorization"]; !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=access-key/") || !strings.Contains(auth, "/us-east-2/sts/aws4_request") {
+		t.Errorf("unexpected Authorization header %q", auth)
+	}
+}
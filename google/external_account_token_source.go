@@ -0,0 +1,223 @@
+package google
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/logging"
+	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
+)
+
+const (
+	tokenExchangeGrantType  = "urn:ietf:params:oauth:grant-type:token-exchange"
+	accessTokenTokenType    = "urn:ietf:params:oauth:token-type:access_token"
+	cloudPlatformScope      = "https://www.googleapis.com/auth/cloud-platform"
+	defaultSecurityTokenURL = "https://sts.googleapis.com/v1/token"
+	subjectTokenFormatText  = "text"
+	subjectTokenFormatJSON  = "json"
+	awsEnvironmentIdPrefix  = "aws"
+	awsEnvironmentId        = "aws1"
+)
+
+// externalAccountCredentialSource describes where the token of the external
+// identity is read from, as found in the `credential_source` block of an
+// `external_account` credentials file.
+type externalAccountCredentialSource struct {
+	EnvironmentID string            `json:"environment_id"`
+	File          string            `json:"file"`
+	URL           string            `json:"url"`
+	Headers       map[string]string `json:"headers"`
+	Format        struct {
+		Type                  string `json:"type"`
+		SubjectTokenFieldName string `json:"subject_token_field_name"`
+	} `json:"format"`
+
+	// AWS credential sources only. URL is then the metadata URL of the
+	// security credentials of the instance role.
+	RegionURL                   string `json:"region_url"`
+	RegionalCredVerificationURL string `json:"regional_cred_verification_url"`
+	IMDSv2SessionTokenURL       string `json:"imdsv2_session_token_url"`
+}
+
+// externalAccountTokenSource implements workload identity federation: it
+// exchanges a token issued by an external identity provider for a Google
+// access token using the Security Token Service, then optionally uses that
+// token to impersonate a service account.
+type externalAccountTokenSource struct {
+	client  *http.Client
+	account accountFile
+	scopes  []string
+
+	// Used to read AWS credentials from the instance metadata, which mustn't
+	// be logged.
+	metadataClient *http.Client
+}
+
+// newExternalAccountTokenSource returns a caching token source for the given
+// `external_account` credentials file.
+func newExternalAccountTokenSource(account accountFile, scopes []string) (oauth2.TokenSource, error) {
+	if account.Audience == "" {
+		return nil, fmt.Errorf("Error parsing external account credentials: missing 'audience' field")
+	}
+	if account.SubjectTokenType == "" {
+		return nil, fmt.Errorf("Error parsing external account credentials: missing 'subject_token_type' field")
+	}
+
+	source := account.CredentialSource
+	if source.isAws() {
+		if source.EnvironmentID != awsEnvironmentId {
+			return nil, fmt.Errorf("Error parsing external account credentials: unsupported AWS credential source version %q", source.EnvironmentID)
+		}
+		if source.RegionalCredVerificationURL == "" {
+			return nil, fmt.Errorf("Error parsing external account credentials: missing 'regional_cred_verification_url' for the AWS credential source")
+		}
+	} else if source.File == "" && source.URL == "" {
+		return nil, fmt.Errorf("Error parsing external account credentials: 'credential_source' must contain one of 'file' or 'url'")
+	}
+	switch source.Format.Type {
+	case "", subjectTokenFormatText:
+	case subjectTokenFormatJSON:
+		if source.Format.SubjectTokenFieldName == "" {
+			return nil, fmt.Errorf("Error parsing external account credentials: missing 'subject_token_field_name' for the 'json' format")
+		}
+	default:
+		return nil, fmt.Errorf("Error parsing external account credentials: unsupported credential source format %q", source.Format.Type)
+	}
+
+	client := &http.Client{
+		Transport: logging.NewTransport("Google", http.DefaultTransport),
+	}
+
+	var ts oauth2.TokenSource = &externalAccountTokenSource{
+		client:         client,
+		account:        account,
+		scopes:         scopes,
+		metadataClient: &http.Client{Timeout: awsMetadataTimeout},
+	}
+
+	if account.ServiceAccountImpersonationURL != "" {
+		impersonatedClient := oauth2.NewClient(context.Background(), oauth2.ReuseTokenSource(nil, ts))
+		impersonatedClient.Transport = logging.NewTransport("Google", impersonatedClient.Transport)
+		ts = &impersonatedTokenSource{
+			client: impersonatedClient,
+			url:    account.ServiceAccountImpersonationURL,
+			target: account.ServiceAccountImpersonationURL,
+			scopes: scopes,
+		}
+	}
+
+	return oauth2.ReuseTokenSource(nil, ts), nil
+}
+
+type securityTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+func (s *externalAccountTokenSource) Token() (*oauth2.Token, error) {
+	subjectToken, err := s.subjectToken()
+	if err != nil {
+		return nil, err
+	}
+
+	// When impersonating a service account, the federated token only needs to
+	// be allowed to call the IAM Credentials API.
+	scopes := s.scopes
+	if s.account.ServiceAccountImpersonationURL != "" {
+		scopes = []string{cloudPlatformScope}
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", tokenExchangeGrantType)
+	form.Set("audience", s.account.Audience)
+	form.Set("scope", strings.Join(scopes, " "))
+	form.Set("requested_token_type", accessTokenTokenType)
+	form.Set("subject_token_type", s.account.SubjectTokenType)
+	form.Set("subject_token", subjectToken)
+
+	tokenURL := s.account.TokenURL
+	if tokenURL == "" {
+		tokenURL = defaultSecurityTokenURL
+	}
+
+	res, err := s.client.PostForm(tokenURL, form)
+	if err != nil {
+		return nil, fmt.Errorf("Error exchanging external account token: %s", err)
+	}
+	defer googleapi.CloseBody(res)
+	if err := googleapi.CheckResponse(res); err != nil {
+		return nil, fmt.Errorf("Error exchanging external account token: %s", err)
+	}
+
+	var token securityTokenResponse
+	if err := json.NewDecoder(res.Body).Decode(&token); err != nil {
+		return nil, err
+	}
+
+	return &oauth2.Token{
+		AccessToken: token.AccessToken,
+		TokenType:   token.TokenType,
+		Expiry:      time.Now().Add(time.Duration(token.ExpiresIn) * time.Second),
+	}, nil
+}
+
+// subjectToken reads the token of the external identity from the configured
+// file or URL, or signs one with the AWS credentials of the environment.
+func (s *externalAccountTokenSource) subjectToken() (string, error) {
+	source := s.account.CredentialSource
+	if source.isAws() {
+		return s.awsSubjectToken()
+	}
+
+	var contents []byte
+	if source.File != "" {
+		b, err := ioutil.ReadFile(source.File)
+		if err != nil {
+			return "", fmt.Errorf("Error reading external account subject token from %s: %s", source.File, err)
+		}
+		contents = b
+	} else {
+		req, err := http.NewRequest("GET", source.URL, nil)
+		if err != nil {
+			return "", err
+		}
+		for k, v := range source.Headers {
+			req.Header.Set(k, v)
+		}
+		res, err := s.client.Do(req)
+		if err != nil {
+			return "", fmt.Errorf("Error reading external account subject token from %s: %s", source.URL, err)
+		}
+		defer googleapi.CloseBody(res)
+		if err := googleapi.CheckResponse(res); err != nil {
+			return "", fmt.Errorf("Error reading external account subject token from %s: %s", source.URL, err)
+		}
+		b, err := ioutil.ReadAll(res.Body)
+		if err != nil {
+			return "", err
+		}
+		contents = b
+	}
+
+	if source.Format.Type != subjectTokenFormatJSON {
+		return strings.TrimSpace(string(contents)), nil
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(contents, &fields); err != nil {
+		return "", fmt.Errorf("Error parsing external account subject token: %s", err)
+	}
+	token, ok := fields[source.Format.SubjectTokenFieldName].(string)
+	if !ok || token == "" {
+		return "", fmt.Errorf("Error parsing external account subject token: field %q not found", source.Format.SubjectTokenFieldName)
+	}
+	return token, nil
+}
@@ -0,0 +1,102 @@
+package google
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func TestExternalAccountTokenSource_file(t *testing.T) {
+	f, err := ioutil.TempFile("", "subject-token")
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.WriteString(`{"id_token": "external-token"}`); err != nil {
+		t.Fatalf("error: %s", err)
+	}
+	f.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("error parsing form: %s", err)
+		}
+		expected := map[string]string{
+			"grant_type":         tokenExchangeGrantType,
+			"audience":           "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/pool/providers/provider",
+			"subject_token_type": "urn:ietf:params:oauth:token-type:jwt",
+			"subject_token":      "external-token",
+			"scope":              "https://www.googleapis.com/auth/compute https://www.googleapis.com/auth/devstorage.full_control",
+		}
+		for k, v := range expected {
+			if actual := r.PostForm.Get(k); actual != v {
+				t.Errorf("expected %s to be %q, got %q", k, v, actual)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "federated-token", "token_type": "Bearer", "expires_in": 3600}`))
+	}))
+	defer server.Close()
+
+	account := accountFile{
+		Type:             externalAccountCredentialsType,
+		Audience:         "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/pool/providers/provider",
+		SubjectTokenType: "urn:ietf:params:oauth:token-type:jwt",
+		TokenURL:         server.URL,
+	}
+	account.CredentialSource.File = f.Name()
+	account.CredentialSource.Format.Type = subjectTokenFormatJSON
+	account.CredentialSource.Format.SubjectTokenFieldName = "id_token"
+
+	ts, err := newExternalAccountTokenSource(account, []string{
+		"https://www.googleapis.com/auth/compute",
+		"https://www.googleapis.com/auth/devstorage.full_control",
+	})
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	token, err := ts.Token()
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+	if token.AccessToken != "federated-token" {
+		t.Errorf("expected access token %q, got %q", "federated-token", token.AccessToken)
+	}
+}
+
+func TestExternalAccountTokenSource_invalid(t *testing.T) {
+	cases := map[string]accountFile{
+		"missing audience": {
+			SubjectTokenType: "urn:ietf:params:oauth:token-type:jwt",
+		},
+		"missing credential source": {
+			Audience:         "audience",
+			SubjectTokenType: "urn:ietf:params:oauth:token-type:jwt",
+		},
+		"unsupported aws version": {
+			Audience:         "audience",
+			SubjectTokenType: "urn:ietf:params:aws:token-type:aws4_request",
+			CredentialSource: externalAccountCredentialSource{
+				EnvironmentID:               "aws2",
+				RegionalCredVerificationURL: "https://sts.{region}.amazonaws.com?Action=GetCallerIdentity&Version=2011-06-15",
+			},
+		},
+		"missing aws verification url": {
+			Audience:         "audience",
+			SubjectTokenType: "urn:ietf:params:aws:token-type:aws4_request",
+			CredentialSource: externalAccountCredentialSource{
+				EnvironmentID: "aws1",
+				URL:           "http://169.254.169.254/latest/meta-data/iam/security-credentials",
+			},
+		},
+	}
+
+	for tn, account := range cases {
+		if _, err := newExternalAccountTokenSource(account, defaultClientScopes); err == nil {
+			t.Errorf("%s: expected error, got nil", tn)
+		}
+	}
+}
@@ -0,0 +1,836 @@
+package fakegcp
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+const computeCreationTimestamp = "2018-01-01T00:00:00.000-00:00"
+
+// computeScope identifies where a Compute resource lives in a project:
+// `global`, `regions/<region>` or `zones/<zone>`.
+type computeScope struct {
+	project string
+	kind    string
+	name    string
+}
+
+func (c computeScope) String() string {
+	if c.kind == "global" {
+		return "global"
+	}
+	return c.kind + "/" + c.name
+}
+
+// region returns the region of a regional or zonal scope.
+func (c computeScope) region() string {
+	if c.kind == "zones" {
+		return c.name[:strings.LastIndex(c.name, "-")]
+	}
+	return c.name
+}
+
+// A computeCollection is a kind of Compute resource supported by the fake.
+type computeCollection struct {
+	kind string
+	// The scopes the resources can be created in.
+	scopes []string
+
+	// insert fills in the fields computed by the API when a resource is
+	// created, after its references have been normalized.
+	insert func(s *Server, c computeScope, obj map[string]interface{}) *apiError
+	// delete releases what the resource owns when it is deleted.
+	delete func(s *Server, c computeScope, obj map[string]interface{})
+	// methods are the custom methods of the resource, such as
+	// `setLabels`, called with the request body.
+	methods map[string]func(s *Server, c computeScope, obj, body map[string]interface{}, r *http.Request) *apiError
+}
+
+var computeCollections = map[string]*computeCollection{
+	"networks": {
+		kind:   "compute#network",
+		scopes: []string{"global"},
+		insert: insertNetwork,
+	},
+	"subnetworks": {
+		kind:   "compute#subnetwork",
+		scopes: []string{"regions"},
+		insert: insertSubnetwork,
+		methods: map[string]func(*Server, computeScope, map[string]interface{}, map[string]interface{}, *http.Request) *apiError{
+			"setPrivateIpGoogleAccess": setField("privateIpGoogleAccess"),
+			"expandIpCidrRange":        setField("ipCidrRange"),
+		},
+	},
+	"firewalls": {
+		kind:   "compute#firewall",
+		scopes: []string{"global"},
+		insert: insertFirewall,
+	},
+	"addresses": {
+		kind:   "compute#address",
+		scopes: []string{"global", "regions"},
+		insert: insertAddress,
+	},
+	"routers": {
+		kind:   "compute#router",
+		scopes: []string{"regions"},
+		insert: insertRouter,
+	},
+	"disks": diskCollection,
+	"instances": {
+		kind:   "compute#instance",
+		scopes: []string{"zones"},
+		insert: insertInstance,
+		delete: deleteInstance,
+		methods: map[string]func(*Server, computeScope, map[string]interface{}, map[string]interface{}, *http.Request) *apiError{
+			"setLabels":             setLabels,
+			"setMetadata":           setFingerprinted("metadata", "compute#metadata"),
+			"setTags":               setFingerprinted("tags", ""),
+			"setScheduling":         setBody("scheduling"),
+			"setDeletionProtection": setDeletionProtection,
+			"start":                 setStatus("RUNNING"),
+			"stop":                  setStatus("TERMINATED"),
+		},
+	},
+}
+
+// Disks are also created along with instances.
+var diskCollection = &computeCollection{
+	kind:   "compute#disk",
+	scopes: []string{"zones"},
+	insert: insertDisk,
+}
+
+// computeReferences lists the fields of each kind of resource holding links
+// to other resources, and the collection they link to. The API accepts
+// partial links, which it returns as self links.
+var computeReferences = map[string]map[string]string{
+	"subnetworks": {"network": "networks"},
+	"firewalls":   {"network": "networks"},
+	"addresses":   {"network": "networks", "subnetwork": "subnetworks"},
+	"routers":     {"network": "networks"},
+	"disks":       {"type": "diskTypes", "sourceImage": "images"},
+	"instances":   {"machineType": "machineTypes"},
+}
+
+func (s *Server) handleCompute(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// /compute/<version>/projects/<project>/...
+	segments := splitPath(r, "/compute/")
+	if len(segments) < 3 || segments[1] != "projects" {
+		writeError(w, notImplemented(r))
+		return
+	}
+
+	res, err := s.routeCompute(r, segments[2], segments[3:])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (s *Server) routeCompute(r *http.Request, project string, path []string) (map[string]interface{}, *apiError) {
+	if len(path) == 0 {
+		if r.Method != http.MethodGet {
+			return nil, notImplemented(r)
+		}
+		return s.getComputeProject(project)
+	}
+
+	if path[0] == "aggregated" {
+		if len(path) != 2 || r.Method != http.MethodGet {
+			return nil, notImplemented(r)
+		}
+		coll, ok := computeCollections[path[1]]
+		if !ok {
+			return nil, notImplemented(r)
+		}
+		return s.listAggregatedCompute(project, path[1], coll), nil
+	}
+
+	var c computeScope
+	switch path[0] {
+	case "global":
+		c, path = computeScope{project: project, kind: "global"}, path[1:]
+	case "regions", "zones":
+		if len(path) < 2 {
+			return nil, notImplemented(r)
+		}
+		c, path = computeScope{project: project, kind: path[0], name: path[1]}, path[2:]
+	default:
+		return nil, notImplemented(r)
+	}
+
+	if len(path) == 0 {
+		if r.Method != http.MethodGet || c.kind == "global" {
+			return nil, notImplemented(r)
+		}
+		return s.getComputeLocation(c), nil
+	}
+
+	if r.Method == http.MethodGet {
+		switch {
+		case len(path) == 2 && path[0] == "operations":
+			op, ok := s.operations[s.computePath(c, "operations", path[1])]
+			if !ok {
+				return nil, notFound("The resource '%s' was not found", r.URL.Path)
+			}
+			return op, nil
+		case len(path) == 2 && (path[0] == "machineTypes" || path[0] == "diskTypes") && c.kind == "zones":
+			return s.getComputeType(c, path[0], path[1]), nil
+		case path[0] == "images" && c.kind == "global":
+			return s.getImage(c, path[1:])
+		}
+	}
+
+	coll, ok := computeCollections[path[0]]
+	if !ok || !coll.inScope(c) {
+		return nil, notImplemented(r)
+	}
+
+	switch {
+	case len(path) == 1 && r.Method == http.MethodGet:
+		return s.listCompute(c, path[0], coll), nil
+	case len(path) == 1 && r.Method == http.MethodPost:
+		body, err := readObject(r)
+		if err != nil {
+			return nil, err
+		}
+		return s.insertCompute(c, path[0], coll, body)
+	case len(path) == 2:
+		return s.updateCompute(r, c, path[0], path[1], coll)
+	case len(path) == 3 && r.Method == http.MethodPost:
+		return s.callComputeMethod(r, c, path[0], path[1], path[2], coll)
+	}
+	return nil, notImplemented(r)
+}
+
+func (coll *computeCollection) inScope(c computeScope) bool {
+	for _, scope := range coll.scopes {
+		if scope == c.kind {
+			return true
+		}
+	}
+	return false
+}
+
+// computePath returns the path of a Compute resource in the store.
+func (s *Server) computePath(c computeScope, collection, name string) string {
+	return fmt.Sprintf("compute/projects/%s/%s/%s/%s", c.project, c, collection, name)
+}
+
+// computeSelfLink returns the self link of the Compute resource stored at
+// path.
+func (s *Server) computeSelfLink(path string) string {
+	return s.URL() + "/compute/v1/" + strings.TrimPrefix(path, "compute/")
+}
+
+// computePathFromLink returns the path in the store of the Compute resource
+// with the given self link, in any API version.
+func (s *Server) computePathFromLink(link string) string {
+	path := strings.TrimPrefix(link, s.URL()+"/compute/")
+	if i := strings.Index(path, "/projects/"); i >= 0 {
+		path = path[i+1:]
+	}
+	return "compute/" + path
+}
+
+// computeLink turns the reference to a resource of the collection into its
+// self link. References may be self links, partial links starting at
+// `projects/` or at the scope, or names of resources in the default scope
+// of the collection.
+func (s *Server) computeLink(c computeScope, collection, ref string) string {
+	switch {
+	case ref == "" || strings.HasPrefix(ref, "http://") || strings.HasPrefix(ref, "https://"):
+		return ref
+	case strings.HasPrefix(ref, "projects/"):
+		return s.URL() + "/compute/v1/" + ref
+	case strings.HasPrefix(ref, "global/"), strings.HasPrefix(ref, "regions/"), strings.HasPrefix(ref, "zones/"):
+		return fmt.Sprintf("%s/compute/v1/projects/%s/%s", s.URL(), c.project, ref)
+	}
+
+	scope := c
+	switch collection {
+	case "networks", "images":
+		scope = computeScope{project: c.project, kind: "global"}
+	case "subnetworks":
+		scope = computeScope{project: c.project, kind: "regions", name: c.region()}
+	}
+	return s.computeSelfLink(s.computePath(scope, collection, ref))
+}
+
+// requireComputeResource checks that the resource linked to exists, like the
+// API does for references to resources managed by the fake.
+func (s *Server) requireComputeResource(link string) (map[string]interface{}, *apiError) {
+	obj, ok := s.resources[s.computePathFromLink(link)]
+	if !ok {
+		return nil, notFound("The resource '%s' was not found", strings.TrimPrefix(link, s.URL()+"/compute/v1/"))
+	}
+	return obj, nil
+}
+
+func (s *Server) normalizeComputeReferences(c computeScope, collection string, obj map[string]interface{}) {
+	for field, target := range computeReferences[collection] {
+		if ref, ok := obj[field].(string); ok {
+			obj[field] = s.computeLink(c, target, ref)
+		}
+	}
+}
+
+// computeOperation records an operation on the resource at path, which is
+// done as soon as it starts.
+func (s *Server) computeOperation(c computeScope, operationType, path string) map[string]interface{} {
+	id := s.next()
+	name := fmt.Sprintf("operation-%d", id)
+	opPath := s.computePath(c, "operations", name)
+	op := map[string]interface{}{
+		"kind":          "compute#operation",
+		"id":            fmt.Sprint(id),
+		"name":          name,
+		"operationType": operationType,
+		"targetLink":    s.computeSelfLink(path),
+		"status":        "DONE",
+		"progress":      100,
+		"insertTime":    computeCreationTimestamp,
+		"selfLink":      s.computeSelfLink(opPath),
+	}
+	switch c.kind {
+	case "regions":
+		op["region"] = s.computeSelfLink(fmt.Sprintf("compute/projects/%s/regions/%s", c.project, c.name))
+	case "zones":
+		op["zone"] = s.computeSelfLink(fmt.Sprintf("compute/projects/%s/zones/%s", c.project, c.name))
+	}
+	s.operations[opPath] = op
+	return op
+}
+
+func (s *Server) getComputeProject(project string) (map[string]interface{}, *apiError) {
+	id, ok := s.projectID(project)
+	if !ok {
+		if strings.Trim(project, "0123456789") == "" {
+			return nil, notFound("The resource 'projects/%s' was not found", project)
+		}
+		id = project
+	}
+	return map[string]interface{}{
+		"kind":     "compute#project",
+		"id":       fmt.Sprint(s.projectNumber(id)),
+		"name":     id,
+		"selfLink": s.computeSelfLink("compute/projects/" + id),
+	}, nil
+}
+
+// getComputeLocation returns any region or zone, all of which are up.
+func (s *Server) getComputeLocation(c computeScope) map[string]interface{} {
+	path := fmt.Sprintf("compute/projects/%s/%s", c.project, c)
+	obj := map[string]interface{}{
+		"kind":     "compute#" + strings.TrimSuffix(c.kind, "s"),
+		"id":       fmt.Sprint(s.next()),
+		"name":     c.name,
+		"status":   "UP",
+		"selfLink": s.computeSelfLink(path),
+	}
+	if c.kind == "zones" {
+		obj["region"] = s.computeSelfLink(fmt.Sprintf("compute/projects/%s/regions/%s", c.project, c.region()))
+	}
+	return obj
+}
+
+// getComputeType returns any machine or disk type.
+func (s *Server) getComputeType(c computeScope, collection, name string) map[string]interface{} {
+	obj := map[string]interface{}{
+		"kind":     "compute#" + strings.TrimSuffix(collection, "s"),
+		"id":       fmt.Sprint(s.next()),
+		"name":     name,
+		"zone":     c.name,
+		"selfLink": s.computeSelfLink(s.computePath(c, collection, name)),
+	}
+	if collection == "machineTypes" {
+		obj["guestCpus"] = 1
+		obj["memoryMb"] = 3840
+	} else {
+		obj["defaultDiskSizeGb"] = "10"
+	}
+	return obj
+}
+
+// getImage returns any image, or the image `<family>-v20180101` for any
+// family.
+func (s *Server) getImage(c computeScope, path []string) (map[string]interface{}, *apiError) {
+	var name, family string
+	switch {
+	case len(path) == 1:
+		name = path[0]
+	case len(path) == 2 && path[0] == "family":
+		name, family = path[1]+"-v20180101", path[1]
+	default:
+		return nil, notFound("The resource 'projects/%s/global/images/%s' was not found", c.project, strings.Join(path, "/"))
+	}
+	return map[string]interface{}{
+		"kind":       "compute#image",
+		"id":         fmt.Sprint(s.next()),
+		"name":       name,
+		"family":     family,
+		"status":     "READY",
+		"diskSizeGb": "10",
+		"selfLink":   s.computeSelfLink(s.computePath(c, "images", name)),
+	}, nil
+}
+
+func (s *Server) listCompute(c computeScope, collection string, coll *computeCollection) map[string]interface{} {
+	prefix := s.computePath(c, collection, "")
+	items := []interface{}{}
+	for path, obj := range s.resources {
+		if strings.HasPrefix(path, prefix) {
+			items = append(items, obj)
+		}
+	}
+	return map[string]interface{}{
+		"kind":     coll.kind + "List",
+		"items":    items,
+		"selfLink": s.computeSelfLink(strings.TrimSuffix(prefix, "/")),
+	}
+}
+
+// listAggregatedCompute lists the regional and zonal resources of a
+// collection, keyed by their scope, e.g. `zones/us-central1-a`.
+func (s *Server) listAggregatedCompute(project, collection string, coll *computeCollection) map[string]interface{} {
+	items := make(map[string]interface{})
+	prefix := fmt.Sprintf("compute/projects/%s/", project)
+	for path, obj := range s.resources {
+		// compute/projects/<project>/<kind>/<scope>/<collection>/<name>
+		segments := strings.Split(strings.TrimPrefix(path, prefix), "/")
+		if !strings.HasPrefix(path, prefix) || len(segments) != 4 || segments[2] != collection {
+			continue
+		}
+		scope := segments[0] + "/" + segments[1]
+		scoped, ok := items[scope].(map[string]interface{})
+		if !ok {
+			scoped = map[string]interface{}{collection: []interface{}{}}
+			items[scope] = scoped
+		}
+		scoped[collection] = append(scoped[collection].([]interface{}), obj)
+	}
+	return map[string]interface{}{
+		"kind":     coll.kind + "AggregatedList",
+		"items":    items,
+		"selfLink": s.computeSelfLink(fmt.Sprintf("compute/projects/%s/aggregated/%s", project, collection)),
+	}
+}
+
+func (s *Server) insertCompute(c computeScope, collection string, coll *computeCollection, obj map[string]interface{}) (map[string]interface{}, *apiError) {
+	name, _ := obj["name"].(string)
+	if name == "" {
+		return nil, badRequest("Invalid value for field 'resource.name': ''. Must be a match of regex '(?:[a-z](?:[-a-z0-9]{0,61}[a-z0-9])?)'")
+	}
+	path := s.computePath(c, collection, name)
+	if _, ok := s.resources[path]; ok {
+		return nil, alreadyExists("The resource '%s' already exists", strings.TrimPrefix(path, "compute/"))
+	}
+
+	obj["kind"] = coll.kind
+	obj["id"] = fmt.Sprint(s.next())
+	obj["creationTimestamp"] = computeCreationTimestamp
+	obj["selfLink"] = s.computeSelfLink(path)
+	switch c.kind {
+	case "regions":
+		obj["region"] = s.computeSelfLink(fmt.Sprintf("compute/projects/%s/regions/%s", c.project, c.name))
+	case "zones":
+		obj["zone"] = s.computeSelfLink(fmt.Sprintf("compute/projects/%s/zones/%s", c.project, c.name))
+	}
+	s.normalizeComputeReferences(c, collection, obj)
+	if coll.insert != nil {
+		if err := coll.insert(s, c, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	s.resources[path] = obj
+	return s.computeOperation(c, "insert", path), nil
+}
+
+func (s *Server) updateCompute(r *http.Request, c computeScope, collection, name string, coll *computeCollection) (map[string]interface{}, *apiError) {
+	path := s.computePath(c, collection, name)
+	obj, ok := s.resources[path]
+	if !ok {
+		return nil, notFound("The resource '%s' was not found", strings.TrimPrefix(path, "compute/"))
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		return obj, nil
+	case http.MethodDelete:
+		if coll.delete != nil {
+			coll.delete(s, c, obj)
+		}
+		delete(s.resources, path)
+		return s.computeOperation(c, "delete", path), nil
+	case http.MethodPatch, http.MethodPut:
+		body, err := readObject(r)
+		if err != nil {
+			return nil, err
+		}
+		// Fields computed by the API can't be changed.
+		for _, field := range []string{"kind", "id", "name", "creationTimestamp", "selfLink", "region", "zone"} {
+			body[field] = obj[field]
+		}
+		if r.Method == http.MethodPut {
+			for k := range obj {
+				delete(obj, k)
+			}
+		}
+		merge(obj, body)
+		s.normalizeComputeReferences(c, collection, obj)
+		if _, ok := obj["fingerprint"]; ok {
+			obj["fingerprint"] = s.fingerprint()
+		}
+		return s.computeOperation(c, strings.ToLower(r.Method), path), nil
+	}
+	return nil, notImplemented(r)
+}
+
+func (s *Server) callComputeMethod(r *http.Request, c computeScope, collection, name, method string, coll *computeCollection) (map[string]interface{}, *apiError) {
+	f, ok := coll.methods[method]
+	if !ok {
+		return nil, notImplemented(r)
+	}
+
+	path := s.computePath(c, collection, name)
+	obj, ok := s.resources[path]
+	if !ok {
+		return nil, notFound("The resource '%s' was not found", strings.TrimPrefix(path, "compute/"))
+	}
+	body, err := readObject(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := f(s, c, obj, body, r); err != nil {
+		return nil, err
+	}
+	return s.computeOperation(c, method, path), nil
+}
+
+// nextAddress returns a new IP address in the given /16 range, e.g. `10.128`.
+func (s *Server) nextAddress(prefix string) string {
+	n := s.next()
+	return fmt.Sprintf("%s.%d.%d", prefix, n/254%256, n%254+1)
+}
+
+func insertNetwork(s *Server, c computeScope, obj map[string]interface{}) *apiError {
+	if _, ok := obj["routingConfig"]; !ok {
+		obj["routingConfig"] = map[string]interface{}{"routingMode": "REGIONAL"}
+	}
+	if cidr, ok := obj["IPv4Range"].(string); ok {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return badRequest("Invalid value for field 'resource.IPv4Range': '%s'", cidr)
+		}
+		gateway := ipNet.IP.To4()
+		gateway[3]++
+		obj["gatewayIPv4"] = gateway.String()
+	}
+	return nil
+}
+
+func insertSubnetwork(s *Server, c computeScope, obj map[string]interface{}) *apiError {
+	network, _ := obj["network"].(string)
+	if _, err := s.requireComputeResource(network); err != nil {
+		return err
+	}
+
+	cidr, _ := obj["ipCidrRange"].(string)
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return badRequest("Invalid value for field 'resource.ipCidrRange': '%s'. Invalid IPCidrRange.", cidr)
+	}
+	gateway := ipNet.IP.To4()
+	gateway[3]++
+	obj["gatewayAddress"] = gateway.String()
+	obj["fingerprint"] = s.fingerprint()
+	return nil
+}
+
+func insertFirewall(s *Server, c computeScope, obj map[string]interface{}) *apiError {
+	if _, ok := obj["network"]; !ok {
+		obj["network"] = s.computeLink(c, "networks", "default")
+	} else if _, err := s.requireComputeResource(obj["network"].(string)); err != nil {
+		return err
+	}
+	if _, ok := obj["direction"]; !ok {
+		obj["direction"] = "INGRESS"
+	}
+	if _, ok := obj["priority"]; !ok {
+		obj["priority"] = 1000
+	}
+	return nil
+}
+
+func insertRouter(s *Server, c computeScope, obj map[string]interface{}) *apiError {
+	network, _ := obj["network"].(string)
+	if _, err := s.requireComputeResource(network); err != nil {
+		return err
+	}
+	return nil
+}
+
+func insertAddress(s *Server, c computeScope, obj map[string]interface{}) *apiError {
+	if _, ok := obj["addressType"]; !ok {
+		obj["addressType"] = "EXTERNAL"
+	}
+	if subnetwork, ok := obj["subnetwork"].(string); ok {
+		if _, err := s.requireComputeResource(subnetwork); err != nil {
+			return err
+		}
+	}
+	if _, ok := obj["address"]; !ok {
+		if obj["addressType"] == "INTERNAL" {
+			obj["address"] = s.nextAddress("10.128")
+		} else {
+			obj["address"] = s.nextAddress("35.200")
+		}
+	}
+	obj["status"] = "RESERVED"
+	return nil
+}
+
+func insertDisk(s *Server, c computeScope, obj map[string]interface{}) *apiError {
+	if _, ok := obj["type"]; !ok {
+		obj["type"] = s.computeLink(c, "diskTypes", "pd-standard")
+	}
+	if _, ok := obj["sizeGb"]; !ok {
+		obj["sizeGb"] = "10"
+	}
+	obj["status"] = "READY"
+	return nil
+}
+
+func insertInstance(s *Server, c computeScope, obj map[string]interface{}) *apiError {
+	name := obj["name"].(string)
+	instance := obj["selfLink"].(string)
+
+	disks, _ := obj["disks"].([]interface{})
+	for i, raw := range disks {
+		disk := raw.(map[string]interface{})
+		if disk["type"] == "SCRATCH" {
+			continue
+		}
+		if _, ok := disk["deviceName"]; !ok {
+			disk["deviceName"] = fmt.Sprintf("persistent-disk-%d", i)
+		}
+		if _, ok := disk["mode"]; !ok {
+			disk["mode"] = "READ_WRITE"
+		}
+		disk["type"] = "PERSISTENT"
+		disk["interface"] = "SCSI"
+		disk["index"] = i
+
+		// Disks created along with the instance are named after it.
+		if params, ok := disk["initializeParams"].(map[string]interface{}); ok {
+			diskName, _ := params["diskName"].(string)
+			if diskName == "" {
+				diskName = name
+				if i > 0 {
+					diskName = fmt.Sprintf("%s-%d", name, i)
+				}
+			}
+			created := map[string]interface{}{
+				"name":        diskName,
+				"type":        params["diskType"],
+				"sourceImage": params["sourceImage"],
+			}
+			if size, ok := params["diskSizeGb"]; ok {
+				created["sizeGb"] = size
+			}
+			for k, v := range created {
+				if v == nil {
+					delete(created, k)
+				}
+			}
+			if _, err := s.insertCompute(c, "disks", diskCollection, created); err != nil {
+				return err
+			}
+			disk["source"] = created["selfLink"]
+			delete(disk, "initializeParams")
+		}
+
+		source, _ := disk["source"].(string)
+		source = s.computeLink(c, "disks", source)
+		attached, err := s.requireComputeResource(source)
+		if err != nil {
+			return err
+		}
+		disk["source"] = source
+		users, _ := attached["users"].([]interface{})
+		attached["users"] = append(users, instance)
+	}
+
+	ifaces, _ := obj["networkInterfaces"].([]interface{})
+	for i, raw := range ifaces {
+		iface := raw.(map[string]interface{})
+		iface["name"] = fmt.Sprintf("nic%d", i)
+		if err := s.insertNetworkInterface(c, iface); err != nil {
+			return err
+		}
+	}
+
+	scheduling, ok := obj["scheduling"].(map[string]interface{})
+	if !ok {
+		scheduling = map[string]interface{}{"automaticRestart": true}
+		obj["scheduling"] = scheduling
+	}
+	if scheduling["onHostMaintenance"] == nil || scheduling["onHostMaintenance"] == "" {
+		scheduling["onHostMaintenance"] = "MIGRATE"
+	}
+
+	metadata, ok := obj["metadata"].(map[string]interface{})
+	if !ok {
+		metadata = map[string]interface{}{"kind": "compute#metadata"}
+		obj["metadata"] = metadata
+	}
+	metadata["fingerprint"] = s.fingerprint()
+	tags, ok := obj["tags"].(map[string]interface{})
+	if !ok {
+		tags = map[string]interface{}{}
+		obj["tags"] = tags
+	}
+	tags["fingerprint"] = s.fingerprint()
+	obj["labelFingerprint"] = s.fingerprint()
+	obj["cpuPlatform"] = "Intel Haswell"
+	obj["status"] = "RUNNING"
+	return nil
+}
+
+// insertNetworkInterface assigns the addresses of a network interface of an
+// instance, including ranges given only as a netmask such as `/24`.
+func (s *Server) insertNetworkInterface(c computeScope, iface map[string]interface{}) *apiError {
+	if subnetwork, _ := iface["subnetwork"].(string); subnetwork != "" {
+		subnetwork = s.computeLink(c, "subnetworks", subnetwork)
+		obj, err := s.requireComputeResource(subnetwork)
+		if err != nil {
+			return err
+		}
+		iface["subnetwork"] = subnetwork
+		iface["network"] = obj["network"]
+	}
+	network, _ := iface["network"].(string)
+	network = s.computeLink(c, "networks", network)
+	obj, err := s.requireComputeResource(network)
+	if err != nil {
+		return err
+	}
+	iface["network"] = network
+	if _, ok := iface["subnetwork"]; !ok && obj["autoCreateSubnetworks"] == true {
+		iface["subnetwork"] = s.computeLink(c, "subnetworks", obj["name"].(string))
+	}
+
+	if ip, _ := iface["networkIP"].(string); ip == "" {
+		iface["networkIP"] = s.nextAddress("10.128")
+	}
+	ranges, _ := iface["aliasIpRanges"].([]interface{})
+	for _, raw := range ranges {
+		r := raw.(map[string]interface{})
+		if cidr, _ := r["ipCidrRange"].(string); strings.HasPrefix(cidr, "/") {
+			r["ipCidrRange"] = fmt.Sprintf("10.%d.%d.0%s", 200+s.next()%50, s.next()%256, cidr)
+		}
+	}
+	configs, _ := iface["accessConfigs"].([]interface{})
+	for _, raw := range configs {
+		ac := raw.(map[string]interface{})
+		ac["kind"] = "compute#accessConfig"
+		if _, ok := ac["name"]; !ok {
+			ac["name"] = "external-nat"
+		}
+		if ip, _ := ac["natIP"].(string); ip == "" {
+			ac["natIP"] = s.nextAddress("35.201")
+		}
+		ac["networkTier"] = "PREMIUM"
+	}
+	iface["fingerprint"] = s.fingerprint()
+	return nil
+}
+
+func deleteInstance(s *Server, c computeScope, obj map[string]interface{}) {
+	disks, _ := obj["disks"].([]interface{})
+	for _, raw := range disks {
+		disk := raw.(map[string]interface{})
+		source, _ := disk["source"].(string)
+		if source == "" {
+			continue
+		}
+		path := s.computePathFromLink(source)
+		if disk["autoDelete"] == true {
+			delete(s.resources, path)
+		} else if attached, ok := s.resources[path]; ok {
+			delete(attached, "users")
+		}
+	}
+}
+
+// setField returns a method setting a field of the resource from the field
+// of the same name in the request.
+func setField(field string) func(*Server, computeScope, map[string]interface{}, map[string]interface{}, *http.Request) *apiError {
+	return func(s *Server, c computeScope, obj, body map[string]interface{}, r *http.Request) *apiError {
+		obj[field] = body[field]
+		if _, ok := obj["fingerprint"]; ok {
+			obj["fingerprint"] = s.fingerprint()
+		}
+		return nil
+	}
+}
+
+// setBody returns a method replacing a field of the resource by the request.
+func setBody(field string) func(*Server, computeScope, map[string]interface{}, map[string]interface{}, *http.Request) *apiError {
+	return func(s *Server, c computeScope, obj, body map[string]interface{}, r *http.Request) *apiError {
+		obj[field] = body
+		return nil
+	}
+}
+
+// setFingerprinted returns a method replacing a field of the resource that
+// is protected by a fingerprint, such as the metadata of an instance.
+func setFingerprinted(field, kind string) func(*Server, computeScope, map[string]interface{}, map[string]interface{}, *http.Request) *apiError {
+	return func(s *Server, c computeScope, obj, body map[string]interface{}, r *http.Request) *apiError {
+		current, _ := obj[field].(map[string]interface{})
+		if fp, ok := body["fingerprint"]; ok && current != nil && fp != current["fingerprint"] {
+			return &apiError{http.StatusPreconditionFailed, "FAILED_PRECONDITION", "conditionNotMet",
+				fmt.Sprintf("Supplied fingerprint does not match current %s fingerprint.", field)}
+		}
+		if kind != "" {
+			body["kind"] = kind
+		}
+		body["fingerprint"] = s.fingerprint()
+		obj[field] = body
+		return nil
+	}
+}
+
+func setLabels(s *Server, c computeScope, obj, body map[string]interface{}, r *http.Request) *apiError {
+	if fp, ok := body["labelFingerprint"]; ok && fp != obj["labelFingerprint"] {
+		return &apiError{http.StatusPreconditionFailed, "FAILED_PRECONDITION", "conditionNotMet",
+			"Labels fingerprint either invalid or resource labels have changed"}
+	}
+	if labels, ok := body["labels"]; ok {
+		obj["labels"] = labels
+	} else {
+		delete(obj, "labels")
+	}
+	obj["labelFingerprint"] = s.fingerprint()
+	return nil
+}
+
+func setDeletionProtection(s *Server, c computeScope, obj, body map[string]interface{}, r *http.Request) *apiError {
+	obj["deletionProtection"] = r.URL.Query().Get("deletionProtection") != "false"
+	return nil
+}
+
+func setStatus(status string) func(*Server, computeScope, map[string]interface{}, map[string]interface{}, *http.Request) *apiError {
+	return func(s *Server, c computeScope, obj, body map[string]interface{}, r *http.Request) *apiError {
+		obj["status"] = status
+		return nil
+	}
+}
@@ -0,0 +1,148 @@
+package fakegcp
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (s *Server) handlePubsub(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// /pubsub/v1/projects/<project>/<topics|subscriptions>/<name>[:<method>]
+	segments := splitPath(r, "/pubsub/v1/")
+	if len(segments) != 4 || segments[0] != "projects" {
+		writeError(w, notImplemented(r))
+		return
+	}
+	name := strings.Join(segments, "/")
+	var method string
+	if i := strings.LastIndex(name, ":"); i >= 0 {
+		name, method = name[:i], name[i+1:]
+	}
+
+	var res map[string]interface{}
+	var err *apiError
+	switch segments[2] {
+	case "topics":
+		res, err = s.handleTopic(r, name, method)
+	case "subscriptions":
+		res, err = s.handleSubscription(r, name, method)
+	default:
+		err = notImplemented(r)
+	}
+
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+func pubsubPath(name string) string {
+	return "pubsub/" + name
+}
+
+func (s *Server) handleTopic(r *http.Request, name, method string) (map[string]interface{}, *apiError) {
+	path := pubsubPath(name)
+	obj, exists := s.resources[path]
+
+	switch {
+	case method != "":
+		return nil, notImplemented(r)
+	case r.Method == http.MethodPut:
+		if exists {
+			return nil, alreadyExists("Resource already exists in the project (resource=%s).", name[strings.LastIndex(name, "/")+1:])
+		}
+		body, err := readObject(r)
+		if err != nil {
+			return nil, err
+		}
+		body["name"] = name
+		s.resources[path] = body
+		return body, nil
+	case !exists:
+		return nil, notFound("Resource not found (resource=%s).", name[strings.LastIndex(name, "/")+1:])
+	case r.Method == http.MethodGet:
+		return obj, nil
+	case r.Method == http.MethodDelete:
+		delete(s.resources, path)
+		// Subscriptions of a deleted topic are detached from it.
+		for _, sub := range s.resources {
+			if sub["topic"] == name {
+				sub["topic"] = "_deleted-topic_"
+			}
+		}
+		return map[string]interface{}{}, nil
+	}
+	return nil, notImplemented(r)
+}
+
+func (s *Server) handleSubscription(r *http.Request, name, method string) (map[string]interface{}, *apiError) {
+	path := pubsubPath(name)
+	obj, exists := s.resources[path]
+	short := name[strings.LastIndex(name, "/")+1:]
+
+	if r.Method == http.MethodPut && method == "" {
+		if exists {
+			return nil, alreadyExists("Resource already exists in the project (resource=%s).", short)
+		}
+		body, err := readObject(r)
+		if err != nil {
+			return nil, err
+		}
+		topic, _ := body["topic"].(string)
+		if _, ok := s.resources[pubsubPath(topic)]; !ok {
+			return nil, notFound("Resource not found (resource=%s).", topic[strings.LastIndex(topic, "/")+1:])
+		}
+		body["name"] = name
+		if _, ok := body["pushConfig"]; !ok {
+			body["pushConfig"] = map[string]interface{}{}
+		}
+		if _, ok := body["ackDeadlineSeconds"]; !ok {
+			body["ackDeadlineSeconds"] = 10
+		}
+		body["messageRetentionDuration"] = fmt.Sprintf("%ds", 7*24*60*60)
+		s.resources[path] = body
+		return body, nil
+	}
+
+	if !exists {
+		return nil, notFound("Resource not found (resource=%s).", short)
+	}
+	switch {
+	case method == "" && r.Method == http.MethodGet:
+		return obj, nil
+	case method == "" && r.Method == http.MethodDelete:
+		delete(s.resources, path)
+		return map[string]interface{}{}, nil
+	case method == "" && r.Method == http.MethodPatch:
+		body, err := readObject(r)
+		if err != nil {
+			return nil, err
+		}
+		update, _ := body["subscription"].(map[string]interface{})
+		mask, _ := body["updateMask"].(string)
+		for _, field := range strings.Split(mask, ",") {
+			if v, ok := update[field]; ok {
+				obj[field] = v
+			} else {
+				delete(obj, field)
+			}
+		}
+		return obj, nil
+	case method == "modifyPushConfig" && r.Method == http.MethodPost:
+		body, err := readObject(r)
+		if err != nil {
+			return nil, err
+		}
+		if config, ok := body["pushConfig"]; ok {
+			obj["pushConfig"] = config
+		} else {
+			obj["pushConfig"] = map[string]interface{}{}
+		}
+		return map[string]interface{}{}, nil
+	}
+	return nil, notImplemented(r)
+}
@@ -0,0 +1,126 @@
+package fakegcp
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (s *Server) handleResourceManager(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// /cloudresourcemanager/v1/projects/<project>[:<method>]
+	segments := splitPath(r, "/cloudresourcemanager/v1/")
+	if len(segments) != 2 || segments[0] != "projects" {
+		writeError(w, notImplemented(r))
+		return
+	}
+	project, method := segments[1], ""
+	if i := strings.LastIndex(project, ":"); i >= 0 {
+		project, method = project[:i], project[i+1:]
+	}
+
+	var res map[string]interface{}
+	var err *apiError
+	switch {
+	case method == "" && r.Method == http.MethodGet:
+		res = map[string]interface{}{
+			"projectId":      project,
+			"projectNumber":  fmt.Sprint(s.projectNumber(project)),
+			"name":           project,
+			"lifecycleState": "ACTIVE",
+		}
+	case method == "getIamPolicy" && r.Method == http.MethodPost:
+		res, err = s.getIamPolicy(r, "projects/"+project)
+	case method == "setIamPolicy" && r.Method == http.MethodPost:
+		res, err = s.setIamPolicy(r, "projects/"+project)
+	default:
+		err = notImplemented(r)
+	}
+
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+// iamPolicy returns the IAM policy of the named resource, which is empty
+// until it is set.
+func (s *Server) iamPolicy(resource string) map[string]interface{} {
+	policy, ok := s.policies[resource]
+	if !ok {
+		policy = map[string]interface{}{
+			"version": 1,
+			"etag":    s.fingerprint(),
+		}
+		s.policies[resource] = policy
+	}
+	return policy
+}
+
+// getIamPolicy returns the IAM policy of the named resource. Policies with
+// conditional bindings must be requested with version 3; unlike the API,
+// which then leaves out the conditions, older versions are rejected.
+func (s *Server) getIamPolicy(r *http.Request, resource string) (map[string]interface{}, *apiError) {
+	body, err := readObject(r)
+	if err != nil {
+		return nil, err
+	}
+	policy := s.iamPolicy(resource)
+	options, _ := body["options"].(map[string]interface{})
+	if version, _ := options["requestedPolicyVersion"].(float64); version < 3 && hasIamConditions(policy) {
+		return nil, badRequest("Request contains an invalid argument.")
+	}
+	return policy, nil
+}
+
+// setIamPolicy replaces the IAM policy of the named resource. Like the API,
+// it rejects policies with the etag of an older version of the policy, which
+// happens when the policy is modified concurrently, and policies with
+// conditional bindings set with a version older than 3.
+func (s *Server) setIamPolicy(r *http.Request, resource string) (map[string]interface{}, *apiError) {
+	body, err := readObject(r)
+	if err != nil {
+		return nil, err
+	}
+	policy, ok := body["policy"].(map[string]interface{})
+	if !ok {
+		return nil, badRequest("Request contains an invalid argument.")
+	}
+
+	current := s.iamPolicy(resource)
+	if etag, ok := policy["etag"]; ok && etag != current["etag"] {
+		return nil, aborted("There were concurrent policy changes. Please retry the whole read-modify-write with exponential backoff.")
+	}
+	version := 1
+	if hasIamConditions(policy) {
+		if v, _ := policy["version"].(float64); v < 3 {
+			return nil, badRequest("Request contains an invalid argument.")
+		}
+		version = 3
+	}
+	// Like the API, the audit configs are only set when in the update mask.
+	if mask, _ := body["updateMask"].(string); !strings.Contains(mask, "auditConfigs") {
+		delete(policy, "auditConfigs")
+		if auditConfigs, ok := current["auditConfigs"]; ok {
+			policy["auditConfigs"] = auditConfigs
+		}
+	}
+	policy["version"] = version
+	policy["etag"] = s.fingerprint()
+	s.policies[resource] = policy
+	return policy, nil
+}
+
+// hasIamConditions returns whether a binding of policy has a condition.
+func hasIamConditions(policy map[string]interface{}) bool {
+	bindings, _ := policy["bindings"].([]interface{})
+	for _, b := range bindings {
+		if binding, ok := b.(map[string]interface{}); ok && binding["condition"] != nil {
+			return true
+		}
+	}
+	return false
+}
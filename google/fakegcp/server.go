@@ -0,0 +1,269 @@
+// Package fakegcp provides an in-process stand-in for the parts of the GCP
+// REST APIs used by the unit tests of the provider.
+//
+// A Server keeps resources in memory and implements enough of Compute
+// (networks, subnetworks, firewalls, addresses, disks, instances and their
+// operations), Cloud Storage buckets, Pub/Sub topics and subscriptions and
+// the Resource Manager IAM policies of projects for resources to be created,
+// read, updated, deleted and imported against it. Like the real APIs, it
+// fills in the fields computed by the server, such as self links, assigned
+// IP addresses and fingerprints, so that diffs between the configuration and
+// what is read back show up in tests.
+//
+// The provider is pointed at a Server through the `<api>_custom_endpoint`
+// arguments returned by Endpoints, with any access token.
+package fakegcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Server is a fake GCP backend listening on a local address.
+type Server struct {
+	server *httptest.Server
+
+	mu sync.Mutex
+	// Resources keyed by their path relative to the root of the server,
+	// e.g. `compute/projects/p/global/networks/n`.
+	resources map[string]map[string]interface{}
+	// Compute operations and IAM policies are kept apart from resources so
+	// that Resources only lists what tests create.
+	operations map[string]map[string]interface{}
+	policies   map[string]map[string]interface{}
+	projects   map[string]uint64
+	// Incremented to generate IDs, IP addresses, etags and fingerprints.
+	counter uint64
+}
+
+// NewServer starts a Server. It must be closed with Close.
+func NewServer() *Server {
+	s := &Server{
+		resources:  make(map[string]map[string]interface{}),
+		operations: make(map[string]map[string]interface{}),
+		policies:   make(map[string]map[string]interface{}),
+		projects:   make(map[string]uint64),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/compute/", s.handleCompute)
+	mux.HandleFunc("/storage/", s.handleStorage)
+	mux.HandleFunc("/pubsub/", s.handlePubsub)
+	mux.HandleFunc("/cloudresourcemanager/", s.handleResourceManager)
+	s.server = httptest.NewServer(mux)
+	return s
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// URL returns the base URL of the server, e.g. `http://127.0.0.1:1234`.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Endpoints returns the base path of each API implemented by the server,
+// keyed by the name of the API in the `<api>_custom_endpoint` provider
+// arguments.
+func (s *Server) Endpoints() map[string]string {
+	return map[string]string{
+		"compute":          s.URL() + "/compute/v1/",
+		"compute_beta":     s.URL() + "/compute/beta/",
+		"storage":          s.URL() + "/storage/v1/",
+		"pubsub":           s.URL() + "/pubsub/v1/",
+		"resource_manager": s.URL() + "/cloudresourcemanager/v1/",
+	}
+}
+
+// Resources returns the paths of the resources that currently exist, in
+// order. Operations and IAM policies aren't included.
+func (s *Server) Resources() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	paths := make([]string, 0, len(s.resources))
+	for path := range s.resources {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// Resource returns a copy of the resource stored at path, as returned by
+// the API.
+func (s *Server) Resource(path string) (map[string]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.resources[path]
+	if !ok {
+		return nil, false
+	}
+	return copyObject(r), true
+}
+
+// IamPolicy returns a copy of the IAM policy of the named resource, e.g.
+// `projects/p` or `storage/b/bucket`.
+func (s *Server) IamPolicy(resource string) map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return copyObject(s.iamPolicy(resource))
+}
+
+// next returns a new value of the counter. s.mu must be held.
+func (s *Server) next() uint64 {
+	s.counter++
+	return s.counter
+}
+
+// projectNumber returns the number of the named project, which is assigned
+// the first time the project is seen. s.mu must be held.
+func (s *Server) projectNumber(project string) uint64 {
+	n, ok := s.projects[project]
+	if !ok {
+		n = 100000000000 + uint64(len(s.projects)+1)
+		s.projects[project] = n
+	}
+	return n
+}
+
+// projectID returns the ID of the project identified by its ID or number.
+// s.mu must be held.
+func (s *Server) projectID(project string) (string, bool) {
+	for id, n := range s.projects {
+		if id == project || fmt.Sprint(n) == project {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+// fingerprint returns a new opaque fingerprint or etag. s.mu must be held.
+func (s *Server) fingerprint() string {
+	return fmt.Sprintf("fp%08d", s.next())
+}
+
+// apiError is an error returned by the API in the format parsed by
+// googleapi.CheckResponse.
+type apiError struct {
+	Code    int
+	Status  string
+	Reason  string
+	Message string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%d %s", e.Code, e.Message)
+}
+
+func notFound(format string, args ...interface{}) *apiError {
+	return &apiError{http.StatusNotFound, "NOT_FOUND", "notFound", fmt.Sprintf(format, args...)}
+}
+
+func alreadyExists(format string, args ...interface{}) *apiError {
+	return &apiError{http.StatusConflict, "ALREADY_EXISTS", "alreadyExists", fmt.Sprintf(format, args...)}
+}
+
+func badRequest(format string, args ...interface{}) *apiError {
+	return &apiError{http.StatusBadRequest, "INVALID_ARGUMENT", "invalid", fmt.Sprintf(format, args...)}
+}
+
+func aborted(format string, args ...interface{}) *apiError {
+	return &apiError{http.StatusConflict, "ABORTED", "aborted", fmt.Sprintf(format, args...)}
+}
+
+func notImplemented(r *http.Request) *apiError {
+	return &apiError{http.StatusNotImplemented, "UNIMPLEMENTED", "notImplemented",
+		fmt.Sprintf("%s %s is not implemented by the fake", r.Method, r.URL.Path)}
+}
+
+func writeError(w http.ResponseWriter, err *apiError) {
+	log.Printf("[DEBUG] fakegcp: returning error %d: %s", err.Code, err.Message)
+	writeJSON(w, err.Code, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    err.Code,
+			"message": err.Message,
+			"status":  err.Status,
+			"errors": []interface{}{
+				map[string]interface{}{
+					"reason":  err.Reason,
+					"message": err.Message,
+				},
+			},
+		},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("[WARN] fakegcp: error writing response: %s", err)
+	}
+}
+
+// readObject decodes the JSON object in the body of r.
+func readObject(r *http.Request) (map[string]interface{}, *apiError) {
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, badRequest("error reading request: %s", err)
+	}
+	obj := make(map[string]interface{})
+	if len(b) == 0 {
+		return obj, nil
+	}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, badRequest("invalid JSON payload: %s", err)
+	}
+	return obj, nil
+}
+
+// copyObject deep copies a decoded JSON object, so that stored resources
+// can't be modified through the values returned by the server.
+func copyObject(obj map[string]interface{}) map[string]interface{} {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		panic(err)
+	}
+	c := make(map[string]interface{})
+	if err := json.Unmarshal(b, &c); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// merge applies a JSON merge patch to obj: fields set in the patch replace
+// those of obj, objects are merged recursively, and fields set to null are
+// removed.
+func merge(obj, patch map[string]interface{}) {
+	for k, v := range patch {
+		if v == nil {
+			delete(obj, k)
+			continue
+		}
+		current, ok := obj[k].(map[string]interface{})
+		if p, isObject := v.(map[string]interface{}); ok && isObject {
+			merge(current, p)
+			continue
+		}
+		obj[k] = v
+	}
+}
+
+// splitPath splits the path of r after prefix into its segments.
+func splitPath(r *http.Request, prefix string) []string {
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, prefix), "/")
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, "/")
+}
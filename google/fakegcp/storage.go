@@ -0,0 +1,202 @@
+package fakegcp
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (s *Server) handleStorage(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// /storage/v1/b[/<bucket>[/o|/iam]]
+	segments := splitPath(r, "/storage/v1/")
+	if len(segments) == 0 || segments[0] != "b" {
+		writeError(w, notImplemented(r))
+		return
+	}
+
+	var res map[string]interface{}
+	var err *apiError
+	switch {
+	case len(segments) == 1 && r.Method == http.MethodPost:
+		res, err = s.insertBucket(r)
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		res = s.listBuckets(r.URL.Query().Get("project"))
+	case len(segments) == 2:
+		res, err = s.updateBucket(r, segments[1])
+	case len(segments) == 3 && segments[2] == "o" && r.Method == http.MethodGet:
+		// Buckets are always empty, as the fake doesn't store objects.
+		if _, ok := s.resources[bucketPath(segments[1])]; !ok {
+			err = notFound("Not Found")
+		} else {
+			res = map[string]interface{}{"kind": "storage#objects"}
+		}
+	case len(segments) == 3 && segments[2] == "iam":
+		res, err = s.bucketIamPolicy(r, segments[1])
+	default:
+		err = notImplemented(r)
+	}
+
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if res == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+func bucketPath(name string) string {
+	return "storage/b/" + name
+}
+
+func (s *Server) insertBucket(r *http.Request) (map[string]interface{}, *apiError) {
+	project := r.URL.Query().Get("project")
+	if project == "" {
+		return nil, badRequest("Required parameter: project")
+	}
+	obj, err := readObject(r)
+	if err != nil {
+		return nil, err
+	}
+	name, _ := obj["name"].(string)
+	if name == "" {
+		return nil, badRequest("Required")
+	}
+	path := bucketPath(name)
+	if _, ok := s.resources[path]; ok {
+		return nil, &apiError{http.StatusConflict, "ALREADY_EXISTS", "conflict",
+			"Sorry, that name is not available. Please try a different one."}
+	}
+
+	obj["kind"] = "storage#bucket"
+	obj["id"] = name
+	obj["selfLink"] = s.URL() + "/storage/v1/" + strings.TrimPrefix(path, "storage/")
+	obj["projectNumber"] = fmt.Sprint(s.projectNumber(project))
+	obj["metageneration"] = "1"
+	obj["etag"] = s.fingerprint()
+	obj["timeCreated"] = "2018-01-01T00:00:00.000Z"
+	obj["updated"] = obj["timeCreated"]
+	if location, ok := obj["location"].(string); ok {
+		obj["location"] = strings.ToUpper(location)
+	} else {
+		obj["location"] = "US"
+	}
+	if _, ok := obj["storageClass"]; !ok {
+		obj["storageClass"] = "STANDARD"
+	}
+
+	s.resources[path] = obj
+	// Like the API, the new bucket grants the legacy roles to the owners,
+	// editors and viewers of its project.
+	s.policies[path] = map[string]interface{}{
+		"kind":       "storage#policy",
+		"resourceId": "projects/_/buckets/" + name,
+		"version":    1,
+		"etag":       s.fingerprint(),
+		"bindings": []interface{}{
+			map[string]interface{}{
+				"role":    "roles/storage.legacyBucketOwner",
+				"members": []interface{}{"projectEditor:" + project, "projectOwner:" + project},
+			},
+			map[string]interface{}{
+				"role":    "roles/storage.legacyBucketReader",
+				"members": []interface{}{"projectViewer:" + project},
+			},
+		},
+	}
+	return obj, nil
+}
+
+func (s *Server) listBuckets(project string) map[string]interface{} {
+	number := fmt.Sprint(s.projectNumber(project))
+	items := []interface{}{}
+	for path, obj := range s.resources {
+		if strings.HasPrefix(path, bucketPath("")) && obj["projectNumber"] == number {
+			items = append(items, obj)
+		}
+	}
+	return map[string]interface{}{
+		"kind":  "storage#buckets",
+		"items": items,
+	}
+}
+
+func (s *Server) updateBucket(r *http.Request, name string) (map[string]interface{}, *apiError) {
+	path := bucketPath(name)
+	obj, ok := s.resources[path]
+	if !ok {
+		return nil, notFound("Not Found")
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		return obj, nil
+	case http.MethodDelete:
+		delete(s.resources, path)
+		delete(s.policies, path)
+		return nil, nil
+	case http.MethodPatch, http.MethodPut:
+		body, err := readObject(r)
+		if err != nil {
+			return nil, err
+		}
+		for _, field := range []string{"kind", "id", "name", "selfLink", "projectNumber", "location", "timeCreated"} {
+			body[field] = obj[field]
+		}
+		if r.Method == http.MethodPut {
+			for k := range obj {
+				delete(obj, k)
+			}
+		}
+		merge(obj, body)
+		obj["metageneration"] = fmt.Sprint(s.next())
+		obj["etag"] = s.fingerprint()
+		return obj, nil
+	}
+	return nil, notImplemented(r)
+}
+
+// bucketIamPolicy gets or replaces the IAM policy of a bucket. Unlike other
+// APIs, the policy is the body of the requests.
+func (s *Server) bucketIamPolicy(r *http.Request, name string) (map[string]interface{}, *apiError) {
+	path := bucketPath(name)
+	if _, ok := s.resources[path]; !ok {
+		return nil, notFound("Not Found")
+	}
+	current := s.iamPolicy(path)
+
+	switch r.Method {
+	case http.MethodGet:
+		if version := r.URL.Query().Get("optionsRequestedPolicyVersion"); version != "3" && hasIamConditions(current) {
+			return nil, badRequest("Request contains an invalid argument.")
+		}
+		return current, nil
+	case http.MethodPut:
+		policy, err := readObject(r)
+		if err != nil {
+			return nil, err
+		}
+		if etag, ok := policy["etag"]; ok && etag != current["etag"] {
+			return nil, &apiError{http.StatusPreconditionFailed, "FAILED_PRECONDITION", "conditionNotMet", "Precondition Failed"}
+		}
+		version := 1
+		if hasIamConditions(policy) {
+			if v, _ := policy["version"].(float64); v < 3 {
+				return nil, badRequest("Request contains an invalid argument.")
+			}
+			version = 3
+		}
+		policy["kind"] = current["kind"]
+		policy["resourceId"] = current["resourceId"]
+		policy["version"] = version
+		policy["etag"] = s.fingerprint()
+		s.policies[path] = policy
+		return policy, nil
+	}
+	return nil, notImplemented(r)
+}
@@ -0,0 +1,145 @@
+package google
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-google/google/fakegcp"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
+)
+
+// Tests using the fake GCP backend run resources against an in-memory
+// stand-in for the APIs with resource.UnitTest, so they need neither
+// credentials nor TF_ACC.
+const (
+	fakeGcpProject = "fake-project"
+	fakeGcpRegion  = "us-central1"
+	fakeGcpZone    = "us-central1-a"
+)
+
+// newFakeGcpServer starts a fake GCP backend. Callers must close it.
+func newFakeGcpServer(t *testing.T) *fakegcp.Server {
+	if vcr != nil {
+		t.Skip("Tests against the fake GCP backend don't use the VCR")
+	}
+	return fakegcp.NewServer()
+}
+
+// testFakeGcpProviders returns providers that aren't shared with other tests,
+// so that they can be configured for the fake of each test.
+func testFakeGcpProviders() map[string]terraform.ResourceProvider {
+	return map[string]terraform.ResourceProvider{
+		"google": Provider(),
+	}
+}
+
+// testFakeGcpProviderConfig returns the provider block sending every request
+// of a test configuration to s.
+func testFakeGcpProviderConfig(s *fakegcp.Server) string {
+	endpoints := s.Endpoints()
+	arguments := make([]string, 0, len(endpoints))
+	for argument := range endpoints {
+		arguments = append(arguments, argument)
+	}
+	sort.Strings(arguments)
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `
+provider "google" {
+  access_token = "fake"
+  project      = "%s"
+  region       = "%s"
+  zone         = "%s"
+`, fakeGcpProject, fakeGcpRegion, fakeGcpZone)
+	for _, argument := range arguments {
+		fmt.Fprintf(&b, "  %s_custom_endpoint = \"%s\"\n", argument, endpoints[argument])
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// testFakeGcpImportStep imports and verifies resourceName against s. Import
+// steps don't reuse the provider block of earlier steps, so it sets its own.
+func testFakeGcpImportStep(s *fakegcp.Server, resourceName string, ignore ...string) resource.TestStep {
+	return resource.TestStep{
+		Config:                  testFakeGcpProviderConfig(s),
+		ResourceName:            resourceName,
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateVerifyIgnore: ignore,
+	}
+}
+
+// testFakeGcpConfig returns a Config whose clients send requests to s, set up
+// through the same endpoint overrides as the provider arguments.
+func testFakeGcpConfig(t *testing.T, s *fakegcp.Server) *Config {
+	endpoints := s.Endpoints()
+	config := &Config{
+		AccessToken:     "fake",
+		Project:         fakeGcpProject,
+		Region:          fakeGcpRegion,
+		Zone:            fakeGcpZone,
+		CustomEndpoints: make(map[string]string),
+	}
+	for _, e := range customEndpoints {
+		if url, ok := endpoints[e.argument]; ok {
+			config.CustomEndpoints[e.key] = url
+		}
+	}
+	if err := config.loadAndValidate(); err != nil {
+		t.Fatal(err)
+	}
+	return config
+}
+
+// testFakeGcpCheckDestroy checks that no resource is left in s.
+func testFakeGcpCheckDestroy(s *fakegcp.Server) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if left := s.Resources(); len(left) > 0 {
+			return fmt.Errorf("Resources still exist: %s", strings.Join(left, ", "))
+		}
+		return nil
+	}
+}
+
+func TestFakeGcpConfig(t *testing.T) {
+	s := newFakeGcpServer(t)
+	defer s.Close()
+	config := testFakeGcpConfig(t, s)
+
+	op, err := config.clientCompute.Networks.Insert(fakeGcpProject, &compute.Network{
+		Name:            "network",
+		ForceSendFields: []string{"AutoCreateSubnetworks"},
+	}).Do()
+	if err != nil {
+		t.Fatalf("Error creating network: %s", err)
+	}
+	if err := computeOperationWait(config, op, fakeGcpProject, "Creating Network"); err != nil {
+		t.Fatal(err)
+	}
+
+	network, err := config.clientCompute.Networks.Get(fakeGcpProject, "network").Do()
+	if err != nil {
+		t.Fatalf("Error reading network: %s", err)
+	}
+	expected := s.URL() + "/compute/v1/projects/fake-project/global/networks/network"
+	if network.SelfLink != expected {
+		t.Errorf("Expected self link %q, got %q", expected, network.SelfLink)
+	}
+
+	_, err = config.clientCompute.Networks.Insert(fakeGcpProject, &compute.Network{Name: "network"}).Do()
+	if gerr, ok := err.(*googleapi.Error); !ok || gerr.Code != 409 {
+		t.Errorf("Expected creating the network again to fail with a 409, got %v", err)
+	}
+
+	_, err = config.clientCompute.Networks.Get(fakeGcpProject, "missing").Do()
+	if !isGoogleApiErrorWithCode(err, 404) {
+		t.Errorf("Expected reading a missing network to fail with a 404, got %v", err)
+	}
+}
@@ -1,14 +1,60 @@
 package google
 
 import (
+	"flag"
 	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"google.golang.org/api/compute/v1"
 )
 
 func TestMain(m *testing.M) {
-	resource.TestMain(m)
+	flag.Parse()
+	if vcr == nil || flag.Lookup("sweep").Value.String() != "" {
+		resource.TestMain(m)
+		return
+	}
+
+	// Tests share the recorder, so they must not run in parallel.
+	flag.Set("test.parallel", "1")
+	code := m.Run()
+	if err := vcrStopCurrent(); err != nil {
+		log.Printf("[ERR] Error saving cassette: %s", err)
+		code = 1
+	}
+	os.Exit(code)
+}
+
+// Sweepers only delete resources whose name starts with this prefix, which
+// acceptance tests use for the resources they create.
+const sweeperTestPrefix = "tf-test"
+
+func isSweepableTestResource(name string) bool {
+	return strings.HasPrefix(name, sweeperTestPrefix)
+}
+
+// testSweepers holds every sweeper registered with addTestSweeper.
+var testSweepers = make(map[string]*resource.Sweeper)
+
+// addTestSweeper registers a sweeper run by `go test -sweep=<region>`. The
+// sweepers of its dependencies run first, so a sweeper depends on the
+// sweepers of the resources that must be deleted before its own, e.g. the
+// network sweeper depends on the subnetwork sweeper.
+func addTestSweeper(name string, f resource.SweeperFunc, dependencies ...string) {
+	s := &resource.Sweeper{
+		Name:         name,
+		F:            f,
+		Dependencies: dependencies,
+	}
+	testSweepers[name] = s
+	resource.AddTestSweepers(name, s)
 }
 
 // sharedConfigForRegion returns a common config setup needed for the sweeper
@@ -32,3 +78,182 @@ func sharedConfigForRegion(region string) (*Config, error) {
 
 	return conf, nil
 }
+
+// sweeperConfig returns the shared config of region with its clients loaded.
+func sweeperConfig(region string) (*Config, error) {
+	config, err := sharedConfigForRegion(region)
+	if err != nil {
+		return nil, fmt.Errorf("error getting shared config for region: %s", err)
+	}
+	if err := config.loadAndValidate(); err != nil {
+		return nil, fmt.Errorf("error loading: %s", err)
+	}
+	return config, nil
+}
+
+// testSweepComputeResources returns a sweeper of the Compute resources of
+// collection, see sweepComputeResources.
+func testSweepComputeResources(collection string) resource.SweeperFunc {
+	return func(region string) error {
+		config, err := sweeperConfig(region)
+		if err != nil {
+			return err
+		}
+		return sweepComputeResources(config, region, collection)
+	}
+}
+
+// sweepComputeResources deletes the test resources of a Compute collection
+// in the project of config. The collection is given relative to the
+// project, e.g. `global/networks` or `regions/{{region}}/subnetworks`; zonal
+// collections are given as `aggregated/<collection>` and swept in the zones
+// of region.
+//
+// Resources failing to be deleted are logged and left for the next sweep,
+// as they may still be in use by resources of other collections.
+func sweepComputeResources(config *Config, region, collection string) error {
+	items, err := listComputeResources(config, region, collection)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		name, _ := item["name"].(string)
+		selfLink, _ := item["selfLink"].(string)
+		if !isSweepableTestResource(name) {
+			continue
+		}
+
+		log.Printf("[INFO] Sweeping %s", selfLink)
+		res, err := Delete(config, selfLink, config.Project)
+		if err != nil {
+			log.Printf("[INFO] Error deleting %s: %s", selfLink, err)
+			continue
+		}
+		op := &compute.Operation{}
+		if err := Convert(res, op); err != nil {
+			return err
+		}
+		if err := computeOperationWaitTime(config, op, config.Project, "Sweeping "+name, 10*time.Minute); err != nil {
+			log.Printf("[INFO] Error deleting %s: %s", selfLink, err)
+		}
+	}
+
+	return nil
+}
+
+// listComputeResources returns the resources of a Compute collection, see
+// sweepComputeResources.
+func listComputeResources(config *Config, region, collection string) ([]map[string]interface{}, error) {
+	collection = strings.Replace(collection, "{{region}}", region, -1)
+	aggregated := strings.HasPrefix(collection, "aggregated/")
+	listUrl := fmt.Sprintf("%sprojects/%s/%s", config.basePath(ComputeBasePathKey), config.Project, collection)
+
+	var items []map[string]interface{}
+	pageToken := ""
+	for {
+		u := listUrl
+		if pageToken != "" {
+			u += "?pageToken=" + url.QueryEscape(pageToken)
+		}
+		res, err := Get(config, u, config.Project)
+		if err != nil {
+			return nil, fmt.Errorf("error listing %s: %s", collection, err)
+		}
+
+		if aggregated {
+			// Aggregated lists hold the resources of each scope, keyed by
+			// `zones/<zone>` or `regions/<region>`, under the name of the
+			// collection. Scopes without resources only hold a warning.
+			// Regional resources are left to the sweepers of the regional
+			// collection, which may depend on other sweepers.
+			name := strings.TrimPrefix(collection, "aggregated/")
+			scopes, _ := res["items"].(map[string]interface{})
+			for scope, raw := range scopes {
+				if !strings.HasPrefix(scope, "zones/"+region+"-") {
+					continue
+				}
+				scoped, _ := raw.(map[string]interface{})
+				list, _ := scoped[name].([]interface{})
+				for _, item := range list {
+					items = append(items, item.(map[string]interface{}))
+				}
+			}
+		} else if list, ok := res["items"].([]interface{}); ok {
+			for _, item := range list {
+				items = append(items, item.(map[string]interface{}))
+			}
+		}
+
+		pageToken, _ = res["nextPageToken"].(string)
+		if pageToken == "" {
+			return items, nil
+		}
+	}
+}
+
+func TestSweeperDependencies(t *testing.T) {
+	// Dependencies must be registered sweepers, and must not depend on the
+	// sweepers depending on them.
+	var visit func(name string, path []string)
+	visit = func(name string, path []string) {
+		for _, p := range path {
+			if p == name {
+				t.Fatalf("sweepers depend on each other: %s", strings.Join(append(path, name), " -> "))
+			}
+		}
+		s, ok := testSweepers[name]
+		if !ok {
+			t.Fatalf("sweeper %s depends on %s, which isn't registered", path[len(path)-1], name)
+		}
+		for _, dep := range s.Dependencies {
+			visit(dep, append(path, name))
+		}
+	}
+
+	for name := range testSweepers {
+		visit(name, nil)
+	}
+}
+
+func TestSweepComputeResources(t *testing.T) {
+	t.Parallel()
+
+	s := newFakeGcpServer(t)
+	defer s.Close()
+	config := testFakeGcpConfig(t, s)
+
+	for _, name := range []string{"tf-test-network", "network"} {
+		op, err := config.clientCompute.Networks.Insert(fakeGcpProject, &compute.Network{Name: name}).Do()
+		if err != nil {
+			t.Fatalf("Error creating network: %s", err)
+		}
+		if err := computeOperationWait(config, op, fakeGcpProject, "Creating Network"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, zone := range []string{fakeGcpZone, "europe-west1-b"} {
+		op, err := config.clientCompute.Disks.Insert(fakeGcpProject, zone, &compute.Disk{Name: "tf-test-disk"}).Do()
+		if err != nil {
+			t.Fatalf("Error creating disk: %s", err)
+		}
+		if err := computeOperationWait(config, op, fakeGcpProject, "Creating Disk"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := sweepComputeResources(config, fakeGcpRegion, "global/networks"); err != nil {
+		t.Fatal(err)
+	}
+	if err := sweepComputeResources(config, fakeGcpRegion, "aggregated/disks"); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"compute/projects/fake-project/global/networks/network",
+		"compute/projects/fake-project/zones/europe-west1-b/disks/tf-test-disk",
+	}
+	if left := s.Resources(); !reflect.DeepEqual(left, expected) {
+		t.Errorf("Expected %v to be left after sweeping, got %v", expected, left)
+	}
+}
@@ -1,8 +1,12 @@
 package google
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/url"
+	"reflect"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/errwrap"
@@ -10,15 +14,108 @@ import (
 	"google.golang.org/api/cloudresourcemanager/v1"
 )
 
+// iamPolicyVersion is the version of the IAM policies read and written by the
+// provider. Policies with conditional bindings can't be read or written with
+// older versions.
+const iamPolicyVersion = 3
+
+// IamPolicy is the IAM policy of a resource, as read and written by every
+// API supporting IAM. Unlike the policies of the client libraries, its
+// bindings can be conditional.
+type IamPolicy struct {
+	AuditConfigs []*cloudresourcemanager.AuditConfig `json:"auditConfigs,omitempty"`
+	Bindings     []*IamBinding                       `json:"bindings,omitempty"`
+	Etag         string                              `json:"etag,omitempty"`
+	Version      int64                               `json:"version,omitempty"`
+}
+
+// IamBinding binds members to a role, only when its condition, if any, holds.
+type IamBinding struct {
+	Condition *IamCondition `json:"condition,omitempty"`
+	Members   []string      `json:"members,omitempty"`
+	Role      string        `json:"role,omitempty"`
+}
+
+// IamCondition is the condition of a binding, a CEL expression.
+type IamCondition struct {
+	Description string `json:"description,omitempty"`
+	Expression  string `json:"expression,omitempty"`
+	Location    string `json:"location,omitempty"`
+	Title       string `json:"title,omitempty"`
+}
+
+// iamConditionSchema is the schema of the condition of _iam_binding and
+// _iam_member resources. Changing the condition changes the binding.
+var iamConditionSchema = &schema.Schema{
+	Type:     schema.TypeList,
+	Optional: true,
+	ForceNew: true,
+	MaxItems: 1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"expression": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	},
+}
+
+func expandIamCondition(v interface{}) *IamCondition {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	raw := l[0].(map[string]interface{})
+	return &IamCondition{
+		Expression:  raw["expression"].(string),
+		Title:       raw["title"].(string),
+		Description: raw["description"].(string),
+	}
+}
+
+func flattenIamCondition(c *IamCondition) []map[string]interface{} {
+	if c == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"expression":  c.Expression,
+			"title":       c.Title,
+			"description": c.Description,
+		},
+	}
+}
+
+// iamConditionIdSuffix returns the suffix of the ID of the bindings and
+// members with condition c, so that they differ from unconditional ones.
+func iamConditionIdSuffix(c *IamCondition) string {
+	if c == nil {
+		return ""
+	}
+	return "/" + c.Title
+}
+
 // The ResourceIamUpdater interface is implemented for each GCP resource supporting IAM policy.
 //
 // Implementations should keep track of the resource identifier.
 type ResourceIamUpdater interface {
 	// Fetch the existing IAM policy attached to a resource.
-	GetResourceIamPolicy() (*cloudresourcemanager.Policy, error)
+	GetResourceIamPolicy() (*IamPolicy, error)
 
 	// Replaces the existing IAM Policy attached to a resource.
-	SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error
+	SetResourceIamPolicy(policy *IamPolicy) error
 
 	// A mutex guards against concurrent to call to the SetResourceIamPolicy method.
 	// The mutex key should be made of the resource type and resource id.
@@ -34,7 +131,7 @@ type ResourceIamUpdater interface {
 }
 
 type newResourceIamUpdaterFunc func(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error)
-type iamPolicyModifyFunc func(p *cloudresourcemanager.Policy) error
+type iamPolicyModifyFunc func(p *IamPolicy) error
 
 // This method parses identifiers specific to the resource (d.GetId()) into the ResourceData
 // object, so that it can be given to the resource's Read method.  Externally, this is wrapped
@@ -44,19 +141,36 @@ type iamPolicyModifyFunc func(p *cloudresourcemanager.Policy) error
 
 type resourceIdParserFunc func(d *schema.ResourceData, config *Config) error
 
-func iamPolicyReadModifyWrite(updater ResourceIamUpdater, modify iamPolicyModifyFunc) error {
+const (
+	// Policy changes are checked at most once per second across every IAM
+	// resource, unless the Resource Manager API has a rate limit, until they
+	// have been seen three times or the timeout is reached.
+	iamPropagationCheckQps     = 1.0
+	iamPropagationCheckTimeout = time.Minute
+)
+
+func iamPolicyReadModifyWrite(config *Config, updater ResourceIamUpdater, modify iamPolicyModifyFunc) error {
+	// When batching is enabled, the modifications of the same policy are
+	// coalesced into a single read-modify-write.
+	if config.iamBatcher != nil {
+		return config.iamBatcher.readModifyWrite(config, updater, modify)
+	}
+	return iamPolicyReadModifyWriteNow(config, updater, modify)
+}
+
+// iamPolicyReadModifyWriteNow applies modify to the policy of the resource of
+// updater, and waits for the change to propagate.
+func iamPolicyReadModifyWriteNow(config *Config, updater ResourceIamUpdater, modify iamPolicyModifyFunc) error {
 	mutexKey := updater.GetMutexKey()
 	mutexKV.Lock(mutexKey)
 	defer mutexKV.Unlock(mutexKey)
 
-	backoff := time.Second
-	for {
+	// Transient errors are retried by the transport, but concurrent changes to
+	// the policy require it to be read and modified again.
+	err := retryTimeDuration(func() error {
 		log.Printf("[DEBUG]: Retrieving policy for %s\n", updater.DescribeResource())
 		p, err := updater.GetResourceIamPolicy()
-		if isGoogleApiErrorWithCode(err, 429) {
-			time.Sleep(backoff)
-			continue
-		} else if err != nil {
+		if err != nil {
 			return err
 		}
 		log.Printf("[DEBUG]: Retrieved policy for %s: %+v\n", updater.DescribeResource(), p)
@@ -68,89 +182,272 @@ func iamPolicyReadModifyWrite(updater ResourceIamUpdater, modify iamPolicyModify
 
 		log.Printf("[DEBUG]: Setting policy for %s to %+v\n", updater.DescribeResource(), p)
 		err = updater.SetResourceIamPolicy(p)
-		if err == nil {
-			fetchBackoff := 1 * time.Second
-			for successfulFetches := 0; successfulFetches < 3; {
-				time.Sleep(fetchBackoff)
-				new_p, err := updater.GetResourceIamPolicy()
-				if err != nil {
-					// Quota for Read is pretty limited, so watch out for running out of quota.
-					if isGoogleApiErrorWithCode(err, 429) {
-						fetchBackoff = fetchBackoff * 2
-					} else {
-						return err
-					}
-				}
-				modified_p := new_p
-				// This relies on the fact that `modify` is idempotent: since other changes might have
-				// happened between the call to set the policy and now, we just need to make sure that
-				// our change has been made.  'modify(p) == p' is our check for whether this has been
-				// correctly applied.
-				err = modify(modified_p)
-				if err != nil {
-					return err
-				}
-				if modified_p == new_p {
-					successfulFetches += 1
-				} else {
-					fetchBackoff = fetchBackoff * 2
-					if fetchBackoff > 30*time.Second {
-						return fmt.Errorf("Error applying IAM policy to %s: Waited too long for propagation.\n", updater.DescribeResource())
-					}
-				}
-			}
-			break
+		if err != nil {
+			return errwrap.Wrapf(fmt.Sprintf("Error applying IAM policy for %s: {{err}}", updater.DescribeResource()), err)
 		}
-		if isConflictError(err) {
-			log.Printf("[DEBUG]: Concurrent policy changes, restarting read-modify-write after %s\n", backoff)
-			time.Sleep(backoff)
-			backoff = backoff * 2
-			if backoff > 30*time.Second {
-				return fmt.Errorf("Error applying IAM policy to %s: too many concurrent policy changes.\n", updater.DescribeResource())
+		return nil
+	}, time.Minute, isConcurrentPolicyModificationError)
+	if err != nil {
+		return err
+	}
+
+	// Reads checking that the change has propagated are paced by a limiter
+	// shared by every IAM resource, and they stop as soon as the provider is
+	// stopped. Like every other request they also wait for the rate limit of
+	// the API, if one is configured.
+	deadline := time.Now().Add(iamPropagationCheckTimeout)
+	for successfulFetches := 0; successfulFetches < 3; {
+		if config.iamPropagationLimiter != nil {
+			if err := config.iamPropagationLimiter.Wait(config.stopContext()); err != nil {
+				return fmt.Errorf("Error checking IAM policy for %s: %s", updater.DescribeResource(), err)
 			}
-			continue
 		}
-		return errwrap.Wrapf(fmt.Sprintf("Error applying IAM policy for %s: {{err}}", updater.DescribeResource()), err)
+		p, err := updater.GetResourceIamPolicy()
+		if err != nil {
+			return err
+		}
+		// This relies on the fact that `modify` is idempotent: since other
+		// changes might have happened between the call to set the policy and
+		// now, we just need to make sure that our change has been made, i.e.
+		// that `modify` doesn't change the policy anymore.
+		changed, err := iamPolicyChangedBy(p, modify)
+		if err != nil {
+			return err
+		}
+		if !changed {
+			successfulFetches += 1
+		} else if time.Now().After(deadline) {
+			return fmt.Errorf("Error applying IAM policy to %s: Waited too long for propagation.\n", updater.DescribeResource())
+		}
 	}
 	log.Printf("[DEBUG]: Set policy for %s", updater.DescribeResource())
 	return nil
 }
 
-// Merge multiple Bindings such that Bindings with the same Role result in
-// a single Binding with combined Members
-func mergeBindings(bindings []*cloudresourcemanager.Binding) []*cloudresourcemanager.Binding {
+// iamPolicyChangedBy returns whether modify changes p, which is left as is.
+// Bindings and audit configs are compared regardless of their order.
+func iamPolicyChangedBy(p *IamPolicy, modify iamPolicyModifyFunc) (bool, error) {
+	modified, err := copyIamPolicy(p)
+	if err != nil {
+		return false, err
+	}
+	if err := modify(modified); err != nil {
+		return false, err
+	}
+	return !reflect.DeepEqual(rolesToMembersMap(p.Bindings), rolesToMembersMap(modified.Bindings)) ||
+		!auditConfigsEqual(p.AuditConfigs, modified.AuditConfigs), nil
+}
+
+func copyIamPolicy(p *IamPolicy) (*IamPolicy, error) {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("Error copying IAM policy: %s", err)
+	}
+	c := &IamPolicy{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, fmt.Errorf("Error copying IAM policy: %s", err)
+	}
+	return c, nil
+}
+
+// getIamPolicyWithBody reads the IAM policy of a resource with a POST
+// getIamPolicy request, which asks for the policy version in its body.
+func getIamPolicyWithBody(config *Config, rawurl, project string) (*IamPolicy, error) {
+	res, err := Post(config, rawurl, project, map[string]interface{}{
+		"options": map[string]interface{}{
+			"requestedPolicyVersion": iamPolicyVersion,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return iamPolicyFromResponse(res)
+}
+
+// getIamPolicyWithQuery reads the IAM policy of a resource with a
+// getIamPolicy request which asks for the policy version in the query
+// parameter versionParam, e.g. `options.requestedPolicyVersion`.
+func getIamPolicyWithQuery(config *Config, method, rawurl, versionParam, project string) (*IamPolicy, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, err
+	}
+	q := u.Query()
+	q.Set(versionParam, strconv.Itoa(iamPolicyVersion))
+	u.RawQuery = q.Encode()
+
+	res, err := sendRequest(config, method, u.String(), project, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iamPolicyFromResponse(res)
+}
+
+// setIamPolicyWithBody replaces the IAM policy of a resource with a POST
+// setIamPolicy request.
+func setIamPolicyWithBody(config *Config, rawurl, project string, policy *IamPolicy) error {
+	policy.Version = iamPolicyVersion
+	_, err := Post(config, rawurl, project, map[string]interface{}{
+		"policy": policy,
+	})
+	return err
+}
+
+// setResourceManagerIamPolicy is like setIamPolicyWithBody, for the Resource
+// Manager APIs which only update the audit configs of a policy when asked to
+// by the update mask of the request.
+func setResourceManagerIamPolicy(config *Config, rawurl, project string, policy *IamPolicy) error {
+	policy.Version = iamPolicyVersion
+	_, err := Post(config, rawurl, project, map[string]interface{}{
+		"policy":     policy,
+		"updateMask": "bindings,etag,auditConfigs",
+	})
+	return err
+}
+
+func iamPolicyFromResponse(res map[string]interface{}) (*IamPolicy, error) {
+	policy := &IamPolicy{}
+	if err := Convert(res, policy); err != nil {
+		return nil, fmt.Errorf("Invalid IAM policy %v: %s", res, err)
+	}
+	return policy, nil
+}
+
+// iamBindingKey identifies the binding of a role with a condition. Bindings
+// of the same role with different conditions, or without a condition, are
+// distinct bindings.
+type iamBindingKey struct {
+	Role                           string
+	Title, Description, Expression string
+}
+
+func (b *IamBinding) key() iamBindingKey {
+	k := iamBindingKey{Role: b.Role}
+	if b.Condition != nil {
+		k.Title = b.Condition.Title
+		k.Description = b.Condition.Description
+		k.Expression = b.Condition.Expression
+	}
+	return k
+}
+
+func (k iamBindingKey) binding() *IamBinding {
+	b := &IamBinding{Role: k.Role}
+	if k != (iamBindingKey{Role: k.Role}) {
+		b.Condition = &IamCondition{
+			Title:       k.Title,
+			Description: k.Description,
+			Expression:  k.Expression,
+		}
+	}
+	return b
+}
+
+// matches returns whether b is the binding e, ignoring its members. Imported
+// bindings only know the title of their condition, which then identifies it.
+func (b *IamBinding) matches(e *IamBinding) bool {
+	if e.Condition != nil && e.Condition.Expression == "" {
+		return b.Role == e.Role && b.Condition != nil && b.Condition.Title == e.Condition.Title
+	}
+	return b.key() == e.key()
+}
+
+// Merge multiple Bindings such that Bindings with the same Role and
+// Condition result in a single Binding with combined Members
+func mergeBindings(bindings []*IamBinding) []*IamBinding {
 	bm := rolesToMembersMap(bindings)
-	rb := make([]*cloudresourcemanager.Binding, 0)
+	rb := make([]*IamBinding, 0)
 
-	for role, members := range bm {
-		var b cloudresourcemanager.Binding
-		b.Role = role
+	for k, members := range bm {
+		b := k.binding()
 		b.Members = make([]string, 0)
 		for m := range members {
 			b.Members = append(b.Members, m)
 		}
 		if len(b.Members) > 0 {
-			rb = append(rb, &b)
+			rb = append(rb, b)
 		}
 	}
 
 	return rb
 }
 
-// Map a role to a map of members, allowing easy merging of multiple bindings.
-func rolesToMembersMap(bindings []*cloudresourcemanager.Binding) map[string]map[string]bool {
-	bm := make(map[string]map[string]bool)
+// Map a role, and the condition of its binding, to a map of members, allowing
+// easy merging of multiple bindings.
+func rolesToMembersMap(bindings []*IamBinding) map[iamBindingKey]map[string]bool {
+	bm := make(map[iamBindingKey]map[string]bool)
 	// Get each binding
 	for _, b := range bindings {
+		k := b.key()
 		// Initialize members map
-		if _, ok := bm[b.Role]; !ok {
-			bm[b.Role] = make(map[string]bool)
+		if _, ok := bm[k]; !ok {
+			bm[k] = make(map[string]bool)
 		}
 		// Get each member (user/principal) for the binding
 		for _, m := range b.Members {
 			// Add the member
-			bm[b.Role][m] = true
+			bm[k][m] = true
 		}
 	}
 	return bm
 }
+
+// setAuditConfig returns auditConfigs with the audit config of the service of
+// ac replaced by ac.
+func setAuditConfig(auditConfigs []*cloudresourcemanager.AuditConfig, ac *cloudresourcemanager.AuditConfig) []*cloudresourcemanager.AuditConfig {
+	configs := []*cloudresourcemanager.AuditConfig{ac}
+	for _, c := range auditConfigs {
+		if c.Service != ac.Service {
+			configs = append(configs, c)
+		}
+	}
+	return configs
+}
+
+func expandAuditLogConfigs(s *schema.Set) []*cloudresourcemanager.AuditLogConfig {
+	configs := make([]*cloudresourcemanager.AuditLogConfig, 0, s.Len())
+	for _, v := range s.List() {
+		raw := v.(map[string]interface{})
+		configs = append(configs, &cloudresourcemanager.AuditLogConfig{
+			LogType:         raw["log_type"].(string),
+			ExemptedMembers: convertStringSet(raw["exempted_members"].(*schema.Set)),
+		})
+	}
+	return configs
+}
+
+func flattenAuditLogConfigs(configs []*cloudresourcemanager.AuditLogConfig) []map[string]interface{} {
+	l := make([]map[string]interface{}, 0, len(configs))
+	for _, c := range configs {
+		l = append(l, map[string]interface{}{
+			"log_type":         c.LogType,
+			"exempted_members": schema.NewSet(schema.HashString, convertStringArrToInterface(c.ExemptedMembers)),
+		})
+	}
+	return l
+}
+
+// auditConfigsEqual returns whether a and b configure the same audit logs,
+// regardless of their order.
+func auditConfigsEqual(a, b []*cloudresourcemanager.AuditConfig) bool {
+	return reflect.DeepEqual(auditConfigsMap(a), auditConfigsMap(b))
+}
+
+// auditConfigsMap maps each service and log type to its exempted members.
+func auditConfigsMap(auditConfigs []*cloudresourcemanager.AuditConfig) map[string]map[string]map[string]bool {
+	m := make(map[string]map[string]map[string]bool)
+	for _, ac := range auditConfigs {
+		if _, ok := m[ac.Service]; !ok {
+			m[ac.Service] = make(map[string]map[string]bool)
+		}
+		for _, c := range ac.AuditLogConfigs {
+			if _, ok := m[ac.Service][c.LogType]; !ok {
+				m[ac.Service][c.LogType] = make(map[string]bool)
+			}
+			for _, member := range c.ExemptedMembers {
+				m[ac.Service][c.LogType][member] = true
+			}
+		}
+	}
+	return m
+}
@@ -0,0 +1,111 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// An iamPolicyBatcher coalesces the modifications of the IAM policy of the
+// same resource, e.g. by many google_project_iam_member resources, so that
+// they are applied by a single read-modify-write rather than one each.
+//
+// The first modification of a policy starts a batch, which is sent once
+// sendAfter has elapsed. The modifications received meanwhile are added to
+// it. A modification failing is skipped and gets its own error, while the
+// others are applied and get the result of the batch.
+type iamPolicyBatcher struct {
+	sendAfter time.Duration
+
+	mu sync.Mutex
+	// Pending batches keyed by the mutex key of their updater, which
+	// identifies the resource of the policy.
+	batches map[string]*iamPolicyBatch
+}
+
+type iamPolicyBatch struct {
+	updater  ResourceIamUpdater
+	modifies []iamPolicyModifyFunc
+
+	// Closed once the batch is applied, err being its result and errs the
+	// errors of the modifications which were skipped.
+	done chan struct{}
+	err  error
+	errs []error
+}
+
+func newIamPolicyBatcher(sendAfter time.Duration) *iamPolicyBatcher {
+	return &iamPolicyBatcher{
+		sendAfter: sendAfter,
+		batches:   make(map[string]*iamPolicyBatch),
+	}
+}
+
+// readModifyWrite adds modify to the batch of the policy of the resource of
+// updater, and waits for the batch to be applied.
+func (b *iamPolicyBatcher) readModifyWrite(config *Config, updater ResourceIamUpdater, modify iamPolicyModifyFunc) error {
+	key := updater.GetMutexKey()
+
+	b.mu.Lock()
+	batch, ok := b.batches[key]
+	if !ok {
+		batch = &iamPolicyBatch{
+			updater: updater,
+			done:    make(chan struct{}),
+		}
+		b.batches[key] = batch
+		time.AfterFunc(b.sendAfter, func() {
+			b.send(config, key)
+		})
+	}
+	i := len(batch.modifies)
+	batch.modifies = append(batch.modifies, modify)
+	b.mu.Unlock()
+
+	<-batch.done
+	if batch.errs[i] != nil {
+		return batch.errs[i]
+	}
+	return batch.err
+}
+
+// send applies the pending batch of key. Modifications received from now on
+// start a new batch, which waits for this one to be applied as both hold the
+// mutex of the policy.
+func (b *iamPolicyBatcher) send(config *Config, key string) {
+	b.mu.Lock()
+	batch := b.batches[key]
+	delete(b.batches, key)
+	b.mu.Unlock()
+
+	log.Printf("[DEBUG]: Applying %d batched modifications of the policy for %s", len(batch.modifies), batch.updater.DescribeResource())
+	batch.errs = make([]error, len(batch.modifies))
+	batch.err = iamPolicyReadModifyWriteNow(config, batch.updater, func(p *IamPolicy) error {
+		// Each modification is applied to a copy of the policy, so that one
+		// failing leaves no partial change behind. Once failed, it is skipped
+		// by the following reads too, including those checking propagation.
+		applied := 0
+		for i, modify := range batch.modifies {
+			if batch.errs[i] != nil {
+				continue
+			}
+			modified, err := copyIamPolicy(p)
+			if err != nil {
+				return err
+			}
+			if err := modify(modified); err != nil {
+				log.Printf("[DEBUG]: Skipping batched modification of the policy for %s: %s", batch.updater.DescribeResource(), err)
+				batch.errs[i] = err
+				continue
+			}
+			*p = *modified
+			applied++
+		}
+		if applied == 0 {
+			return fmt.Errorf("Error applying IAM policy for %s: every batched modification failed", batch.updater.DescribeResource())
+		}
+		return nil
+	})
+	close(batch.done)
+}
@@ -0,0 +1,116 @@
+package google
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingIamUpdater counts the policies set through an updater.
+type countingIamUpdater struct {
+	ResourceIamUpdater
+	sets int32
+}
+
+func (u *countingIamUpdater) SetResourceIamPolicy(policy *IamPolicy) error {
+	atomic.AddInt32(&u.sets, 1)
+	return u.ResourceIamUpdater.SetResourceIamPolicy(policy)
+}
+
+func TestIamPolicyBatcher(t *testing.T) {
+	t.Parallel()
+
+	s := newFakeGcpServer(t)
+	defer s.Close()
+	config := testFakeGcpConfig(t, s)
+	config.iamBatcher = newIamPolicyBatcher(time.Second)
+	updater := &countingIamUpdater{
+		ResourceIamUpdater: &ProjectIamUpdater{resourceId: fakeGcpProject, Config: config},
+	}
+
+	members := []string{"user:a@example.com", "user:b@example.com", "user:c@example.com"}
+	errs := make([]error, len(members))
+	var wg sync.WaitGroup
+	for i, member := range members {
+		wg.Add(1)
+		go func(i int, member string) {
+			defer wg.Done()
+			errs[i] = iamPolicyReadModifyWrite(config, updater, func(p *IamPolicy) error {
+				p.Bindings = mergeBindings(append(p.Bindings, &IamBinding{Role: "roles/viewer", Members: []string{member}}))
+				return nil
+			})
+		}(i, member)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("Error adding %s: %s", members[i], err)
+		}
+	}
+	if sets := atomic.LoadInt32(&updater.sets); sets != 1 {
+		t.Errorf("Expected the modifications to be applied by 1 request, got %d", sets)
+	}
+	if err := testFakeProjectIamMembers(s, "roles/viewer", len(members))(nil); err != nil {
+		t.Error(err)
+	}
+
+	// A modification failing gets its own error, and doesn't prevent the
+	// others of the batch from being applied.
+	member := "user:d@example.com"
+	failure := fmt.Errorf("modification failed")
+	errs = make([]error, 2)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		errs[0] = iamPolicyReadModifyWrite(config, updater, func(p *IamPolicy) error {
+			p.Bindings = nil
+			return failure
+		})
+	}()
+	go func() {
+		defer wg.Done()
+		errs[1] = iamPolicyReadModifyWrite(config, updater, func(p *IamPolicy) error {
+			p.Bindings = mergeBindings(append(p.Bindings, &IamBinding{Role: "roles/viewer", Members: []string{member}}))
+			return nil
+		})
+	}()
+	wg.Wait()
+
+	if errs[0] != failure {
+		t.Errorf("Expected the failing modification to get its error, got %v", errs[0])
+	}
+	if errs[1] != nil {
+		t.Errorf("Error adding %s: %s", member, errs[1])
+	}
+	if sets := atomic.LoadInt32(&updater.sets); sets != 2 {
+		t.Errorf("Expected the modifications to be applied by 1 more request, got %d", sets-1)
+	}
+	if err := testFakeProjectIamMembers(s, "roles/viewer", len(members)+1)(nil); err != nil {
+		t.Error(err)
+	}
+
+	// Nothing is written when every modification of the batch fails.
+	errs = make([]error, 2)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = iamPolicyReadModifyWrite(config, updater, func(p *IamPolicy) error {
+				return fmt.Errorf("modification %d failed", i)
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if expected := fmt.Sprintf("modification %d failed", i); err == nil || err.Error() != expected {
+			t.Errorf("Expected modification %d to fail with %q, got %v", i, expected, err)
+		}
+	}
+	if sets := atomic.LoadInt32(&updater.sets); sets != 2 {
+		t.Errorf("Expected no more requests, got %d", sets-2)
+	}
+}
@@ -0,0 +1,230 @@
+package google
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+var IamBigqueryDatasetSchema = map[string]*schema.Schema{
+	"dataset_id": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	},
+}
+
+// BigQuery datasets have no IAM policy: access is granted by the access list
+// of the dataset, whose basic roles have IAM equivalents.
+var bigqueryAccessPrimitiveToRoleMap = map[string]string{
+	"OWNER":  "roles/bigquery.dataOwner",
+	"WRITER": "roles/bigquery.dataEditor",
+	"READER": "roles/bigquery.dataViewer",
+}
+
+type BigqueryDatasetIamUpdater struct {
+	project   string
+	datasetId string
+	Config    *Config
+}
+
+func NewBigqueryDatasetIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	project, err := getProject(d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BigqueryDatasetIamUpdater{
+		project:   project,
+		datasetId: d.Get("dataset_id").(string),
+		Config:    config,
+	}, nil
+}
+
+func BigqueryDatasetIdParseFunc(d *schema.ResourceData, config *Config) error {
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/datasets/(?P<dataset_id>[^/]+)",
+		"(?P<project>[^/]+)/(?P<dataset_id>[^/]+)",
+		"(?P<dataset_id>[^/]+)",
+	}, d, config); err != nil {
+		return err
+	}
+
+	// Explicitly set the id so imported resources have the same ID format as non-imported ones.
+	d.SetId(fmt.Sprintf("projects/%s/datasets/%s", d.Get("project").(string), d.Get("dataset_id").(string)))
+	return nil
+}
+
+func (u *BigqueryDatasetIamUpdater) GetResourceIamPolicy() (*IamPolicy, error) {
+	res, err := Get(u.Config, u.datasetUrl(), u.project)
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	access, _ := res["access"].([]interface{})
+	policy, err := bigqueryAccessToIamPolicy(access)
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+	policy.Etag, _ = res["etag"].(string)
+	return policy, nil
+}
+
+func (u *BigqueryDatasetIamUpdater) SetResourceIamPolicy(policy *IamPolicy) error {
+	res, err := Get(u.Config, u.datasetUrl(), u.project)
+	if err != nil {
+		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	// The authorized views of the dataset aren't members of the policy, and
+	// are kept.
+	access := make([]interface{}, 0)
+	current, _ := res["access"].([]interface{})
+	for _, raw := range current {
+		if entry, ok := raw.(map[string]interface{}); ok && entry["view"] != nil {
+			access = append(access, entry)
+		}
+	}
+	policyAccess, err := iamPolicyToBigqueryAccess(policy)
+	if err != nil {
+		return fmt.Errorf("Error setting IAM policy for %s: %s", u.DescribeResource(), err)
+	}
+	access = append(access, policyAccess...)
+
+	// The dataset must not have changed since the policy was read, otherwise
+	// the access entries granted or revoked since then would be overwritten.
+	// Policies that weren't read from the dataset are checked against the
+	// dataset read above, whose authorized views are kept.
+	etag := policy.Etag
+	if etag == "" {
+		etag, _ = res["etag"].(string)
+	}
+	headers := make(http.Header)
+	if etag != "" {
+		headers.Set("If-Match", etag)
+	}
+	_, err = sendRequestWithHeaders(u.Config, "PATCH", u.datasetUrl(), u.project, headers, map[string]interface{}{
+		"access": access,
+	}, []string{"access"}, nil)
+	if err != nil {
+		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return nil
+}
+
+func (u *BigqueryDatasetIamUpdater) datasetUrl() string {
+	return fmt.Sprintf("%sprojects/%s/datasets/%s", u.Config.basePath(BigQueryBasePathKey), u.project, u.datasetId)
+}
+
+func (u *BigqueryDatasetIamUpdater) GetResourceId() string {
+	return fmt.Sprintf("projects/%s/datasets/%s", u.project, u.datasetId)
+}
+
+func (u *BigqueryDatasetIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-bigquery-dataset-%s-%s", u.project, u.datasetId)
+}
+
+func (u *BigqueryDatasetIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("BigQuery Dataset %s/%s", u.project, u.datasetId)
+}
+
+// bigqueryAccessToIamPolicy returns the bindings granted by the access list of
+// a dataset. Authorized views are skipped.
+func bigqueryAccessToIamPolicy(access []interface{}) (*IamPolicy, error) {
+	members := make(map[string][]string)
+	var roles []string
+	for _, raw := range access {
+		entry, ok := raw.(map[string]interface{})
+		if !ok || entry["view"] != nil {
+			continue
+		}
+		role, _ := entry["role"].(string)
+		if r, ok := bigqueryAccessPrimitiveToRoleMap[role]; ok {
+			role = r
+		}
+		member, err := bigqueryAccessMember(entry)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := members[role]; !ok {
+			roles = append(roles, role)
+		}
+		members[role] = append(members[role], member)
+	}
+
+	policy := &IamPolicy{}
+	for _, role := range roles {
+		policy.Bindings = append(policy.Bindings, &IamBinding{Role: role, Members: members[role]})
+	}
+	return policy, nil
+}
+
+func bigqueryAccessMember(entry map[string]interface{}) (string, error) {
+	if email, ok := entry["userByEmail"].(string); ok {
+		// Service accounts are granted access as users.
+		if strings.HasSuffix(email, ".gserviceaccount.com") {
+			return "serviceAccount:" + email, nil
+		}
+		return "user:" + email, nil
+	}
+	if email, ok := entry["groupByEmail"].(string); ok {
+		return "group:" + email, nil
+	}
+	if domain, ok := entry["domain"].(string); ok {
+		return "domain:" + domain, nil
+	}
+	// e.g. allAuthenticatedUsers or projectOwners.
+	if group, ok := entry["specialGroup"].(string); ok {
+		return group, nil
+	}
+	if member, ok := entry["iamMember"].(string); ok {
+		return member, nil
+	}
+	return "", fmt.Errorf("Unsupported dataset access entry %v", entry)
+}
+
+// iamPolicyToBigqueryAccess returns the access list granting the bindings of
+// policy.
+func iamPolicyToBigqueryAccess(policy *IamPolicy) ([]interface{}, error) {
+	var access []interface{}
+	for _, b := range policy.Bindings {
+		if b.Condition != nil {
+			return nil, errors.New("BigQuery datasets don't support IAM conditions")
+		}
+		role := b.Role
+		for primitive, r := range bigqueryAccessPrimitiveToRoleMap {
+			if r == role {
+				role = primitive
+			}
+		}
+		for _, member := range b.Members {
+			entry := map[string]interface{}{"role": role}
+			parts := strings.SplitN(member, ":", 2)
+			switch {
+			case len(parts) == 2 && (parts[0] == "user" || parts[0] == "serviceAccount"):
+				entry["userByEmail"] = parts[1]
+			case len(parts) == 2 && parts[0] == "group":
+				entry["groupByEmail"] = parts[1]
+			case len(parts) == 2 && parts[0] == "domain":
+				entry["domain"] = parts[1]
+			case member == "allAuthenticatedUsers" || strings.HasPrefix(member, "project"):
+				entry["specialGroup"] = member
+			default:
+				entry["iamMember"] = member
+			}
+			access = append(access, entry)
+		}
+	}
+	return access, nil
+}
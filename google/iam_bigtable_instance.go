@@ -0,0 +1,89 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+var IamBigtableInstanceSchema = map[string]*schema.Schema{
+	"instance": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	},
+}
+
+type BigtableInstanceIamUpdater struct {
+	project  string
+	instance string
+	Config   *Config
+}
+
+func NewBigtableInstanceIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	project, err := getProject(d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BigtableInstanceIamUpdater{
+		project:  project,
+		instance: d.Get("instance").(string),
+		Config:   config,
+	}, nil
+}
+
+func BigtableInstanceIdParseFunc(d *schema.ResourceData, config *Config) error {
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/instances/(?P<instance>[^/]+)",
+		"(?P<project>[^/]+)/(?P<instance>[^/]+)",
+		"(?P<instance>[^/]+)",
+	}, d, config); err != nil {
+		return err
+	}
+
+	// Explicitly set the id so imported resources have the same ID format as non-imported ones.
+	d.SetId(fmt.Sprintf("projects/%s/instances/%s", d.Get("project").(string), d.Get("instance").(string)))
+	return nil
+}
+
+func (u *BigtableInstanceIamUpdater) GetResourceIamPolicy() (*IamPolicy, error) {
+	url := fmt.Sprintf("%sprojects/%s/instances/%s:getIamPolicy", u.Config.basePath(BigtableAdminBasePathKey), u.project, u.instance)
+	p, err := getIamPolicyWithBody(u.Config, url, u.project)
+
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return p, nil
+}
+
+func (u *BigtableInstanceIamUpdater) SetResourceIamPolicy(policy *IamPolicy) error {
+	url := fmt.Sprintf("%sprojects/%s/instances/%s:setIamPolicy", u.Config.basePath(BigtableAdminBasePathKey), u.project, u.instance)
+	err := setIamPolicyWithBody(u.Config, url, u.project, policy)
+
+	if err != nil {
+		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return nil
+}
+
+func (u *BigtableInstanceIamUpdater) GetResourceId() string {
+	return fmt.Sprintf("projects/%s/instances/%s", u.project, u.instance)
+}
+
+func (u *BigtableInstanceIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-bigtable-instance-%s-%s", u.project, u.instance)
+}
+
+func (u *BigtableInstanceIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("Bigtable Instance %s/%s", u.project, u.instance)
+}
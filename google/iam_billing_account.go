@@ -0,0 +1,67 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+var IamBillingAccountSchema = map[string]*schema.Schema{
+	"billing_account_id": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+}
+
+type BillingAccountIamUpdater struct {
+	billingAccountId string
+	Config           *Config
+}
+
+func NewBillingAccountIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	return &BillingAccountIamUpdater{
+		billingAccountId: d.Get("billing_account_id").(string),
+		Config:           config,
+	}, nil
+}
+
+func BillingAccountIdParseFunc(d *schema.ResourceData, _ *Config) error {
+	d.Set("billing_account_id", d.Id())
+	return nil
+}
+
+func (u *BillingAccountIamUpdater) GetResourceIamPolicy() (*IamPolicy, error) {
+	url := fmt.Sprintf("%sbillingAccounts/%s:getIamPolicy", u.Config.basePath(CloudBillingBasePathKey), u.billingAccountId)
+	p, err := getIamPolicyWithQuery(u.Config, "GET", url, "options.requestedPolicyVersion", "")
+
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return p, nil
+}
+
+func (u *BillingAccountIamUpdater) SetResourceIamPolicy(policy *IamPolicy) error {
+	url := fmt.Sprintf("%sbillingAccounts/%s:setIamPolicy", u.Config.basePath(CloudBillingBasePathKey), u.billingAccountId)
+	err := setIamPolicyWithBody(u.Config, url, "", policy)
+
+	if err != nil {
+		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return nil
+}
+
+func (u *BillingAccountIamUpdater) GetResourceId() string {
+	return u.billingAccountId
+}
+
+func (u *BillingAccountIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-billing-account-%s", u.billingAccountId)
+}
+
+func (u *BillingAccountIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("billing account %q", u.billingAccountId)
+}
@@ -0,0 +1,103 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+var IamCloudFunctionsFunctionSchema = map[string]*schema.Schema{
+	"cloud_function": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	},
+	"region": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	},
+}
+
+type CloudFunctionsFunctionIamUpdater struct {
+	project  string
+	region   string
+	function string
+	Config   *Config
+}
+
+func NewCloudFunctionsFunctionIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	project, err := getProject(d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CloudFunctionsFunctionIamUpdater{
+		project:  project,
+		region:   region,
+		function: d.Get("cloud_function").(string),
+		Config:   config,
+	}, nil
+}
+
+func CloudFunctionsFunctionIdParseFunc(d *schema.ResourceData, config *Config) error {
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/functions/(?P<cloud_function>[^/]+)",
+		"(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<cloud_function>[^/]+)",
+		"(?P<region>[^/]+)/(?P<cloud_function>[^/]+)",
+		"(?P<cloud_function>[^/]+)",
+	}, d, config); err != nil {
+		return err
+	}
+
+	// Explicitly set the id so imported resources have the same ID format as non-imported ones.
+	d.SetId(fmt.Sprintf("projects/%s/locations/%s/functions/%s", d.Get("project").(string), d.Get("region").(string), d.Get("cloud_function").(string)))
+	return nil
+}
+
+func (u *CloudFunctionsFunctionIamUpdater) GetResourceIamPolicy() (*IamPolicy, error) {
+	url := fmt.Sprintf("%sprojects/%s/locations/%s/functions/%s:getIamPolicy", u.Config.basePath(CloudFunctionsBasePathKey), u.project, u.region, u.function)
+	p, err := getIamPolicyWithQuery(u.Config, "GET", url, "options.requestedPolicyVersion", u.project)
+
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return p, nil
+}
+
+func (u *CloudFunctionsFunctionIamUpdater) SetResourceIamPolicy(policy *IamPolicy) error {
+	url := fmt.Sprintf("%sprojects/%s/locations/%s/functions/%s:setIamPolicy", u.Config.basePath(CloudFunctionsBasePathKey), u.project, u.region, u.function)
+	err := setIamPolicyWithBody(u.Config, url, u.project, policy)
+
+	if err != nil {
+		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return nil
+}
+
+func (u *CloudFunctionsFunctionIamUpdater) GetResourceId() string {
+	return fmt.Sprintf("projects/%s/locations/%s/functions/%s", u.project, u.region, u.function)
+}
+
+func (u *CloudFunctionsFunctionIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-cloudfunctions-function-%s-%s-%s", u.project, u.region, u.function)
+}
+
+func (u *CloudFunctionsFunctionIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("Cloud Functions Function %s/%s/%s", u.project, u.region, u.function)
+}
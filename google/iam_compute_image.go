@@ -0,0 +1,89 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+var IamComputeImageSchema = map[string]*schema.Schema{
+	"image": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	},
+}
+
+type ComputeImageIamUpdater struct {
+	project string
+	image   string
+	Config  *Config
+}
+
+func NewComputeImageIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	project, err := getProject(d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ComputeImageIamUpdater{
+		project: project,
+		image:   d.Get("image").(string),
+		Config:  config,
+	}, nil
+}
+
+func ComputeImageIdParseFunc(d *schema.ResourceData, config *Config) error {
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/global/images/(?P<image>[^/]+)",
+		"(?P<project>[^/]+)/(?P<image>[^/]+)",
+		"(?P<image>[^/]+)",
+	}, d, config); err != nil {
+		return err
+	}
+
+	// Explicitly set the id so imported resources have the same ID format as non-imported ones.
+	d.SetId(fmt.Sprintf("projects/%s/global/images/%s", d.Get("project").(string), d.Get("image").(string)))
+	return nil
+}
+
+func (u *ComputeImageIamUpdater) GetResourceIamPolicy() (*IamPolicy, error) {
+	url := fmt.Sprintf("%sprojects/%s/global/images/%s/getIamPolicy", u.Config.basePath(ComputeBetaBasePathKey), u.project, u.image)
+	p, err := getIamPolicyWithQuery(u.Config, "GET", url, "optionsRequestedPolicyVersion", u.project)
+
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return p, nil
+}
+
+func (u *ComputeImageIamUpdater) SetResourceIamPolicy(policy *IamPolicy) error {
+	url := fmt.Sprintf("%sprojects/%s/global/images/%s/setIamPolicy", u.Config.basePath(ComputeBetaBasePathKey), u.project, u.image)
+	err := setIamPolicyWithBody(u.Config, url, u.project, policy)
+
+	if err != nil {
+		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return nil
+}
+
+func (u *ComputeImageIamUpdater) GetResourceId() string {
+	return fmt.Sprintf("projects/%s/global/images/%s", u.project, u.image)
+}
+
+func (u *ComputeImageIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-compute-image-%s-%s", u.project, u.image)
+}
+
+func (u *ComputeImageIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("Compute Image %s/%s", u.project, u.image)
+}
@@ -0,0 +1,103 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+var IamComputeInstanceSchema = map[string]*schema.Schema{
+	"instance_name": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	},
+	"zone": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	},
+}
+
+type ComputeInstanceIamUpdater struct {
+	project      string
+	zone         string
+	instanceName string
+	Config       *Config
+}
+
+func NewComputeInstanceIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	project, err := getProject(d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ComputeInstanceIamUpdater{
+		project:      project,
+		zone:         zone,
+		instanceName: d.Get("instance_name").(string),
+		Config:       config,
+	}, nil
+}
+
+func ComputeInstanceIdParseFunc(d *schema.ResourceData, config *Config) error {
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/instances/(?P<instance_name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<instance_name>[^/]+)",
+		"(?P<zone>[^/]+)/(?P<instance_name>[^/]+)",
+		"(?P<instance_name>[^/]+)",
+	}, d, config); err != nil {
+		return err
+	}
+
+	// Explicitly set the id so imported resources have the same ID format as non-imported ones.
+	d.SetId(fmt.Sprintf("projects/%s/zones/%s/instances/%s", d.Get("project").(string), d.Get("zone").(string), d.Get("instance_name").(string)))
+	return nil
+}
+
+func (u *ComputeInstanceIamUpdater) GetResourceIamPolicy() (*IamPolicy, error) {
+	url := fmt.Sprintf("%sprojects/%s/zones/%s/instances/%s/getIamPolicy", u.Config.basePath(ComputeBetaBasePathKey), u.project, u.zone, u.instanceName)
+	p, err := getIamPolicyWithQuery(u.Config, "GET", url, "optionsRequestedPolicyVersion", u.project)
+
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return p, nil
+}
+
+func (u *ComputeInstanceIamUpdater) SetResourceIamPolicy(policy *IamPolicy) error {
+	url := fmt.Sprintf("%sprojects/%s/zones/%s/instances/%s/setIamPolicy", u.Config.basePath(ComputeBetaBasePathKey), u.project, u.zone, u.instanceName)
+	err := setIamPolicyWithBody(u.Config, url, u.project, policy)
+
+	if err != nil {
+		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return nil
+}
+
+func (u *ComputeInstanceIamUpdater) GetResourceId() string {
+	return fmt.Sprintf("projects/%s/zones/%s/instances/%s", u.project, u.zone, u.instanceName)
+}
+
+func (u *ComputeInstanceIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-compute-instance-%s-%s-%s", u.project, u.zone, u.instanceName)
+}
+
+func (u *ComputeInstanceIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("Compute Instance %s/%s/%s", u.project, u.zone, u.instanceName)
+}
@@ -6,8 +6,6 @@ import (
 
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
-	"google.golang.org/api/cloudresourcemanager/v1"
-	computeBeta "google.golang.org/api/compute/v0.beta"
 )
 
 var IamComputeSubnetworkSchema = map[string]*schema.Schema{
@@ -98,33 +96,20 @@ func ComputeSubnetworkIdParseFunc(d *schema.ResourceData, config *Config) error
 	return nil
 }
 
-func (u *ComputeSubnetworkIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
-	p, err := u.Config.clientComputeBeta.Subnetworks.GetIamPolicy(u.project, u.region, u.resourceId).Do()
+func (u *ComputeSubnetworkIamUpdater) GetResourceIamPolicy() (*IamPolicy, error) {
+	url := fmt.Sprintf("%sprojects/%s/regions/%s/subnetworks/%s/getIamPolicy", u.Config.basePath(ComputeBetaBasePathKey), u.project, u.region, u.resourceId)
+	p, err := getIamPolicyWithQuery(u.Config, "GET", url, "optionsRequestedPolicyVersion", u.project)
 
 	if err != nil {
 		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
 	}
 
-	cloudResourcePolicy, err := computeBetaToResourceManagerPolicy(p)
-
-	if err != nil {
-		return nil, errwrap.Wrapf(fmt.Sprintf("Invalid IAM policy for %s: {{err}}", u.DescribeResource()), err)
-	}
-
-	return cloudResourcePolicy, nil
+	return p, nil
 }
 
-func (u *ComputeSubnetworkIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
-	computePolicy, err := resourceManagerToComputeBetaPolicy(policy)
-
-	if err != nil {
-		return errwrap.Wrapf(fmt.Sprintf("Invalid IAM policy for %s: {{err}}", u.DescribeResource()), err)
-	}
-
-	req := &computeBeta.RegionSetPolicyRequest{
-		Policy: computePolicy,
-	}
-	_, err = u.Config.clientComputeBeta.Subnetworks.SetIamPolicy(u.project, u.region, u.resourceId, req).Do()
+func (u *ComputeSubnetworkIamUpdater) SetResourceIamPolicy(policy *IamPolicy) error {
+	url := fmt.Sprintf("%sprojects/%s/regions/%s/subnetworks/%s/setIamPolicy", u.Config.basePath(ComputeBetaBasePathKey), u.project, u.region, u.resourceId)
+	err := setIamPolicyWithBody(u.Config, url, u.project, policy)
 
 	if err != nil {
 		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
@@ -144,21 +129,3 @@ func (u *ComputeSubnetworkIamUpdater) GetMutexKey() string {
 func (u *ComputeSubnetworkIamUpdater) DescribeResource() string {
 	return fmt.Sprintf("Compute Subnetwork %s/%s/%s", u.project, u.region, u.resourceId)
 }
-
-func resourceManagerToComputeBetaPolicy(p *cloudresourcemanager.Policy) (*computeBeta.Policy, error) {
-	out := &computeBeta.Policy{}
-	err := Convert(p, out)
-	if err != nil {
-		return nil, errwrap.Wrapf("Cannot convert a resourcemanager policy to a compute policy: {{err}}", err)
-	}
-	return out, nil
-}
-
-func computeBetaToResourceManagerPolicy(p *computeBeta.Policy) (*cloudresourcemanager.Policy, error) {
-	out := &cloudresourcemanager.Policy{}
-	err := Convert(p, out)
-	if err != nil {
-		return nil, errwrap.Wrapf("Cannot convert a compute policy to a resourcemanager policy: {{err}}", err)
-	}
-	return out, nil
-}
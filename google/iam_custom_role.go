@@ -0,0 +1,103 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/iam/v1"
+)
+
+// The support levels of permissions in custom roles. The API leaves out the
+// level of supported permissions.
+const (
+	customRolesSupported    = "SUPPORTED"
+	customRolesTesting      = "TESTING"
+	customRolesNotSupported = "NOT_SUPPORTED"
+)
+
+// queryTestablePermissions returns the permissions which can be tested on the
+// resource of fullResourceName, e.g.
+// `//cloudresourcemanager.googleapis.com/projects/my-project`.
+func queryTestablePermissions(config *Config, fullResourceName string) ([]*iam.Permission, error) {
+	var permissions []*iam.Permission
+	err := config.clientIAM.Permissions.QueryTestablePermissions(&iam.QueryTestablePermissionsRequest{
+		FullResourceName: fullResourceName,
+		PageSize:         1000,
+	}).Pages(config.stopContext(), func(res *iam.QueryTestablePermissionsResponse) error {
+		permissions = append(permissions, res.Permissions...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return permissions, nil
+}
+
+func customRolesSupportLevel(p *iam.Permission) string {
+	if p.CustomRolesSupportLevel == "" {
+		return customRolesSupported
+	}
+	return p.CustomRolesSupportLevel
+}
+
+// iamCustomRolePermissionsCustomizeDiff returns a CustomizeDiffFunc which
+// rejects the permissions of a custom role that can't be included in the
+// custom roles of the resource returned by fullResourceName, rather than
+// failing when the role is created or updated. fullResourceName returns an
+// empty name when the resource can't be determined yet, and the permissions
+// aren't checked then.
+func iamCustomRolePermissionsCustomizeDiff(fullResourceName func(d *schema.ResourceDiff, config *Config) (string, error)) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		// The permissions are only read when they change. They are empty when
+		// they aren't known yet.
+		permissions := convertStringSet(d.Get("permissions").(*schema.Set))
+		if !d.HasChange("permissions") || len(permissions) == 0 {
+			return nil
+		}
+
+		config := meta.(*Config)
+		name, err := fullResourceName(d, config)
+		if err != nil || name == "" {
+			return err
+		}
+		testable, err := queryTestablePermissions(config, name)
+		if err != nil {
+			return fmt.Errorf("Error reading the permissions of %s: %s", name, err)
+		}
+		if err := validateIamCustomRolePermissions(permissions, testable); err != nil {
+			return fmt.Errorf("Invalid permissions for a custom role of %s: %s", name, err)
+		}
+		return nil
+	}
+}
+
+// validateIamCustomRolePermissions returns an error listing the permissions
+// which aren't among the testable permissions of the resource of a custom
+// role, or can't be included in its custom roles.
+func validateIamCustomRolePermissions(permissions []string, testable []*iam.Permission) error {
+	levels := make(map[string]string, len(testable))
+	for _, p := range testable {
+		levels[p.Name] = customRolesSupportLevel(p)
+	}
+
+	var invalid []string
+	for _, permission := range permissions {
+		level, ok := levels[permission]
+		switch {
+		case !ok:
+			invalid = append(invalid, fmt.Sprintf("%s is not a permission of the resource", permission))
+		case level == customRolesNotSupported:
+			invalid = append(invalid, fmt.Sprintf("%s is not supported in custom roles", permission))
+		case level == customRolesTesting:
+			log.Printf("[WARN] The support of permission %s in custom roles is being tested, and may change", permission)
+		}
+	}
+	if len(invalid) > 0 {
+		sort.Strings(invalid)
+		return fmt.Errorf("%s", strings.Join(invalid, ", "))
+	}
+	return nil
+}
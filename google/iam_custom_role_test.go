@@ -0,0 +1,80 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"google.golang.org/api/iam/v1"
+)
+
+func TestValidateIamCustomRolePermissions(t *testing.T) {
+	t.Parallel()
+
+	testable := []*iam.Permission{
+		{Name: "iam.roles.list"},
+		{Name: "iam.roles.create", CustomRolesSupportLevel: customRolesSupported},
+		{Name: "resourcemanager.projects.list", CustomRolesSupportLevel: customRolesNotSupported},
+		{Name: "compute.instances.list", CustomRolesSupportLevel: customRolesTesting},
+	}
+
+	cases := map[string]struct {
+		permissions []string
+		valid       bool
+	}{
+		"supported": {
+			permissions: []string{"iam.roles.list", "iam.roles.create"},
+			valid:       true,
+		},
+		"testing": {
+			permissions: []string{"compute.instances.list"},
+			valid:       true,
+		},
+		"not supported": {
+			permissions: []string{"iam.roles.list", "resourcemanager.projects.list"},
+		},
+		"unknown": {
+			permissions: []string{"iam.roles.lst"},
+		},
+	}
+	for name, c := range cases {
+		err := validateIamCustomRolePermissions(c.permissions, testable)
+		if c.valid && err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestIamCustomRolePermissionsCustomizeDiff_unknownParent(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		resource *schema.Resource
+		parent   string
+	}{
+		"project":      {resource: resourceGoogleProjectIamCustomRole(), parent: "project"},
+		"organization": {resource: resourceGoogleOrganizationIamCustomRole(), parent: "org_id"},
+	}
+	for name, c := range cases {
+		raw := map[string]interface{}{
+			"role_id":     "myRole",
+			"title":       "My Role",
+			"permissions": []interface{}{"iam.roles.list"},
+			c.parent:      config.UnknownVariableValue,
+		}
+		rc := terraform.NewResourceConfig(nil)
+		rc.Raw = raw
+		rc.Config = raw
+		rc.ComputedKeys = []string{c.parent}
+
+		// The permissions would be read with the clients of the provider,
+		// which this Config doesn't have.
+		if _, err := c.resource.Diff(nil, rc, &Config{}); err != nil {
+			t.Errorf("%s: expected the permissions not to be checked, got %s", name, err)
+		}
+	}
+}
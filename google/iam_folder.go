@@ -6,7 +6,6 @@ import (
 
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
-	"google.golang.org/api/cloudresourcemanager/v1"
 	resourceManagerV2Beta1 "google.golang.org/api/cloudresourcemanager/v2beta1"
 )
 
@@ -35,19 +34,13 @@ func FolderIdParseFunc(d *schema.ResourceData, _ *Config) error {
 	return nil
 }
 
-func (u *FolderIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
+func (u *FolderIamUpdater) GetResourceIamPolicy() (*IamPolicy, error) {
 	return getFolderIamPolicyByFolderName(u.folderId, u.Config)
 }
 
-func (u *FolderIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
-	v2BetaPolicy, err := v1PolicyToV2Beta(policy)
-	if err != nil {
-		return err
-	}
-
-	_, err = u.Config.clientResourceManagerV2Beta1.Folders.SetIamPolicy(u.folderId, &resourceManagerV2Beta1.SetIamPolicyRequest{
-		Policy: v2BetaPolicy,
-	}).Do()
+func (u *FolderIamUpdater) SetResourceIamPolicy(policy *IamPolicy) error {
+	url := fmt.Sprintf("%s%s:setIamPolicy", u.Config.basePath(ResourceManagerV2Beta1BasePathKey), u.folderId)
+	err := setResourceManagerIamPolicy(u.Config, url, "", policy)
 
 	if err != nil {
 		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
@@ -76,43 +69,19 @@ func canonicalFolderId(folder string) string {
 	return "folders/" + folder
 }
 
-// v1 and v2beta policy are identical
-func v1PolicyToV2Beta(in *cloudresourcemanager.Policy) (*resourceManagerV2Beta1.Policy, error) {
-	out := &resourceManagerV2Beta1.Policy{}
-	err := Convert(in, out)
-	if err != nil {
-		return nil, errwrap.Wrapf("Cannot convert a v1 policy to a v2beta policy: {{err}}", err)
-	}
-	return out, nil
-}
-
-func v2BetaPolicyToV1(in *resourceManagerV2Beta1.Policy) (*cloudresourcemanager.Policy, error) {
-	out := &cloudresourcemanager.Policy{}
-	err := Convert(in, out)
-	if err != nil {
-		return nil, errwrap.Wrapf("Cannot convert a v2beta policy to a v1 policy: {{err}}", err)
-	}
-	return out, nil
-}
-
 // Retrieve the existing IAM Policy for a folder
-func getFolderIamPolicyByFolderName(folderName string, config *Config) (*cloudresourcemanager.Policy, error) {
-	p, err := config.clientResourceManagerV2Beta1.Folders.GetIamPolicy(folderName,
-		&resourceManagerV2Beta1.GetIamPolicyRequest{}).Do()
+func getFolderIamPolicyByFolderName(folderName string, config *Config) (*IamPolicy, error) {
+	url := fmt.Sprintf("%s%s:getIamPolicy", config.basePath(ResourceManagerV2Beta1BasePathKey), folderName)
+	p, err := getIamPolicyWithBody(config, url, "")
 
 	if err != nil {
 		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for folder %q: {{err}}", folderName), err)
 	}
 
-	v1Policy, err := v2BetaPolicyToV1(p)
-	if err != nil {
-		return nil, err
-	}
-
-	return v1Policy, nil
+	return p, nil
 }
 
-func getFolderIamPolicyByParentAndDisplayName(parent, displayName string, config *Config) (*cloudresourcemanager.Policy, error) {
+func getFolderIamPolicyByParentAndDisplayName(parent, displayName string, config *Config) (*IamPolicy, error) {
 	queryString := fmt.Sprintf("lifecycleState=ACTIVE AND parent=%s AND displayName=%s", parent, displayName)
 	searchRequest := &resourceManagerV2Beta1.SearchFoldersRequest{
 		Query: queryString,
@@ -133,3 +102,12 @@ func getFolderIamPolicyByParentAndDisplayName(parent, displayName string, config
 
 	return getFolderIamPolicyByFolderName(folders[0].Name, config)
 }
+
+func (u *FolderIamUpdater) SetIamPolicyPermission() string {
+	return "resourcemanager.folders.setIamPolicy"
+}
+
+func (u *FolderIamUpdater) TestIamPermissions(permissions []string) ([]string, error) {
+	url := fmt.Sprintf("%s%s:testIamPermissions", u.Config.basePath(ResourceManagerV2Beta1BasePathKey), u.folderId)
+	return testIamPermissions(u.Config, url, "", permissions)
+}
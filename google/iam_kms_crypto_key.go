@@ -5,8 +5,6 @@ import (
 
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
-	"google.golang.org/api/cloudkms/v1"
-	"google.golang.org/api/cloudresourcemanager/v1"
 )
 
 var IamKmsCryptoKeySchema = map[string]*schema.Schema{
@@ -46,32 +44,20 @@ func CryptoIdParseFunc(d *schema.ResourceData, config *Config) error {
 	return nil
 }
 
-func (u *KmsCryptoKeyIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
-	p, err := u.Config.clientKms.Projects.Locations.KeyRings.CryptoKeys.GetIamPolicy(u.resourceId).Do()
+func (u *KmsCryptoKeyIamUpdater) GetResourceIamPolicy() (*IamPolicy, error) {
+	url := fmt.Sprintf("%s%s:getIamPolicy", u.Config.basePath(KmsBasePathKey), u.resourceId)
+	p, err := getIamPolicyWithQuery(u.Config, "GET", url, "options.requestedPolicyVersion", "")
 
 	if err != nil {
 		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
 	}
 
-	cloudResourcePolicy, err := kmsToResourceManagerPolicy(p)
-
-	if err != nil {
-		return nil, errwrap.Wrapf(fmt.Sprintf("Invalid IAM policy for %s: {{err}}", u.DescribeResource()), err)
-	}
-
-	return cloudResourcePolicy, nil
+	return p, nil
 }
 
-func (u *KmsCryptoKeyIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
-	kmsPolicy, err := resourceManagerToKmsPolicy(policy)
-
-	if err != nil {
-		return errwrap.Wrapf(fmt.Sprintf("Invalid IAM policy for %s: {{err}}", u.DescribeResource()), err)
-	}
-
-	_, err = u.Config.clientKms.Projects.Locations.KeyRings.CryptoKeys.SetIamPolicy(u.resourceId, &cloudkms.SetIamPolicyRequest{
-		Policy: kmsPolicy,
-	}).Do()
+func (u *KmsCryptoKeyIamUpdater) SetResourceIamPolicy(policy *IamPolicy) error {
+	url := fmt.Sprintf("%s%s:setIamPolicy", u.Config.basePath(KmsBasePathKey), u.resourceId)
+	err := setIamPolicyWithBody(u.Config, url, "", policy)
 
 	if err != nil {
 		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
@@ -5,8 +5,6 @@ import (
 
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
-	"google.golang.org/api/cloudkms/v1"
-	"google.golang.org/api/cloudresourcemanager/v1"
 )
 
 var IamKmsKeyRingSchema = map[string]*schema.Schema{
@@ -47,32 +45,20 @@ func KeyRingIdParseFunc(d *schema.ResourceData, config *Config) error {
 	return nil
 }
 
-func (u *KmsKeyRingIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
-	p, err := u.Config.clientKms.Projects.Locations.KeyRings.GetIamPolicy(u.resourceId).Do()
+func (u *KmsKeyRingIamUpdater) GetResourceIamPolicy() (*IamPolicy, error) {
+	url := fmt.Sprintf("%s%s:getIamPolicy", u.Config.basePath(KmsBasePathKey), u.resourceId)
+	p, err := getIamPolicyWithQuery(u.Config, "GET", url, "options.requestedPolicyVersion", "")
 
 	if err != nil {
 		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
 	}
 
-	cloudResourcePolicy, err := kmsToResourceManagerPolicy(p)
-
-	if err != nil {
-		return nil, errwrap.Wrapf(fmt.Sprintf("Invalid IAM policy for %s: {{err}}", u.DescribeResource()), err)
-	}
-
-	return cloudResourcePolicy, nil
+	return p, nil
 }
 
-func (u *KmsKeyRingIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
-	kmsPolicy, err := resourceManagerToKmsPolicy(policy)
-
-	if err != nil {
-		return errwrap.Wrapf(fmt.Sprintf("Invalid IAM policy for %s: {{err}}", u.DescribeResource()), err)
-	}
-
-	_, err = u.Config.clientKms.Projects.Locations.KeyRings.SetIamPolicy(u.resourceId, &cloudkms.SetIamPolicyRequest{
-		Policy: kmsPolicy,
-	}).Do()
+func (u *KmsKeyRingIamUpdater) SetResourceIamPolicy(policy *IamPolicy) error {
+	url := fmt.Sprintf("%s%s:setIamPolicy", u.Config.basePath(KmsBasePathKey), u.resourceId)
+	err := setIamPolicyWithBody(u.Config, url, "", policy)
 
 	if err != nil {
 		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
@@ -92,21 +78,3 @@ func (u *KmsKeyRingIamUpdater) GetMutexKey() string {
 func (u *KmsKeyRingIamUpdater) DescribeResource() string {
 	return fmt.Sprintf("KMS KeyRing %q", u.resourceId)
 }
-
-func resourceManagerToKmsPolicy(p *cloudresourcemanager.Policy) (*cloudkms.Policy, error) {
-	out := &cloudkms.Policy{}
-	err := Convert(p, out)
-	if err != nil {
-		return nil, errwrap.Wrapf("Cannot convert a v1 policy to a kms policy: {{err}}", err)
-	}
-	return out, nil
-}
-
-func kmsToResourceManagerPolicy(p *cloudkms.Policy) (*cloudresourcemanager.Policy, error) {
-	out := &cloudresourcemanager.Policy{}
-	err := Convert(p, out)
-	if err != nil {
-		return nil, errwrap.Wrapf("Cannot convert a kms policy to a v1 policy: {{err}}", err)
-	}
-	return out, nil
-}
@@ -0,0 +1,211 @@
+package google
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The tokeninfo endpoint tells which account an access token belongs to.
+var tokenInfoUrl = "https://oauth2.googleapis.com/tokeninfo"
+
+// IamLockoutSchema is added to the authoritative policy resources of
+// resources whose callers can lock themselves out of their policy.
+var IamLockoutSchema = map[string]*schema.Schema{
+	"allow_lockout": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+}
+
+// The resourceIamLockoutChecker interface is implemented by the updaters of
+// the resources whose policy controls who can set it, such as projects,
+// folders and organizations.
+type resourceIamLockoutChecker interface {
+	// Returns the permission needed to set the IAM policy of the resource,
+	// e.g. `resourcemanager.projects.setIamPolicy`.
+	SetIamPolicyPermission() string
+
+	// Returns the permissions, among the given ones, which the caller holds
+	// on the resource.
+	TestIamPermissions(permissions []string) ([]string, error)
+}
+
+// checkIamPolicyLockout returns an error if setting policy on the resource of
+// updater would remove the permission of the caller to set its policy, unless
+// allowLockout is set.
+//
+// The permission can't be tested against a policy which isn't applied yet, so
+// the caller must hold it now, be granted it directly by the current policy
+// and no longer be by the new one. Grants through groups, domains, conditions
+// or the ancestors of the resource are left alone, as they can't be checked.
+func checkIamPolicyLockout(config *Config, updater ResourceIamUpdater, policy *IamPolicy, allowLockout bool) error {
+	checker, ok := updater.(resourceIamLockoutChecker)
+	if !ok || allowLockout {
+		return nil
+	}
+	if vcr != nil && vcr.replaying() {
+		// Replayed tests have no access token to identify the caller with.
+		log.Printf("[DEBUG] Not checking the IAM policy of %s for a lockout while replaying", updater.DescribeResource())
+		return nil
+	}
+
+	permission := checker.SetIamPolicyPermission()
+	held, err := checker.TestIamPermissions([]string{permission})
+	if err != nil {
+		return fmt.Errorf("Error checking the permissions of the caller on %s: %s", updater.DescribeResource(), err)
+	}
+	if len(held) == 0 {
+		// Setting the policy will fail anyway.
+		return nil
+	}
+
+	member, err := iamCallerMember(config)
+	if err != nil {
+		return fmt.Errorf("Error identifying the caller to check the IAM policy of %s for a lockout: %s. "+
+			"Set `allow_lockout` to true to apply the policy without checking it.", updater.DescribeResource(), err)
+	}
+
+	current, err := updater.GetResourceIamPolicy()
+	if err != nil {
+		return err
+	}
+	rolePermissions := newIamRolePermissionsFunc(config)
+	lockout, err := iamPolicyLockout(current, policy, member, permission, rolePermissions)
+	if err != nil {
+		return fmt.Errorf("Error checking the IAM policy of %s for a lockout: %s", updater.DescribeResource(), err)
+	}
+	if lockout {
+		return fmt.Errorf("Applying this IAM policy would remove the permission %s of %s on %s, locking it out of the policy. "+
+			"Grant it a role with this permission in the policy, or set `allow_lockout` to true to apply the policy anyway.", permission, member, updater.DescribeResource())
+	}
+	return nil
+}
+
+// testIamPermissions returns the permissions, among the given ones, which the
+// caller holds on the resource of the testIamPermissions method at rawurl.
+func testIamPermissions(config *Config, rawurl, project string, permissions []string) ([]string, error) {
+	res, err := Post(config, rawurl, project, map[string]interface{}{
+		"permissions": permissions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	held, _ := res["permissions"].([]interface{})
+	return convertStringArr(held), nil
+}
+
+// iamPolicyLockout returns whether member is granted permission directly by
+// the current policy but not by the new one.
+func iamPolicyLockout(current, new *IamPolicy, member, permission string, rolePermissions func(role string) ([]string, error)) (bool, error) {
+	grantedNow, err := iamPolicyGrantsPermission(current, member, permission, rolePermissions)
+	if err != nil || !grantedNow {
+		return false, err
+	}
+	grantedAfter, err := iamPolicyGrantsPermission(new, member, permission, rolePermissions)
+	if err != nil {
+		return false, err
+	}
+	return !grantedAfter, nil
+}
+
+// iamPolicyGrantsPermission returns whether an unconditional binding of policy
+// grants permission to member.
+func iamPolicyGrantsPermission(policy *IamPolicy, member, permission string, rolePermissions func(role string) ([]string, error)) (bool, error) {
+	for _, b := range policy.Bindings {
+		if b.Condition != nil || !containsString(b.Members, member) {
+			continue
+		}
+		permissions, err := rolePermissions(b.Role)
+		if err != nil {
+			return false, err
+		}
+		if containsString(permissions, permission) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// newIamRolePermissionsFunc returns a function listing the permissions of a
+// predefined or custom role, which reads each role once.
+func newIamRolePermissionsFunc(config *Config) func(role string) ([]string, error) {
+	cache := make(map[string][]string)
+	return func(role string) ([]string, error) {
+		if permissions, ok := cache[role]; ok {
+			return permissions, nil
+		}
+		// Predefined and custom role names are all relative to the API,
+		// e.g. `roles/owner` or `projects/p/roles/r`.
+		res, err := Get(config, config.basePath(IamBasePathKey)+role, "")
+		if err != nil {
+			return nil, fmt.Errorf("Error reading role %s: %s", role, err)
+		}
+		var permissions []string
+		if v, ok := res["includedPermissions"].([]interface{}); ok {
+			permissions = convertStringArr(v)
+		}
+		cache[role] = permissions
+		return permissions, nil
+	}
+}
+
+// iamCallerMember returns the IAM member of the account the provider
+// authenticates as, e.g. `serviceAccount:terraform@p.iam.gserviceaccount.com`.
+func iamCallerMember(config *Config) (string, error) {
+	// The service account to impersonate may be given by its resource name,
+	// e.g. `projects/-/serviceAccounts/<email>`.
+	email := GetResourceNameFromSelfLink(config.ImpersonateServiceAccount)
+	if email == "" {
+		var err error
+		if email, err = tokenEmail(config); err != nil {
+			return "", err
+		}
+	}
+	if strings.HasSuffix(email, ".gserviceaccount.com") {
+		return "serviceAccount:" + email, nil
+	}
+	return "user:" + email, nil
+}
+
+// tokenEmail returns the email of the account of the access tokens of config.
+// The token is posted rather than sent in the URL, and with a client of its
+// own rather than config.client, so that it is neither logged nor recorded by
+// the VCR.
+func tokenEmail(config *Config) (string, error) {
+	token, err := config.tokenSource.Token()
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequest("POST", tokenInfoUrl, strings.NewReader(url.Values{"access_token": {token.AccessToken}}.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	client := &http.Client{Timeout: config.RequestTimeout}
+	res, err := client.Do(req.WithContext(config.stopContext()))
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Error reading token info: %s", res.Status)
+	}
+
+	var info struct {
+		Email string `json:"email"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&info); err != nil {
+		return "", fmt.Errorf("Error reading token info: %s", err)
+	}
+	if info.Email == "" {
+		return "", fmt.Errorf("the access token has no email, it may lack the userinfo.email scope")
+	}
+	return info.Email, nil
+}
@@ -0,0 +1,162 @@
+package google
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+func TestIamPolicyLockout(t *testing.T) {
+	t.Parallel()
+
+	const (
+		caller     = "user:admin@example.com"
+		permission = "resourcemanager.projects.setIamPolicy"
+	)
+	rolePermissions := func(role string) ([]string, error) {
+		switch role {
+		case "roles/owner", "roles/resourcemanager.projectIamAdmin":
+			return []string{permission, "resourcemanager.projects.getIamPolicy"}, nil
+		}
+		return []string{"resourcemanager.projects.get"}, nil
+	}
+	owner := &IamBinding{Role: "roles/owner", Members: []string{caller}}
+	iamAdmin := &IamBinding{Role: "roles/resourcemanager.projectIamAdmin", Members: []string{caller}}
+	viewer := &IamBinding{Role: "roles/viewer", Members: []string{caller}}
+	conditionalOwner := &IamBinding{
+		Role:      "roles/owner",
+		Members:   []string{caller},
+		Condition: &IamCondition{Title: "expires", Expression: `request.time < timestamp("2020-01-01T00:00:00Z")`},
+	}
+
+	cases := map[string]struct {
+		current, new []*IamBinding
+		lockout      bool
+	}{
+		"kept": {
+			current: []*IamBinding{owner},
+			new:     []*IamBinding{owner, viewer},
+		},
+		"granted by another role": {
+			current: []*IamBinding{owner},
+			new:     []*IamBinding{iamAdmin},
+		},
+		"removed": {
+			current: []*IamBinding{owner, viewer},
+			new:     []*IamBinding{viewer},
+			lockout: true,
+		},
+		"replaced by a conditional binding": {
+			current: []*IamBinding{owner},
+			new:     []*IamBinding{conditionalOwner},
+			lockout: true,
+		},
+		// The caller holds the permission through a group, a domain or an
+		// ancestor of the resource, which the policy doesn't change.
+		"not granted directly": {
+			current: []*IamBinding{viewer},
+			new:     []*IamBinding{},
+		},
+	}
+	for name, c := range cases {
+		lockout, err := iamPolicyLockout(&IamPolicy{Bindings: c.current}, &IamPolicy{Bindings: c.new}, caller, permission, rolePermissions)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err)
+			continue
+		}
+		if lockout != c.lockout {
+			t.Errorf("%s: expected lockout to be %t, got %t", name, c.lockout, lockout)
+		}
+	}
+}
+
+func TestIamCallerMember(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("access_token") != "sa-token" {
+			w.Write([]byte(`{"email": "admin@example.com"}`))
+			return
+		}
+		w.Write([]byte(`{"email": "terraform@my-project.iam.gserviceaccount.com"}`))
+	}))
+	defer server.Close()
+	defer func(url string) { tokenInfoUrl = url }(tokenInfoUrl)
+	tokenInfoUrl = server.URL
+
+	cases := []struct {
+		config   *Config
+		expected string
+	}{
+		{
+			config:   &Config{tokenSource: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "user-token"})},
+			expected: "user:admin@example.com",
+		},
+		{
+			config:   &Config{tokenSource: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "sa-token"})},
+			expected: "serviceAccount:terraform@my-project.iam.gserviceaccount.com",
+		},
+		{
+			config: &Config{
+				ImpersonateServiceAccount: "impersonated@my-project.iam.gserviceaccount.com",
+				tokenSource:               oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "user-token"}),
+			},
+			expected: "serviceAccount:impersonated@my-project.iam.gserviceaccount.com",
+		},
+		{
+			config: &Config{
+				ImpersonateServiceAccount: "projects/-/serviceAccounts/impersonated@my-project.iam.gserviceaccount.com",
+				tokenSource:               oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "user-token"}),
+			},
+			expected: "serviceAccount:impersonated@my-project.iam.gserviceaccount.com",
+		},
+	}
+	for _, c := range cases {
+		member, err := iamCallerMember(c.config)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+			continue
+		}
+		if member != c.expected {
+			t.Errorf("expected %q, got %q", c.expected, member)
+		}
+	}
+}
+
+// lockoutCheckingIamUpdater is an updater whose caller holds the permission
+// to set the policy.
+type lockoutCheckingIamUpdater struct {
+	ResourceIamUpdater
+}
+
+func (u *lockoutCheckingIamUpdater) SetIamPolicyPermission() string {
+	return "resourcemanager.projects.setIamPolicy"
+}
+
+func (u *lockoutCheckingIamUpdater) TestIamPermissions(permissions []string) ([]string, error) {
+	return permissions, nil
+}
+
+func (u *lockoutCheckingIamUpdater) DescribeResource() string {
+	return "project \"my-project\""
+}
+
+func TestCheckIamPolicyLockout_unknownCaller(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+	defer func(url string) { tokenInfoUrl = url }(tokenInfoUrl)
+	tokenInfoUrl = server.URL
+
+	config := &Config{tokenSource: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "token"})}
+	err := checkIamPolicyLockout(config, &lockoutCheckingIamUpdater{}, &IamPolicy{}, false)
+	if err == nil || !strings.Contains(err.Error(), "allow_lockout") {
+		t.Errorf("Expected an error suggesting to set allow_lockout, got %v", err)
+	}
+
+	if err := checkIamPolicyLockout(config, &lockoutCheckingIamUpdater{}, &IamPolicy{}, true); err != nil {
+		t.Errorf("Expected no check with allow_lockout, got %s", err)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
-	"google.golang.org/api/cloudresourcemanager/v1"
 )
 
 var IamOrganizationSchema = map[string]*schema.Schema{
@@ -32,8 +31,10 @@ func OrgIdParseFunc(d *schema.ResourceData, _ *Config) error {
 	return nil
 }
 
-func (u *OrganizationIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
-	p, err := u.Config.clientResourceManager.Organizations.GetIamPolicy("organizations/"+u.resourceId, &cloudresourcemanager.GetIamPolicyRequest{}).Do()
+func (u *OrganizationIamUpdater) GetResourceIamPolicy() (*IamPolicy, error) {
+	url := fmt.Sprintf("%sorganizations/%s:getIamPolicy", u.Config.basePath(ResourceManagerBasePathKey), u.resourceId)
+	p, err := getIamPolicyWithBody(u.Config, url, "")
+
 	if err != nil {
 		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
 	}
@@ -41,10 +42,9 @@ func (u *OrganizationIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.P
 	return p, nil
 }
 
-func (u *OrganizationIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
-	_, err := u.Config.clientResourceManager.Organizations.SetIamPolicy("organizations/"+u.resourceId, &cloudresourcemanager.SetIamPolicyRequest{
-		Policy: policy,
-	}).Do()
+func (u *OrganizationIamUpdater) SetResourceIamPolicy(policy *IamPolicy) error {
+	url := fmt.Sprintf("%sorganizations/%s:setIamPolicy", u.Config.basePath(ResourceManagerBasePathKey), u.resourceId)
+	err := setResourceManagerIamPolicy(u.Config, url, "", policy)
 
 	if err != nil {
 		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
@@ -64,3 +64,12 @@ func (u *OrganizationIamUpdater) GetMutexKey() string {
 func (u *OrganizationIamUpdater) DescribeResource() string {
 	return fmt.Sprintf("organization %q", u.resourceId)
 }
+
+func (u *OrganizationIamUpdater) SetIamPolicyPermission() string {
+	return "resourcemanager.organizations.setIamPolicy"
+}
+
+func (u *OrganizationIamUpdater) TestIamPermissions(permissions []string) ([]string, error) {
+	url := fmt.Sprintf("%sorganizations/%s:testIamPermissions", u.Config.basePath(ResourceManagerBasePathKey), u.resourceId)
+	return testIamPermissions(u.Config, url, "", permissions)
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
-	"google.golang.org/api/cloudresourcemanager/v1"
 )
 
 var IamProjectSchema = map[string]*schema.Schema{
@@ -37,9 +36,9 @@ func ProjectIdParseFunc(d *schema.ResourceData, _ *Config) error {
 	return nil
 }
 
-func (u *ProjectIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
-	p, err := u.Config.clientResourceManager.Projects.GetIamPolicy(u.resourceId,
-		&cloudresourcemanager.GetIamPolicyRequest{}).Do()
+func (u *ProjectIamUpdater) GetResourceIamPolicy() (*IamPolicy, error) {
+	url := fmt.Sprintf("%sprojects/%s:getIamPolicy", u.Config.basePath(ResourceManagerBasePathKey), u.resourceId)
+	p, err := getIamPolicyWithBody(u.Config, url, u.resourceId)
 
 	if err != nil {
 		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
@@ -48,10 +47,9 @@ func (u *ProjectIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy
 	return p, nil
 }
 
-func (u *ProjectIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
-	_, err := u.Config.clientResourceManager.Projects.SetIamPolicy(u.resourceId, &cloudresourcemanager.SetIamPolicyRequest{
-		Policy: policy,
-	}).Do()
+func (u *ProjectIamUpdater) SetResourceIamPolicy(policy *IamPolicy) error {
+	url := fmt.Sprintf("%sprojects/%s:setIamPolicy", u.Config.basePath(ResourceManagerBasePathKey), u.resourceId)
+	err := setResourceManagerIamPolicy(u.Config, url, u.resourceId, policy)
 
 	if err != nil {
 		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
@@ -71,3 +69,12 @@ func (u *ProjectIamUpdater) GetMutexKey() string {
 func (u *ProjectIamUpdater) DescribeResource() string {
 	return fmt.Sprintf("project %q", u.resourceId)
 }
+
+func (u *ProjectIamUpdater) SetIamPolicyPermission() string {
+	return "resourcemanager.projects.setIamPolicy"
+}
+
+func (u *ProjectIamUpdater) TestIamPermissions(permissions []string) ([]string, error) {
+	url := fmt.Sprintf("%sprojects/%s:testIamPermissions", u.Config.basePath(ResourceManagerBasePathKey), u.resourceId)
+	return testIamPermissions(u.Config, url, u.resourceId, permissions)
+}
@@ -4,8 +4,6 @@ import (
 	"fmt"
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
-	"google.golang.org/api/cloudresourcemanager/v1"
-	"google.golang.org/api/pubsub/v1"
 )
 
 var IamPubsubSubscriptionSchema = map[string]*schema.Schema{
@@ -47,30 +45,20 @@ func PubsubSubscriptionIdParseFunc(d *schema.ResourceData, _ *Config) error {
 	return nil
 }
 
-func (u *PubsubSubscriptionIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
-	p, err := u.Config.clientPubsub.Projects.Subscriptions.GetIamPolicy(u.subscription).Do()
+func (u *PubsubSubscriptionIamUpdater) GetResourceIamPolicy() (*IamPolicy, error) {
+	url := fmt.Sprintf("%s%s:getIamPolicy", u.Config.basePath(PubsubBasePathKey), u.subscription)
+	p, err := getIamPolicyWithQuery(u.Config, "GET", url, "options.requestedPolicyVersion", "")
 
 	if err != nil {
 		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
 	}
 
-	v1Policy, err := pubsubToResourceManagerPolicy(p)
-	if err != nil {
-		return nil, err
-	}
-
-	return v1Policy, nil
+	return p, nil
 }
 
-func (u *PubsubSubscriptionIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
-	pubsubPolicy, err := resourceManagerToPubsubPolicy(policy)
-	if err != nil {
-		return err
-	}
-
-	_, err = u.Config.clientPubsub.Projects.Subscriptions.SetIamPolicy(u.subscription, &pubsub.SetIamPolicyRequest{
-		Policy: pubsubPolicy,
-	}).Do()
+func (u *PubsubSubscriptionIamUpdater) SetResourceIamPolicy(policy *IamPolicy) error {
+	url := fmt.Sprintf("%s%s:setIamPolicy", u.Config.basePath(PubsubBasePathKey), u.subscription)
+	err := setIamPolicyWithBody(u.Config, url, "", policy)
 
 	if err != nil {
 		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
@@ -5,8 +5,6 @@ import (
 
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
-	"google.golang.org/api/cloudresourcemanager/v1"
-	"google.golang.org/api/pubsub/v1"
 )
 
 var IamPubsubTopicSchema = map[string]*schema.Schema{
@@ -48,30 +46,20 @@ func PubsubTopicIdParseFunc(d *schema.ResourceData, _ *Config) error {
 	return nil
 }
 
-func (u *PubsubTopicIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
-	p, err := u.Config.clientPubsub.Projects.Topics.GetIamPolicy(u.topic).Do()
+func (u *PubsubTopicIamUpdater) GetResourceIamPolicy() (*IamPolicy, error) {
+	url := fmt.Sprintf("%s%s:getIamPolicy", u.Config.basePath(PubsubBasePathKey), u.topic)
+	p, err := getIamPolicyWithQuery(u.Config, "GET", url, "options.requestedPolicyVersion", "")
 
 	if err != nil {
 		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
 	}
 
-	v1Policy, err := pubsubToResourceManagerPolicy(p)
-	if err != nil {
-		return nil, err
-	}
-
-	return v1Policy, nil
+	return p, nil
 }
 
-func (u *PubsubTopicIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
-	pubsubPolicy, err := resourceManagerToPubsubPolicy(policy)
-	if err != nil {
-		return err
-	}
-
-	_, err = u.Config.clientPubsub.Projects.Topics.SetIamPolicy(u.topic, &pubsub.SetIamPolicyRequest{
-		Policy: pubsubPolicy,
-	}).Do()
+func (u *PubsubTopicIamUpdater) SetResourceIamPolicy(policy *IamPolicy) error {
+	url := fmt.Sprintf("%s%s:setIamPolicy", u.Config.basePath(PubsubBasePathKey), u.topic)
+	err := setIamPolicyWithBody(u.Config, url, "", policy)
 
 	if err != nil {
 		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
@@ -91,22 +79,3 @@ func (u *PubsubTopicIamUpdater) GetMutexKey() string {
 func (u *PubsubTopicIamUpdater) DescribeResource() string {
 	return fmt.Sprintf("pubsub topic %q", u.topic)
 }
-
-// v1 and v2beta policy are identical
-func resourceManagerToPubsubPolicy(in *cloudresourcemanager.Policy) (*pubsub.Policy, error) {
-	out := &pubsub.Policy{}
-	err := Convert(in, out)
-	if err != nil {
-		return nil, errwrap.Wrapf("Cannot convert a v1 policy to a pubsub policy: {{err}}", err)
-	}
-	return out, nil
-}
-
-func pubsubToResourceManagerPolicy(in *pubsub.Policy) (*cloudresourcemanager.Policy, error) {
-	out := &cloudresourcemanager.Policy{}
-	err := Convert(in, out)
-	if err != nil {
-		return nil, errwrap.Wrapf("Cannot convert a pubsub policy to a v1 policy: {{err}}", err)
-	}
-	return out, nil
-}
@@ -4,8 +4,6 @@ import (
 	"fmt"
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
-	"google.golang.org/api/cloudresourcemanager/v1"
-	"google.golang.org/api/iam/v1"
 )
 
 var IamServiceAccountSchema = map[string]*schema.Schema{
@@ -34,30 +32,20 @@ func ServiceAccountIdParseFunc(d *schema.ResourceData, _ *Config) error {
 	return nil
 }
 
-func (u *ServiceAccountIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
-	p, err := u.Config.clientIAM.Projects.ServiceAccounts.GetIamPolicy(u.serviceAccountId).Do()
+func (u *ServiceAccountIamUpdater) GetResourceIamPolicy() (*IamPolicy, error) {
+	url := fmt.Sprintf("%s%s:getIamPolicy", u.Config.basePath(IamBasePathKey), u.serviceAccountId)
+	p, err := getIamPolicyWithQuery(u.Config, "POST", url, "options.requestedPolicyVersion", "")
 
 	if err != nil {
 		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
 	}
 
-	cloudResourcePolicy, err := iamToResourceManagerPolicy(p)
-	if err != nil {
-		return nil, err
-	}
-
-	return cloudResourcePolicy, nil
+	return p, nil
 }
 
-func (u *ServiceAccountIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
-	iamPolicy, err := resourceManagerToIamPolicy(policy)
-	if err != nil {
-		return err
-	}
-
-	_, err = u.Config.clientIAM.Projects.ServiceAccounts.SetIamPolicy(u.GetResourceId(), &iam.SetIamPolicyRequest{
-		Policy: iamPolicy,
-	}).Do()
+func (u *ServiceAccountIamUpdater) SetResourceIamPolicy(policy *IamPolicy) error {
+	url := fmt.Sprintf("%s%s:setIamPolicy", u.Config.basePath(IamBasePathKey), u.serviceAccountId)
+	err := setIamPolicyWithBody(u.Config, url, "", policy)
 
 	if err != nil {
 		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
@@ -77,21 +65,3 @@ func (u *ServiceAccountIamUpdater) GetMutexKey() string {
 func (u *ServiceAccountIamUpdater) DescribeResource() string {
 	return fmt.Sprintf("service account '%s'", u.serviceAccountId)
 }
-
-func resourceManagerToIamPolicy(p *cloudresourcemanager.Policy) (*iam.Policy, error) {
-	out := &iam.Policy{}
-	err := Convert(p, out)
-	if err != nil {
-		return nil, errwrap.Wrapf("Cannot convert a v1 policy to a iam policy: {{err}}", err)
-	}
-	return out, nil
-}
-
-func iamToResourceManagerPolicy(p *iam.Policy) (*cloudresourcemanager.Policy, error) {
-	out := &cloudresourcemanager.Policy{}
-	err := Convert(p, out)
-	if err != nil {
-		return nil, errwrap.Wrapf("Cannot convert a iam policy to a v1 policy: {{err}}", err)
-	}
-	return out, nil
-}
@@ -0,0 +1,88 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+var IamSourceRepoRepositorySchema = map[string]*schema.Schema{
+	"repository": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	},
+}
+
+type SourceRepoRepositoryIamUpdater struct {
+	project    string
+	repository string
+	Config     *Config
+}
+
+func NewSourceRepoRepositoryIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	project, err := getProject(d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SourceRepoRepositoryIamUpdater{
+		project:    project,
+		repository: d.Get("repository").(string),
+		Config:     config,
+	}, nil
+}
+
+func SourceRepoRepositoryIdParseFunc(d *schema.ResourceData, config *Config) error {
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/repos/(?P<repository>.+)",
+		"(?P<repository>[^/]+)",
+	}, d, config); err != nil {
+		return err
+	}
+
+	// Explicitly set the id so imported resources have the same ID format as non-imported ones.
+	d.SetId(fmt.Sprintf("projects/%s/repos/%s", d.Get("project").(string), d.Get("repository").(string)))
+	return nil
+}
+
+func (u *SourceRepoRepositoryIamUpdater) GetResourceIamPolicy() (*IamPolicy, error) {
+	url := fmt.Sprintf("%sprojects/%s/repos/%s:getIamPolicy", u.Config.basePath(SourceRepoBasePathKey), u.project, u.repository)
+	p, err := getIamPolicyWithQuery(u.Config, "GET", url, "options.requestedPolicyVersion", u.project)
+
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return p, nil
+}
+
+func (u *SourceRepoRepositoryIamUpdater) SetResourceIamPolicy(policy *IamPolicy) error {
+	url := fmt.Sprintf("%sprojects/%s/repos/%s:setIamPolicy", u.Config.basePath(SourceRepoBasePathKey), u.project, u.repository)
+	err := setIamPolicyWithBody(u.Config, url, u.project, policy)
+
+	if err != nil {
+		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return nil
+}
+
+func (u *SourceRepoRepositoryIamUpdater) GetResourceId() string {
+	return fmt.Sprintf("projects/%s/repos/%s", u.project, u.repository)
+}
+
+func (u *SourceRepoRepositoryIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-sourcerepo-repository-%s-%s", u.project, u.repository)
+}
+
+func (u *SourceRepoRepositoryIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("Source Repository %s/%s", u.project, u.repository)
+}
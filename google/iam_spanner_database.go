@@ -5,8 +5,6 @@ import (
 
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
-	"google.golang.org/api/cloudresourcemanager/v1"
-	spanner "google.golang.org/api/spanner/v1"
 )
 
 var IamSpannerDatabaseSchema = map[string]*schema.Schema{
@@ -64,40 +62,28 @@ func SpannerDatabaseIdParseFunc(d *schema.ResourceData, config *Config) error {
 	return nil
 }
 
-func (u *SpannerDatabaseIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
-	p, err := u.Config.clientSpanner.Projects.Instances.Databases.GetIamPolicy(spannerDatabaseId{
+func (u *SpannerDatabaseIamUpdater) GetResourceIamPolicy() (*IamPolicy, error) {
+	url := fmt.Sprintf("%s%s:getIamPolicy", u.Config.basePath(SpannerBasePathKey), spannerDatabaseId{
 		Project:  u.project,
 		Database: u.database,
 		Instance: u.instance,
-	}.databaseUri(), &spanner.GetIamPolicyRequest{}).Do()
+	}.databaseUri())
+	p, err := getIamPolicyWithBody(u.Config, url, u.project)
 
 	if err != nil {
 		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
 	}
 
-	cloudResourcePolicy, err := spannerToResourceManagerPolicy(p)
-
-	if err != nil {
-		return nil, errwrap.Wrapf(fmt.Sprintf("Invalid IAM policy for %s: {{err}}", u.DescribeResource()), err)
-	}
-
-	return cloudResourcePolicy, nil
+	return p, nil
 }
 
-func (u *SpannerDatabaseIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
-	spannerPolicy, err := resourceManagerToSpannerPolicy(policy)
-
-	if err != nil {
-		return errwrap.Wrapf(fmt.Sprintf("Invalid IAM policy for %s: {{err}}", u.DescribeResource()), err)
-	}
-
-	_, err = u.Config.clientSpanner.Projects.Instances.Databases.SetIamPolicy(spannerDatabaseId{
+func (u *SpannerDatabaseIamUpdater) SetResourceIamPolicy(policy *IamPolicy) error {
+	url := fmt.Sprintf("%s%s:setIamPolicy", u.Config.basePath(SpannerBasePathKey), spannerDatabaseId{
 		Project:  u.project,
 		Database: u.database,
 		Instance: u.instance,
-	}.databaseUri(), &spanner.SetIamPolicyRequest{
-		Policy: spannerPolicy,
-	}).Do()
+	}.databaseUri())
+	err := setIamPolicyWithBody(u.Config, url, u.project, policy)
 
 	if err != nil {
 		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
@@ -121,21 +107,3 @@ func (u *SpannerDatabaseIamUpdater) GetMutexKey() string {
 func (u *SpannerDatabaseIamUpdater) DescribeResource() string {
 	return fmt.Sprintf("Spanner Database: %s/%s/%s", u.project, u.instance, u.database)
 }
-
-func resourceManagerToSpannerPolicy(p *cloudresourcemanager.Policy) (*spanner.Policy, error) {
-	out := &spanner.Policy{}
-	err := Convert(p, out)
-	if err != nil {
-		return nil, errwrap.Wrapf("Cannot convert a resourcemanager policy to a spanner policy: {{err}}", err)
-	}
-	return out, nil
-}
-
-func spannerToResourceManagerPolicy(p *spanner.Policy) (*cloudresourcemanager.Policy, error) {
-	out := &cloudresourcemanager.Policy{}
-	err := Convert(p, out)
-	if err != nil {
-		return nil, errwrap.Wrapf("Cannot convert a spanner policy to a resourcemanager policy: {{err}}", err)
-	}
-	return out, nil
-}
@@ -5,8 +5,6 @@ import (
 
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
-	"google.golang.org/api/cloudresourcemanager/v1"
-	spanner "google.golang.org/api/spanner/v1"
 )
 
 var IamSpannerInstanceSchema = map[string]*schema.Schema{
@@ -56,38 +54,26 @@ func SpannerInstanceIdParseFunc(d *schema.ResourceData, config *Config) error {
 	return nil
 }
 
-func (u *SpannerInstanceIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
-	p, err := u.Config.clientSpanner.Projects.Instances.GetIamPolicy(spannerInstanceId{
+func (u *SpannerInstanceIamUpdater) GetResourceIamPolicy() (*IamPolicy, error) {
+	url := fmt.Sprintf("%s%s:getIamPolicy", u.Config.basePath(SpannerBasePathKey), spannerInstanceId{
 		Project:  u.project,
 		Instance: u.instance,
-	}.instanceUri(), &spanner.GetIamPolicyRequest{}).Do()
+	}.instanceUri())
+	p, err := getIamPolicyWithBody(u.Config, url, u.project)
 
 	if err != nil {
 		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
 	}
 
-	cloudResourcePolicy, err := spannerToResourceManagerPolicy(p)
-
-	if err != nil {
-		return nil, errwrap.Wrapf(fmt.Sprintf("Invalid IAM policy for %s: {{err}}", u.DescribeResource()), err)
-	}
-
-	return cloudResourcePolicy, nil
+	return p, nil
 }
 
-func (u *SpannerInstanceIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
-	spannerPolicy, err := resourceManagerToSpannerPolicy(policy)
-
-	if err != nil {
-		return errwrap.Wrapf(fmt.Sprintf("Invalid IAM policy for %s: {{err}}", u.DescribeResource()), err)
-	}
-
-	_, err = u.Config.clientSpanner.Projects.Instances.SetIamPolicy(spannerInstanceId{
+func (u *SpannerInstanceIamUpdater) SetResourceIamPolicy(policy *IamPolicy) error {
+	url := fmt.Sprintf("%s%s:setIamPolicy", u.Config.basePath(SpannerBasePathKey), spannerInstanceId{
 		Project:  u.project,
 		Instance: u.instance,
-	}.instanceUri(), &spanner.SetIamPolicyRequest{
-		Policy: spannerPolicy,
-	}).Do()
+	}.instanceUri())
+	err := setIamPolicyWithBody(u.Config, url, u.project, policy)
 
 	if err != nil {
 		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
@@ -1,11 +1,12 @@
 package google
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
+
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
-	"google.golang.org/api/cloudresourcemanager/v1"
-	"google.golang.org/api/storage/v1"
 )
 
 var IamStorageBucketSchema = map[string]*schema.Schema{
@@ -16,47 +17,109 @@ var IamStorageBucketSchema = map[string]*schema.Schema{
 	},
 }
 
+// The legacy roles of a bucket, such as roles/storage.legacyBucketReader,
+// mirror its ACL and are granted to the owners, editors and viewers of its
+// project when it is created.
+const storageLegacyRolePrefix = "roles/storage.legacy"
+
+// IamStorageBucketPolicySchema is added to google_storage_bucket_iam_policy,
+// whose policy would otherwise remove the legacy roles of the bucket.
+var IamStorageBucketPolicySchema = map[string]*schema.Schema{
+	"manage_legacy_roles": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+}
+
 type StorageBucketIamUpdater struct {
 	bucket string
-	Config *Config
+	// Whether the bindings of legacy roles are preserved when setting a
+	// policy without a binding for their role, and left out of the policy
+	// read unless managedLegacyRoles has their role.
+	preserveLegacyRoles bool
+	managedLegacyRoles  map[string]bool
+	Config              *Config
 }
 
 func NewStorageBucketIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
 	bucket := d.Get("bucket").(string)
 
+	// Only google_storage_bucket_iam_policy has manage_legacy_roles.
+	manage, ok := d.Get("manage_legacy_roles").(bool)
+	managedLegacyRoles := make(map[string]bool)
+	if ok && !manage {
+		if policy, err := unmarshalIamPolicy(d.Get("policy_data").(string)); err == nil {
+			for _, b := range policy.Bindings {
+				if strings.HasPrefix(b.Role, storageLegacyRolePrefix) {
+					managedLegacyRoles[b.Role] = true
+				}
+			}
+		}
+	}
+
 	return &StorageBucketIamUpdater{
-		bucket: bucket,
-		Config: config,
+		bucket:              bucket,
+		preserveLegacyRoles: ok && !manage,
+		managedLegacyRoles:  managedLegacyRoles,
+		Config:              config,
 	}, nil
 }
 
-func (u *StorageBucketIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
-	p, err := u.Config.clientStorage.Buckets.GetIamPolicy(u.bucket).Do()
+func StorageBucketIdParseFunc(d *schema.ResourceData, config *Config) error {
+	if err := parseImportId([]string{
+		"b/(?P<bucket>[^/]+)",
+		"(?P<bucket>[^/]+)",
+	}, d, config); err != nil {
+		return err
+	}
+
+	// Explicitly set the id so imported resources have the same ID format as non-imported ones.
+	d.SetId(d.Get("bucket").(string))
+	return nil
+}
+
+func (u *StorageBucketIamUpdater) GetResourceIamPolicy() (*IamPolicy, error) {
+	p, err := u.getPolicy()
 	if err != nil {
 		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
 	}
 
-	cloudResourcePolicy, err := storageToResourceManagerPolicy(p)
-	if err != nil {
-		return nil, errwrap.Wrapf(fmt.Sprintf("Invalid IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	if u.preserveLegacyRoles {
+		var bindings []*IamBinding
+		for _, b := range p.Bindings {
+			if !strings.HasPrefix(b.Role, storageLegacyRolePrefix) || u.managedLegacyRoles[b.Role] {
+				bindings = append(bindings, b)
+			}
+		}
+		p.Bindings = bindings
 	}
 
-	return cloudResourcePolicy, nil
+	return p, nil
 }
 
-func (u *StorageBucketIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
-	storagePolicy, err := resourceManagerToStoragePolicy(policy)
-
+func (u *StorageBucketIamUpdater) SetResourceIamPolicy(policy *IamPolicy) error {
+	ppolicy, err := u.getPolicy()
 	if err != nil {
-		return errwrap.Wrapf(fmt.Sprintf("Invalid IAM policy for %s: {{err}}", u.DescribeResource()), err)
+		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+	policy.Etag = ppolicy.Etag
+	policy.Version = iamPolicyVersion
+
+	if u.preserveLegacyRoles {
+		policy.Bindings = preserveStorageLegacyBindings(ppolicy.Bindings, policy.Bindings)
 	}
 
-	ppolicy, err := u.Config.clientStorage.Buckets.GetIamPolicy(u.bucket).Do()
+	// Unlike other APIs, the bucket policy is the body of the request.
+	var body map[string]interface{}
+	b, err := json.Marshal(policy)
+	if err == nil {
+		err = json.Unmarshal(b, &body)
+	}
 	if err != nil {
-		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
+		return errwrap.Wrapf(fmt.Sprintf("Invalid IAM policy for %s: {{err}}", u.DescribeResource()), err)
 	}
-	storagePolicy.Etag = ppolicy.Etag
-	_, err = u.Config.clientStorage.Buckets.SetIamPolicy(u.bucket, storagePolicy).Do()
+	_, err = Put(u.Config, u.policyUrl(), "", body)
 
 	if err != nil {
 		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
@@ -65,6 +128,14 @@ func (u *StorageBucketIamUpdater) SetResourceIamPolicy(policy *cloudresourcemana
 	return nil
 }
 
+func (u *StorageBucketIamUpdater) getPolicy() (*IamPolicy, error) {
+	return getIamPolicyWithQuery(u.Config, "GET", u.policyUrl(), "optionsRequestedPolicyVersion", "")
+}
+
+func (u *StorageBucketIamUpdater) policyUrl() string {
+	return fmt.Sprintf("%sb/%s/iam", u.Config.basePath(StorageBasePathKey), u.bucket)
+}
+
 func (u *StorageBucketIamUpdater) GetResourceId() string {
 	return u.bucket
 }
@@ -77,20 +148,17 @@ func (u *StorageBucketIamUpdater) DescribeResource() string {
 	return fmt.Sprintf("Storage Bucket %q", u.bucket)
 }
 
-func resourceManagerToStoragePolicy(p *cloudresourcemanager.Policy) (*storage.Policy, error) {
-	out := &storage.Policy{}
-	err := Convert(p, out)
-	if err != nil {
-		return nil, errwrap.Wrapf("Cannot convert a v1 policy to a storage policy: {{err}}", err)
+// preserveStorageLegacyBindings returns bindings with the bindings of current
+// for the legacy roles which have no binding in bindings.
+func preserveStorageLegacyBindings(current, bindings []*IamBinding) []*IamBinding {
+	roles := make(map[string]bool)
+	for _, b := range bindings {
+		roles[b.Role] = true
 	}
-	return out, nil
-}
-
-func storageToResourceManagerPolicy(p *storage.Policy) (*cloudresourcemanager.Policy, error) {
-	out := &cloudresourcemanager.Policy{}
-	err := Convert(p, out)
-	if err != nil {
-		return nil, errwrap.Wrapf("Cannot convert a storage policy to a v1 policy: {{err}}", err)
+	for _, b := range current {
+		if strings.HasPrefix(b.Role, storageLegacyRolePrefix) && !roles[b.Role] {
+			bindings = append(bindings, b)
+		}
 	}
-	return out, nil
+	return bindings
 }
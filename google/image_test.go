@@ -6,7 +6,6 @@ import (
 
 	"google.golang.org/api/compute/v1"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -15,7 +14,7 @@ func TestAccComputeImage_resolveImage(t *testing.T) {
 	t.Parallel()
 
 	var image compute.Image
-	rand := acctest.RandString(10)
+	rand := randString(t, 10)
 	name := fmt.Sprintf("test-image-%s", rand)
 	fam := fmt.Sprintf("test-image-family-%s", rand)
 
@@ -0,0 +1,103 @@
+package google
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/logging"
+	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
+)
+
+// impersonatedTokenSource exchanges the tokens of a base identity for
+// short-lived access tokens of a target service account using the IAM
+// Credentials API. The base identity must hold roles/iam.serviceAccountTokenCreator
+// on the target, or on the first service account of the delegation chain.
+type impersonatedTokenSource struct {
+	client *http.Client
+
+	// URL of the generateAccessToken method for the target service account.
+	url       string
+	target    string
+	delegates []string
+	scopes    []string
+}
+
+// newImpersonatedTokenSource returns a caching token source that mints
+// access tokens for the target service account, optionally through a chain
+// of delegate service accounts.
+func newImpersonatedTokenSource(base oauth2.TokenSource, basePath, target string, delegates, scopes []string) oauth2.TokenSource {
+	client := oauth2.NewClient(context.Background(), base)
+	client.Transport = logging.NewTransport("Google", client.Transport)
+
+	return oauth2.ReuseTokenSource(nil, &impersonatedTokenSource{
+		client:    client,
+		url:       fmt.Sprintf("%s%s:generateAccessToken", basePath, serviceAccountFQN(target, "")),
+		target:    target,
+		delegates: delegates,
+		scopes:    scopes,
+	})
+}
+
+type generateAccessTokenRequest struct {
+	Delegates []string `json:"delegates,omitempty"`
+	Scope     []string `json:"scope"`
+	Lifetime  string   `json:"lifetime"`
+}
+
+type generateAccessTokenResponse struct {
+	AccessToken string `json:"accessToken"`
+	ExpireTime  string `json:"expireTime"`
+}
+
+func (s *impersonatedTokenSource) Token() (*oauth2.Token, error) {
+	delegates := make([]string, 0, len(s.delegates))
+	for _, d := range s.delegates {
+		delegates = append(delegates, serviceAccountFQN(d, ""))
+	}
+
+	var buf bytes.Buffer
+	err := json.NewEncoder(&buf).Encode(&generateAccessTokenRequest{
+		Delegates: delegates,
+		Scope:     s.scopes,
+		Lifetime:  "3600s",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", s.url, &buf)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Error impersonating service account %s: %s", s.target, err)
+	}
+	defer googleapi.CloseBody(res)
+	if err := googleapi.CheckResponse(res); err != nil {
+		return nil, fmt.Errorf("Error impersonating service account %s: %s", s.target, err)
+	}
+
+	var token generateAccessTokenResponse
+	if err := json.NewDecoder(res.Body).Decode(&token); err != nil {
+		return nil, err
+	}
+
+	expiry, err := time.Parse(time.RFC3339, token.ExpireTime)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing expiry of impersonated token for %s: %s", s.target, err)
+	}
+
+	return &oauth2.Token{
+		AccessToken: token.AccessToken,
+		TokenType:   "Bearer",
+		Expiry:      expiry,
+	}, nil
+}
@@ -0,0 +1,55 @@
+package google
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+func TestImpersonatedTokenSource(t *testing.T) {
+	var gotPath string
+	var gotAuth string
+	var gotRequest generateAccessTokenRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotAuth = r.Header.Get("Authorization")
+		if err := json.NewDecoder(r.Body).Decode(&gotRequest); err != nil {
+			t.Fatalf("error decoding request: %s", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"accessToken": "impersonated-token", "expireTime": "2030-01-01T00:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	scopes := []string{"https://www.googleapis.com/auth/cloud-platform"}
+	base := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "base-token"})
+	ts := newImpersonatedTokenSource(base, server.URL+"/v1/", "target@my-project.iam.gserviceaccount.com", []string{"delegate@my-project.iam.gserviceaccount.com"}, scopes)
+
+	token, err := ts.Token()
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+
+	if token.AccessToken != "impersonated-token" {
+		t.Errorf("expected access token %q, got %q", "impersonated-token", token.AccessToken)
+	}
+	if token.Expiry.Year() != 2030 {
+		t.Errorf("expected token to expire in 2030, got %s", token.Expiry)
+	}
+	if gotAuth != "Bearer base-token" {
+		t.Errorf("expected request to be authenticated with the base token, got %q", gotAuth)
+	}
+	if expected := "/v1/projects/-/serviceAccounts/target@my-project.iam.gserviceaccount.com:generateAccessToken"; gotPath != expected {
+		t.Errorf("expected request path %q, got %q", expected, gotPath)
+	}
+	if expected := []string{"projects/-/serviceAccounts/delegate@my-project.iam.gserviceaccount.com"}; !reflect.DeepEqual(gotRequest.Delegates, expected) {
+		t.Errorf("expected delegates %v, got %v", expected, gotRequest.Delegates)
+	}
+	if !reflect.DeepEqual(gotRequest.Scope, scopes) {
+		t.Errorf("expected scopes %v, got %v", scopes, gotRequest.Scope)
+	}
+}
@@ -64,3 +64,44 @@ func setDefaultValues(idRegex string, d TerraformResourceData, config *Config) e
 	}
 	return nil
 }
+
+// importIdFields holds the fields parsed from an import id which aren't
+// attributes of the resource, see parseImportIdFields.
+type importIdFields struct {
+	TerraformResourceData
+	values map[string]string
+}
+
+func (d *importIdFields) GetOk(key string) (interface{}, bool) {
+	if v, ok := d.values[key]; ok {
+		return v, v != ""
+	}
+	return d.TerraformResourceData.GetOk(key)
+}
+
+func (d *importIdFields) Set(key string, value interface{}) error {
+	if _, ok := d.values[key]; ok {
+		d.values[key] = value.(string)
+		return nil
+	}
+	return d.TerraformResourceData.Set(key, value)
+}
+
+// Parse an import id like parseImportId, for ids containing fields which
+// aren't attributes of the resource, e.g. a job id only used as the resource
+// id, or the project of a parent resource. The values of these fields are
+// returned rather than set in d, and "project" is defaulted as usual.
+func parseImportIdFields(idRegexes []string, fields []string, d TerraformResourceData, config *Config) (map[string]string, error) {
+	data := &importIdFields{
+		TerraformResourceData: d,
+		values:                make(map[string]string),
+	}
+	for _, field := range fields {
+		data.values[field] = ""
+	}
+
+	if err := parseImportId(idRegexes, data, config); err != nil {
+		return nil, err
+	}
+	return data.values, nil
+}
@@ -3,14 +3,13 @@ package google
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccProjectIamCustomRole_import(t *testing.T) {
 	t.Parallel()
 
-	roleId := "tfIamRole" + acctest.RandString(10)
+	roleId := "tfIamRole" + randString(t, 10)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
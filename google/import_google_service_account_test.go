@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
@@ -16,7 +15,7 @@ func TestAccServiceAccount_importBasic(t *testing.T) {
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccServiceAccount_import("terraform-" + acctest.RandString(10)),
+				Config: testAccServiceAccount_import("terraform-" + randString(t, 10)),
 			},
 
 			resource.TestStep{
@@ -44,7 +43,7 @@ func TestAccServiceAccount_importWithProject(t *testing.T) {
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccServiceAccount_importWithProject(getTestProjectFromEnv(), "terraform-"+acctest.RandString(10)),
+				Config: testAccServiceAccount_importWithProject(getTestProjectFromEnv(), "terraform-"+randString(t, 10)),
 			},
 
 			resource.TestStep{
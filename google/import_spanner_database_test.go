@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
@@ -12,8 +11,8 @@ func TestAccSpannerDatabase_importInstanceDatabase(t *testing.T) {
 	t.Parallel()
 
 	resourceName := "google_spanner_database.basic"
-	instanceName := fmt.Sprintf("span-iname-%s", acctest.RandString(10))
-	dbName := fmt.Sprintf("span-dbname-%s", acctest.RandString(10))
+	instanceName := fmt.Sprintf("span-iname-%s", randString(t, 10))
+	dbName := fmt.Sprintf("span-dbname-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -38,8 +37,8 @@ func TestAccSpannerDatabase_importProjectInstanceDatabase(t *testing.T) {
 	t.Parallel()
 
 	resourceName := "google_spanner_database.basic"
-	instanceName := fmt.Sprintf("span-iname-%s", acctest.RandString(10))
-	dbName := fmt.Sprintf("span-dbname-%s", acctest.RandString(10))
+	instanceName := fmt.Sprintf("span-iname-%s", randString(t, 10))
+	dbName := fmt.Sprintf("span-dbname-%s", randString(t, 10))
 	projectId := getTestProjectFromEnv()
 
 	resource.Test(t, resource.TestCase{
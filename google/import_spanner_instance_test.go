@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
@@ -12,7 +11,7 @@ func TestAccSpannerInstance_importInstance(t *testing.T) {
 	t.Parallel()
 
 	resourceName := "google_spanner_instance.basic"
-	instanceName := fmt.Sprintf("span-itest-%s", acctest.RandString(10))
+	instanceName := fmt.Sprintf("span-itest-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -37,7 +36,7 @@ func TestAccSpannerInstance_importProjectInstance(t *testing.T) {
 	t.Parallel()
 
 	resourceName := "google_spanner_instance.basic"
-	instanceName := fmt.Sprintf("span-itest-%s", acctest.RandString(10))
+	instanceName := fmt.Sprintf("span-itest-%s", randString(t, 10))
 	projectId := getTestProjectFromEnv()
 	if projectId == "" {
 		t.Skip("Unable to locate projectId via environment variables ... skipping ")
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
@@ -13,7 +12,7 @@ func TestAccSqlDatabaseInstance_importBasic(t *testing.T) {
 	t.Parallel()
 
 	resourceName := "google_sql_database_instance.instance"
-	databaseID := acctest.RandInt()
+	databaseID := randInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -39,7 +38,7 @@ func TestAccSqlDatabaseInstance_importBasic3(t *testing.T) {
 	t.Parallel()
 
 	resourceName := "google_sql_database_instance.instance"
-	databaseID := acctest.RandInt()
+	databaseID := randInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
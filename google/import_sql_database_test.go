@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
@@ -20,7 +19,7 @@ func TestAccSqlDatabase_importBasic(t *testing.T) {
 		Steps: []resource.TestStep{
 			resource.TestStep{
 				Config: fmt.Sprintf(
-					testGoogleSqlDatabase_basic, acctest.RandString(10), acctest.RandString(10)),
+					testGoogleSqlDatabase_basic, randString(t, 10), randString(t, 10)),
 			},
 
 			resource.TestStep{
@@ -2,6 +2,8 @@ package google
 
 import (
 	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func TestParseImportId(t *testing.T) {
@@ -145,3 +147,207 @@ func TestParseImportId(t *testing.T) {
 		}
 	}
 }
+
+func TestParseImportIdFields(t *testing.T) {
+	idRegexes := []string{
+		"projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/jobs/(?P<job_id>[^/]+)",
+		"(?P<job_id>[^/]+)",
+	}
+	config := &Config{
+		Project: "default-project",
+		Region:  "default-region",
+	}
+
+	d := &ResourceDataMock{
+		FieldsInSchema: make(map[string]interface{}),
+		id:             "projects/my-project/regions/my-region/jobs/my-job",
+	}
+	fields, err := parseImportIdFields(idRegexes, []string{"job_id"}, d, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fields["job_id"] != "my-job" {
+		t.Errorf("Expected job_id %q, got %q", "my-job", fields["job_id"])
+	}
+	if _, ok := d.FieldsInSchema["job_id"]; ok {
+		t.Errorf("Expected job_id not to be set in the resource")
+	}
+	if v := d.FieldsInSchema["region"]; v != "my-region" {
+		t.Errorf("Expected region %q, got %q", "my-region", v)
+	}
+
+	d = &ResourceDataMock{
+		FieldsInSchema: make(map[string]interface{}),
+		id:             "my-job",
+	}
+	fields, err = parseImportIdFields(idRegexes, []string{"job_id", "project"}, d, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fields["job_id"] != "my-job" || fields["project"] != "default-project" {
+		t.Errorf("Expected job_id %q and project %q, got %v", "my-job", "default-project", fields)
+	}
+}
+
+// Importers which don't read the resource, tested against each of their
+// accepted id formats.
+func TestResourceImporters(t *testing.T) {
+	config := &Config{
+		Project: "default-project",
+		Region:  "default-region",
+		Zone:    "default-region-a",
+	}
+
+	cases := map[string]struct {
+		Resource     string
+		ImportId     string
+		ExpectedId   string
+		ExpectedData map[string]string
+	}{
+		"bigtable table": {
+			Resource:     "google_bigtable_table",
+			ImportId:     "projects/my-project/instances/my-instance/tables/my-table",
+			ExpectedId:   "my-table",
+			ExpectedData: map[string]string{"project": "my-project", "instance_name": "my-instance", "name": "my-table"},
+		},
+		"bigtable table with default project": {
+			Resource:     "google_bigtable_table",
+			ImportId:     "my-instance/my-table",
+			ExpectedId:   "my-table",
+			ExpectedData: map[string]string{"project": "default-project", "instance_name": "my-instance", "name": "my-table"},
+		},
+		"network peering": {
+			Resource:   "google_compute_network_peering",
+			ImportId:   "my-project/my-network/my-peering",
+			ExpectedId: "my-network/my-peering",
+			ExpectedData: map[string]string{
+				"network": "https://www.googleapis.com/compute/v1/projects/my-project/global/networks/my-network",
+				"name":    "my-peering",
+			},
+		},
+		"network peering with default project": {
+			Resource:   "google_compute_network_peering",
+			ImportId:   "my-network/my-peering",
+			ExpectedId: "my-network/my-peering",
+			ExpectedData: map[string]string{
+				"network": "https://www.googleapis.com/compute/v1/projects/default-project/global/networks/my-network",
+				"name":    "my-peering",
+			},
+		},
+		"region backend service": {
+			Resource:     "google_compute_region_backend_service",
+			ImportId:     "projects/my-project/regions/my-region/backendServices/my-service",
+			ExpectedId:   "my-service",
+			ExpectedData: map[string]string{"project": "my-project", "region": "my-region", "name": "my-service"},
+		},
+		"dataflow job": {
+			Resource:     "google_dataflow_job",
+			ImportId:     "my-project/2018-09-28_12_00_00-1234",
+			ExpectedId:   "2018-09-28_12_00_00-1234",
+			ExpectedData: map[string]string{"project": "my-project", "on_delete": "drain"},
+		},
+		"dataproc cluster in the global region": {
+			Resource:     "google_dataproc_cluster",
+			ImportId:     "my-cluster",
+			ExpectedId:   "my-cluster",
+			ExpectedData: map[string]string{"project": "default-project", "region": "global", "name": "my-cluster"},
+		},
+		"dataproc job": {
+			Resource:     "google_dataproc_job",
+			ImportId:     "projects/my-project/regions/my-region/jobs/my-job",
+			ExpectedId:   "my-job",
+			ExpectedData: map[string]string{"project": "my-project", "region": "my-region"},
+		},
+		"billing account sink": {
+			Resource:     "google_logging_billing_account_sink",
+			ImportId:     "billingAccounts/000000-0000/sinks/my-sink",
+			ExpectedId:   "billingAccounts/000000-0000/sinks/my-sink",
+			ExpectedData: map[string]string{"billing_account": "000000-0000", "name": "my-sink"},
+		},
+		"folder sink": {
+			Resource:     "google_logging_folder_sink",
+			ImportId:     "1234/my-sink",
+			ExpectedId:   "folders/1234/sinks/my-sink",
+			ExpectedData: map[string]string{"folder": "1234", "name": "my-sink"},
+		},
+		"organization sink": {
+			Resource:     "google_logging_organization_sink",
+			ImportId:     "organizations/1234/sinks/my-sink",
+			ExpectedId:   "organizations/1234/sinks/my-sink",
+			ExpectedData: map[string]string{"org_id": "1234", "name": "my-sink"},
+		},
+		"folder organization policy": {
+			Resource:     "google_folder_organization_policy",
+			ImportId:     "folders/1234/constraints/serviceuser.services",
+			ExpectedId:   "1234:serviceuser.services",
+			ExpectedData: map[string]string{"folder": "1234", "constraint": "serviceuser.services"},
+		},
+		"folder organization policy by resource id": {
+			Resource:     "google_folder_organization_policy",
+			ImportId:     "folders/1234:constraints/serviceuser.services",
+			ExpectedId:   "1234:serviceuser.services",
+			ExpectedData: map[string]string{"folder": "1234", "constraint": "serviceuser.services"},
+		},
+		"project organization policy of a domain-scoped project": {
+			Resource:     "google_project_organization_policy",
+			ImportId:     "example.com:my-project:serviceuser.services",
+			ExpectedId:   "example.com:my-project:serviceuser.services",
+			ExpectedData: map[string]string{"project": "example.com:my-project", "constraint": "serviceuser.services"},
+		},
+		"project organization policy": {
+			Resource:     "google_project_organization_policy",
+			ImportId:     "my-project/serviceuser.services",
+			ExpectedId:   "my-project:serviceuser.services",
+			ExpectedData: map[string]string{"project": "my-project", "constraint": "serviceuser.services"},
+		},
+		"runtimeconfig config": {
+			Resource:     "google_runtimeconfig_config",
+			ImportId:     "my-config",
+			ExpectedId:   "projects/default-project/configs/my-config",
+			ExpectedData: map[string]string{"project": "default-project", "name": "my-config"},
+		},
+		"runtimeconfig variable": {
+			Resource:     "google_runtimeconfig_variable",
+			ImportId:     "projects/my-project/configs/my-config/variables/ports/http",
+			ExpectedId:   "projects/my-project/configs/my-config/variables/ports/http",
+			ExpectedData: map[string]string{"project": "my-project", "parent": "my-config", "name": "ports/http"},
+		},
+		"runtimeconfig variable with default project": {
+			Resource:     "google_runtimeconfig_variable",
+			ImportId:     "my-config/ports/http",
+			ExpectedId:   "projects/default-project/configs/my-config/variables/ports/http",
+			ExpectedData: map[string]string{"project": "default-project", "parent": "my-config", "name": "ports/http"},
+		},
+		"source repository": {
+			Resource:     "google_sourcerepo_repository",
+			ImportId:     "projects/my-project/repos/team/my-repo",
+			ExpectedId:   "projects/my-project/repos/team/my-repo",
+			ExpectedData: map[string]string{"project": "my-project", "name": "team/my-repo"},
+		},
+		"storage bucket object": {
+			Resource:     "google_storage_bucket_object",
+			ImportId:     "gs://my-bucket/path/to/object",
+			ExpectedId:   "my-bucket-path/to/object",
+			ExpectedData: map[string]string{"bucket": "my-bucket", "name": "path/to/object"},
+		},
+	}
+
+	resources := Provider().(*schema.Provider).ResourcesMap
+	for tn, tc := range cases {
+		d := resources[tc.Resource].Data(nil)
+		d.SetId(tc.ImportId)
+		imported, err := resources[tc.Resource].Importer.State(d, config)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+			continue
+		}
+		if id := imported[0].Id(); id != tc.ExpectedId {
+			t.Errorf("%s: expected id %q, got %q", tn, tc.ExpectedId, id)
+		}
+		for k, expected := range tc.ExpectedData {
+			if v := imported[0].Get(k); v != expected {
+				t.Errorf("%s: expected %q for %q, got %q", tn, expected, k, v)
+			}
+		}
+	}
+}
@@ -10,6 +10,7 @@ func TestParseLoggingSinkId(t *testing.T) {
 	}{
 		{"projects/my-project/sinks/my-sink", &LoggingSinkId{"projects", "my-project", "my-sink"}, false},
 		{"folders/foofolder/sinks/woo", &LoggingSinkId{"folders", "foofolder", "woo"}, false},
+		{"billingAccounts/000000-0000/sinks/my-sink", &LoggingSinkId{"billingAccounts", "000000-0000", "my-sink"}, false},
 		{"kitchens/the-big-one/sinks/second-from-the-left", nil, true},
 	}
 
@@ -2,47 +2,31 @@ package google
 
 import (
 	"errors"
-	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	computeBeta "google.golang.org/api/compute/v0.beta"
 	"google.golang.org/api/compute/v1"
 )
 
-const FINGERPRINT_RETRIES = 10
-
 var FINGERPRINT_FAIL_ERRORS = []string{"Invalid fingerprint.", "Supplied fingerprint does not match current metadata fingerprint."}
 
 // Since the google compute API uses optimistic locking, there is a chance
 // we need to resubmit our updated metadata. To do this, you need to provide
-// an update function that attempts to submit your metadata
+// an update function that reads the current fingerprint and attempts to
+// submit your metadata
 func MetadataRetryWrapper(update func() error) error {
-	attempt := 0
-	for attempt < FINGERPRINT_RETRIES {
-		err := update()
-		if err == nil {
-			return nil
-		}
-
-		// Check to see if the error matches any of our fingerprint-related failure messages
-		var fingerprintError bool
-		for _, msg := range FINGERPRINT_FAIL_ERRORS {
-			if strings.Contains(err.Error(), msg) {
-				fingerprintError = true
-				break
-			}
-		}
+	return retryTimeDuration(update, 5*time.Minute, isFingerprintError)
+}
 
-		if !fingerprintError {
-			// Something else went wrong, don't retry
-			return err
+func isFingerprintError(err error) (bool, string) {
+	for _, msg := range FINGERPRINT_FAIL_ERRORS {
+		if strings.Contains(err.Error(), msg) {
+			return true, "Metadata fingerprint mismatch"
 		}
-
-		attempt++
 	}
-
-	return fmt.Errorf("Failed to update metadata after %d retries", attempt)
+	return false, ""
 }
 
 // Update the metadata (serverMD) according to the provided diff (oldMDMap v
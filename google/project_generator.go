@@ -0,0 +1,614 @@
+package google
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/iam/v1"
+	"google.golang.org/api/sqladmin/v1beta4"
+	"google.golang.org/api/storage/v1"
+)
+
+// GenerateProject writes a configuration managing the resources of an
+// existing project, and the state matching it, so that the project can be
+// adopted without recreating its resources. p must be configured with
+// credentials for the project.
+//
+// Resources are found by listing each kind of resource in the project, and
+// read with the Importer and Read functions of their resource type. The
+// configuration sets every argument of the resources which isn't empty or
+// its default, and refers to the other generated resources instead of their
+// self links. It doesn't include a provider block.
+//
+// Resources which can't be listed or read are logged and left out, so the
+// configuration should be reviewed, and planned to be sure there is no diff,
+// before being applied.
+func GenerateProject(p *schema.Provider, project string, config, state io.Writer) error {
+	g := &projectGenerator{
+		provider:    p,
+		config:      p.Meta().(*Config),
+		project:     project,
+		names:       make(map[string]bool),
+		references:  make(map[string]string),
+		autoSubnets: make(map[string]bool),
+		bootDisks:   make(map[string]bool),
+		skipped:     make(map[string]bool),
+	}
+
+	for _, l := range projectResourceListers {
+		imports, err := l.list(g)
+		if err != nil {
+			log.Printf("[WARN] Error listing %s resources in project %q: %s", l.resourceType, project, err)
+			continue
+		}
+		for _, i := range imports {
+			if err := g.read(l.resourceType, i); err != nil {
+				log.Printf("[WARN] Error reading %s %q: %s", l.resourceType, i.id, err)
+			}
+		}
+	}
+
+	if err := g.writeConfig(config); err != nil {
+		return err
+	}
+	return terraform.WriteState(g.state(), state)
+}
+
+// projectResourceImport is a resource found in a project, by the ID it is
+// imported with.
+type projectResourceImport struct {
+	id string
+	// name is used to name the resource in the configuration.
+	name string
+}
+
+// projectResourceListers list the resources of each type supported by
+// GenerateProject, in order: listers may skip resources managed through the
+// resources of the listers before them.
+var projectResourceListers = []struct {
+	resourceType string
+	list         func(g *projectGenerator) ([]projectResourceImport, error)
+}{
+	{"google_compute_network", listProjectNetworks},
+	{"google_compute_subnetwork", listProjectSubnetworks},
+	{"google_compute_firewall", listProjectFirewalls},
+	{"google_compute_instance", listProjectInstances},
+	{"google_compute_disk", listProjectDisks},
+	{"google_storage_bucket", listProjectBuckets},
+	{"google_service_account", listProjectServiceAccounts},
+	{"google_project_iam_member", listProjectIamMembers},
+	{"google_container_cluster", listProjectContainerClusters},
+	{"google_sql_database_instance", listProjectSqlDatabaseInstances},
+}
+
+// generatedResource is a resource read by GenerateProject.
+type generatedResource struct {
+	resourceType string
+	name         string
+	state        *terraform.InstanceState
+}
+
+func (r *generatedResource) key() string {
+	return r.resourceType + "." + r.name
+}
+
+type projectGenerator struct {
+	provider *schema.Provider
+	config   *Config
+	project  string
+
+	resources []*generatedResource
+	// names holds the keys of the resources, to name resources uniquely.
+	names map[string]bool
+	// references maps the self links and emails of the resources to an
+	// interpolation of the matching attribute.
+	references map[string]string
+
+	// Subnetworks of auto mode networks are created and deleted with their
+	// network, and boot disks with their instance.
+	autoSubnets map[string]bool
+	bootDisks   map[string]bool
+	// skipped holds the self links of the resources managed outside of the
+	// configuration, e.g. by instance group managers.
+	skipped map[string]bool
+}
+
+// read imports and refreshes a resource of the project.
+func (g *projectGenerator) read(resourceType string, i projectResourceImport) error {
+	info := &terraform.InstanceInfo{Type: resourceType}
+	states, err := g.provider.ImportState(info, i.id)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range states {
+		s, err := g.provider.Refresh(info, s)
+		if err != nil {
+			return err
+		}
+		if s == nil || s.ID == "" {
+			return fmt.Errorf("resource not found")
+		}
+
+		// Arguments only read from the configuration, such as
+		// force_destroy, are missing from imported resources. They are set
+		// to their default, which is what the configuration sets them to.
+		for k, v := range g.provider.ResourcesMap[resourceType].Schema {
+			if _, ok := s.Attributes[k]; !ok && v.Default != nil && v.Elem == nil {
+				s.Attributes[k] = fmt.Sprint(v.Default)
+			}
+		}
+
+		r := &generatedResource{
+			resourceType: resourceType,
+			name:         g.uniqueName(resourceType, i.name),
+			state:        s,
+		}
+		g.resources = append(g.resources, r)
+		if link := s.Attributes["self_link"]; link != "" {
+			if path, err := getRelativePath(link); err == nil {
+				g.references[path] = fmt.Sprintf("${%s.self_link}", r.key())
+			}
+		}
+		if resourceType == "google_service_account" {
+			g.references[s.Attributes["email"]] = fmt.Sprintf("${%s.email}", r.key())
+		}
+	}
+	return nil
+}
+
+var invalidResourceNameChars = regexp.MustCompile("[^a-z0-9_-]+")
+
+// uniqueName returns a name for a resource of the configuration, derived
+// from the name of the GCP resource.
+func (g *projectGenerator) uniqueName(resourceType, name string) string {
+	base := strings.Trim(invalidResourceNameChars.ReplaceAllString(strings.ToLower(name), "_"), "_-")
+	if base == "" || base[0] < 'a' || base[0] > 'z' {
+		base = "r_" + base
+	}
+
+	name = base
+	for n := 2; g.names[resourceType+"."+name]; n++ {
+		name = fmt.Sprintf("%s_%d", base, n)
+	}
+	g.names[resourceType+"."+name] = true
+	return name
+}
+
+// reference returns an interpolation of the attribute of a generated
+// resource holding value, if any.
+func (g *projectGenerator) reference(value string) (string, bool) {
+	if member := strings.TrimPrefix(value, "serviceAccount:"); member != value {
+		if ref, ok := g.references[member]; ok {
+			return "serviceAccount:" + ref, true
+		}
+		return "", false
+	}
+	if ref, ok := g.references[value]; ok {
+		return ref, true
+	}
+	path, err := getRelativePath(value)
+	if err != nil {
+		return "", false
+	}
+	ref, ok := g.references[path]
+	return ref, ok
+}
+
+func (g *projectGenerator) state() *terraform.State {
+	state := terraform.NewState()
+	mod := state.RootModule()
+	for _, r := range g.resources {
+		mod.Resources[r.key()] = &terraform.ResourceState{
+			Type:         r.resourceType,
+			Provider:     "provider.google",
+			Dependencies: g.dependencies(r),
+			Primary:      r.state,
+		}
+	}
+	return state
+}
+
+// dependencies returns the keys of the resources r refers to.
+func (g *projectGenerator) dependencies(r *generatedResource) []string {
+	deps := make(map[string]bool)
+	for _, v := range r.state.Attributes {
+		if ref, ok := g.reference(v); ok {
+			key := strings.TrimSuffix(ref[strings.Index(ref, "${")+2:], "}")
+			key = key[:strings.LastIndex(key, ".")]
+			if key != r.key() {
+				deps[key] = true
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(deps))
+	for k := range deps {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (g *projectGenerator) writeConfig(w io.Writer) error {
+	var b bytes.Buffer
+	for i, r := range g.resources {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		res := g.provider.ResourcesMap[r.resourceType]
+		d := res.Data(r.state)
+
+		values := make(map[string]interface{})
+		for k := range res.Schema {
+			values[k] = d.Get(k)
+		}
+
+		fmt.Fprintf(&b, "resource %q %q {\n", r.resourceType, r.name)
+		g.writeBody(&b, 1, res.Schema, values)
+		b.WriteString("}\n")
+	}
+
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+// writeBody writes the arguments of a block set in values: attributes
+// first, with their `=` aligned like `terraform fmt` does, then nested
+// blocks.
+func (g *projectGenerator) writeBody(b *bytes.Buffer, indent int, s map[string]*schema.Schema, values map[string]interface{}) {
+	var attributes, blocks []string
+	for k, v := range s {
+		if !isGeneratedArgument(v, values[k]) {
+			continue
+		}
+		if _, ok := v.Elem.(*schema.Resource); ok {
+			blocks = append(blocks, k)
+		} else {
+			attributes = append(attributes, k)
+		}
+	}
+	sortArguments(attributes)
+	sort.Strings(blocks)
+
+	// Arguments conflicting with each other are never both set, but an
+	// empty value may be read for one of them.
+	emitted := make(map[string]bool)
+	conflicts := func(k string) bool {
+		for _, c := range s[k].ConflictsWith {
+			// Conflicting arguments are given by their path, e.g.
+			// `boot_disk.0.source`.
+			if emitted[c[strings.LastIndex(c, ".")+1:]] {
+				return true
+			}
+		}
+		emitted[k] = true
+		return false
+	}
+
+	prefix := strings.Repeat("  ", indent)
+	width := 0
+	for _, k := range attributes {
+		if len(k) > width {
+			width = len(k)
+		}
+	}
+	for _, k := range attributes {
+		if conflicts(k) {
+			continue
+		}
+		fmt.Fprintf(b, "%s%-*s = %s\n", prefix, width, k, g.formatValue(values[k], indent))
+	}
+
+	for i, k := range blocks {
+		if conflicts(k) {
+			continue
+		}
+		elem := s[k].Elem.(*schema.Resource)
+		for j, v := range listValue(values[k]) {
+			if len(attributes) > 0 || i > 0 || j > 0 {
+				b.WriteString("\n")
+			}
+			fmt.Fprintf(b, "%s%s {\n", prefix, k)
+			g.writeBody(b, indent+1, elem.Schema, v.(map[string]interface{}))
+			fmt.Fprintf(b, "%s}\n", prefix)
+		}
+	}
+}
+
+// sortArguments sorts argument names, with the name of the resource first.
+func sortArguments(keys []string) {
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i] == "name" || keys[j] == "name" {
+			return keys[i] == "name"
+		}
+		return keys[i] < keys[j]
+	})
+}
+
+// isGeneratedArgument returns whether an argument is set in the
+// configuration, i.e. whether it can be set and is set to a value other than
+// its default. Blocks are only set if one of their arguments is.
+func isGeneratedArgument(s *schema.Schema, v interface{}) bool {
+	if !s.Optional && !s.Required || s.Deprecated != "" || s.Removed != "" {
+		return false
+	}
+
+	if elem, ok := s.Elem.(*schema.Resource); ok {
+		for _, e := range listValue(v) {
+			m, _ := e.(map[string]interface{})
+			for k, s := range elem.Schema {
+				if isGeneratedArgument(s, m[k]) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	if s.Default != nil {
+		return !reflect.DeepEqual(v, s.Default)
+	}
+
+	switch v := v.(type) {
+	case nil:
+		return false
+	case string:
+		return v != ""
+	case int:
+		return v != 0
+	case float64:
+		return v != 0
+	case bool:
+		return v
+	case map[string]interface{}:
+		return len(v) > 0
+	case []interface{}:
+		return len(v) > 0
+	case *schema.Set:
+		return v.Len() > 0
+	}
+	return true
+}
+
+func listValue(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case []interface{}:
+		return v
+	case *schema.Set:
+		return v.List()
+	}
+	return nil
+}
+
+func (g *projectGenerator) formatValue(v interface{}, indent int) string {
+	switch v := v.(type) {
+	case string:
+		if ref, ok := g.reference(v); ok {
+			return strconv.Quote(ref)
+		}
+		// Escape interpolations, which HCL would otherwise evaluate.
+		return strings.Replace(strconv.Quote(v), "${", "$${", -1)
+	case []interface{}, *schema.Set:
+		elems := make([]string, 0)
+		for _, e := range listValue(v) {
+			elems = append(elems, g.formatValue(e, indent))
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		width := 0
+		for k := range v {
+			keys = append(keys, k)
+			if len(strconv.Quote(k)) > width {
+				width = len(strconv.Quote(k))
+			}
+		}
+		sort.Strings(keys)
+
+		prefix := strings.Repeat("  ", indent)
+		var b bytes.Buffer
+		b.WriteString("{\n")
+		for _, k := range keys {
+			fmt.Fprintf(&b, "%s  %-*s = %s\n", prefix, width, strconv.Quote(k), g.formatValue(v[k], indent+1))
+		}
+		b.WriteString(prefix + "}")
+		return b.String()
+	}
+	return fmt.Sprint(v)
+}
+
+func listProjectNetworks(g *projectGenerator) ([]projectResourceImport, error) {
+	var imports []projectResourceImport
+	err := g.config.clientCompute.Networks.List(g.project).Pages(g.config.stopContext(), func(res *compute.NetworkList) error {
+		for _, network := range res.Items {
+			if network.AutoCreateSubnetworks {
+				g.autoSubnets[network.SelfLink] = true
+			}
+			imports = append(imports, projectResourceImport{id: network.Name, name: network.Name})
+		}
+		return nil
+	})
+	return imports, err
+}
+
+func listProjectSubnetworks(g *projectGenerator) ([]projectResourceImport, error) {
+	var imports []projectResourceImport
+	err := g.config.clientCompute.Subnetworks.AggregatedList(g.project).Pages(g.config.stopContext(), func(res *compute.SubnetworkAggregatedList) error {
+		for _, scoped := range res.Items {
+			for _, subnetwork := range scoped.Subnetworks {
+				if g.autoSubnets[subnetwork.Network] {
+					continue
+				}
+				region := GetResourceNameFromSelfLink(subnetwork.Region)
+				imports = append(imports, projectResourceImport{
+					id:   fmt.Sprintf("%s/%s", region, subnetwork.Name),
+					name: subnetwork.Name,
+				})
+			}
+		}
+		return nil
+	})
+	return imports, err
+}
+
+func listProjectFirewalls(g *projectGenerator) ([]projectResourceImport, error) {
+	var imports []projectResourceImport
+	err := g.config.clientCompute.Firewalls.List(g.project).Pages(g.config.stopContext(), func(res *compute.FirewallList) error {
+		for _, firewall := range res.Items {
+			imports = append(imports, projectResourceImport{id: firewall.Name, name: firewall.Name})
+		}
+		return nil
+	})
+	return imports, err
+}
+
+func listProjectInstances(g *projectGenerator) ([]projectResourceImport, error) {
+	var imports []projectResourceImport
+	err := g.config.clientCompute.Instances.AggregatedList(g.project).Pages(g.config.stopContext(), func(res *compute.InstanceAggregatedList) error {
+		for _, scoped := range res.Items {
+			for _, instance := range scoped.Instances {
+				for _, disk := range instance.Disks {
+					if disk.Boot {
+						g.bootDisks[disk.Source] = true
+					}
+				}
+
+				// Instances of managed instance groups are managed through
+				// the group.
+				if instance.Metadata != nil {
+					managed := false
+					for _, item := range instance.Metadata.Items {
+						managed = managed || item.Key == "created-by"
+					}
+					if managed {
+						g.skipped[instance.SelfLink] = true
+						continue
+					}
+				}
+
+				zone := GetResourceNameFromSelfLink(instance.Zone)
+				imports = append(imports, projectResourceImport{
+					id:   fmt.Sprintf("%s/%s/%s", g.project, zone, instance.Name),
+					name: instance.Name,
+				})
+			}
+		}
+		return nil
+	})
+	return imports, err
+}
+
+func listProjectDisks(g *projectGenerator) ([]projectResourceImport, error) {
+	var imports []projectResourceImport
+	err := g.config.clientCompute.Disks.AggregatedList(g.project).Pages(g.config.stopContext(), func(res *compute.DiskAggregatedList) error {
+		for _, scoped := range res.Items {
+			for _, disk := range scoped.Disks {
+				if g.bootDisks[disk.SelfLink] {
+					continue
+				}
+				skipped := false
+				for _, user := range disk.Users {
+					skipped = skipped || g.skipped[user]
+				}
+				if skipped {
+					continue
+				}
+
+				zone := GetResourceNameFromSelfLink(disk.Zone)
+				imports = append(imports, projectResourceImport{
+					id:   fmt.Sprintf("%s/%s/%s", g.project, zone, disk.Name),
+					name: disk.Name,
+				})
+			}
+		}
+		return nil
+	})
+	return imports, err
+}
+
+func listProjectBuckets(g *projectGenerator) ([]projectResourceImport, error) {
+	var imports []projectResourceImport
+	err := g.config.clientStorage.Buckets.List(g.project).Pages(g.config.stopContext(), func(res *storage.Buckets) error {
+		for _, bucket := range res.Items {
+			imports = append(imports, projectResourceImport{id: bucket.Name, name: bucket.Name})
+		}
+		return nil
+	})
+	return imports, err
+}
+
+func listProjectServiceAccounts(g *projectGenerator) ([]projectResourceImport, error) {
+	var imports []projectResourceImport
+	err := g.config.clientIAM.Projects.ServiceAccounts.List("projects/"+g.project).Pages(g.config.stopContext(), func(res *iam.ListServiceAccountsResponse) error {
+		for _, sa := range res.Accounts {
+			imports = append(imports, projectResourceImport{
+				id:   sa.Name,
+				name: strings.Split(sa.Email, "@")[0],
+			})
+		}
+		return nil
+	})
+	return imports, err
+}
+
+// listProjectIamMembers lists each member of the IAM policy of the project,
+// rather than the policy or its bindings, so that applying the configuration
+// never removes members added to the project outside of it.
+func listProjectIamMembers(g *projectGenerator) ([]projectResourceImport, error) {
+	policy, err := getProjectIamPolicy(g.project, g.config)
+	if err != nil {
+		return nil, err
+	}
+
+	var imports []projectResourceImport
+	for _, binding := range policy.Bindings {
+		for _, member := range binding.Members {
+			id := fmt.Sprintf("%s %s %s", g.project, binding.Role, member)
+			name := GetResourceNameFromSelfLink(binding.Role) + "_" + strings.Split(member, "@")[0]
+			if binding.Condition != nil {
+				id += " " + binding.Condition.Title
+				name += "_" + binding.Condition.Title
+			}
+			imports = append(imports, projectResourceImport{id: id, name: name})
+		}
+	}
+	return imports, nil
+}
+
+func listProjectContainerClusters(g *projectGenerator) ([]projectResourceImport, error) {
+	res, err := g.config.clientContainerBeta.Projects.Locations.Clusters.List(fmt.Sprintf("projects/%s/locations/-", g.project)).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	var imports []projectResourceImport
+	for _, cluster := range res.Clusters {
+		imports = append(imports, projectResourceImport{
+			id:   fmt.Sprintf("%s/%s/%s", g.project, cluster.Location, cluster.Name),
+			name: cluster.Name,
+		})
+	}
+	return imports, nil
+}
+
+func listProjectSqlDatabaseInstances(g *projectGenerator) ([]projectResourceImport, error) {
+	var imports []projectResourceImport
+	err := g.config.clientSqlAdmin.Instances.List(g.project).Pages(g.config.stopContext(), func(res *sqladmin.InstancesListResponse) error {
+		for _, instance := range res.Items {
+			imports = append(imports, projectResourceImport{id: instance.Name, name: instance.Name})
+		}
+		return nil
+	})
+	return imports, err
+}
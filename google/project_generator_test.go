@@ -0,0 +1,216 @@
+package google
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config/module"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-google/google/fakegcp"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/storage/v1"
+)
+
+func TestGenerateProject(t *testing.T) {
+	t.Parallel()
+
+	s := newFakeGcpServer(t)
+	defer s.Close()
+	config := testFakeGcpConfig(t, s)
+	testGenerateProjectResources(t, config)
+
+	// Listing the resources of the APIs the fake doesn't implement fails
+	// rather than reaching GCP.
+	config.clientIAM.BasePath = s.URL() + "/iam/v1/"
+	config.clientContainerBeta.BasePath = s.URL() + "/container/v1beta1/"
+	config.clientSqlAdmin.BasePath = s.URL() + "/sql/v1beta4/"
+
+	p := Provider().(*schema.Provider)
+	p.SetMeta(config)
+	var hcl, state bytes.Buffer
+	if err := GenerateProject(p, fakeGcpProject, &hcl, &state); err != nil {
+		t.Fatal(err)
+	}
+	generated := hcl.String()
+
+	for _, expected := range []string{
+		`resource "google_compute_network" "network" {`,
+		`resource "google_compute_subnetwork" "subnetwork" {`,
+		`  network       = "${google_compute_network.network.self_link}"`,
+		`resource "google_compute_firewall" "allow-ssh" {`,
+		`resource "google_compute_instance" "instance" {`,
+		`    subnetwork         = "${google_compute_subnetwork.subnetwork.self_link}"`,
+		`resource "google_storage_bucket" "fake-bucket" {`,
+		`resource "google_project_iam_member" "viewer_user_jane" {`,
+		`  member  = "user:jane@example.com"`,
+	} {
+		if !strings.Contains(generated, expected) {
+			t.Errorf("Expected the configuration to contain %q, got:\n%s", expected, generated)
+		}
+	}
+	for _, unexpected := range []string{
+		// The boot disk is managed through the instance.
+		`resource "google_compute_disk"`,
+		// Computed attributes aren't arguments.
+		`self_link`,
+		`fingerprint`,
+	} {
+		if strings.Contains(generated, unexpected+" ") {
+			t.Errorf("Expected the configuration not to contain %q, got:\n%s", unexpected, generated)
+		}
+	}
+
+	st, err := terraform.ReadState(&state)
+	if err != nil {
+		t.Fatalf("Error reading state: %s", err)
+	}
+	deps := st.RootModule().Resources["google_compute_instance.instance"].Dependencies
+	expected := []string{"google_compute_network.network", "google_compute_subnetwork.subnetwork"}
+	if !reflect.DeepEqual(deps, expected) {
+		t.Errorf("Expected the instance to depend on %v, got %v", expected, deps)
+	}
+
+	// Applying the configuration to the state must do nothing.
+	testGenerateProjectPlan(t, s, generated, st)
+}
+
+func TestGenerateProject_resourceNames(t *testing.T) {
+	g := &projectGenerator{names: make(map[string]bool)}
+	cases := []struct {
+		name, expected string
+	}{
+		{"my-network", "my-network"},
+		{"my-network", "my-network_2"},
+		{"roles/editor_user:jane", "roles_editor_user_jane"},
+		{"1-bucket.example.com", "r_1-bucket_example_com"},
+	}
+	for _, c := range cases {
+		if name := g.uniqueName("google_compute_network", c.name); name != c.expected {
+			t.Errorf("Expected %q to be named %q, got %q", c.name, c.expected, name)
+		}
+	}
+}
+
+func testGenerateProjectResources(t *testing.T, config *Config) {
+	op, err := config.clientCompute.Networks.Insert(fakeGcpProject, &compute.Network{
+		Name:            "network",
+		ForceSendFields: []string{"AutoCreateSubnetworks"},
+	}).Do()
+	if err == nil {
+		err = computeOperationWait(config, op, fakeGcpProject, "Creating Network")
+	}
+	if err != nil {
+		t.Fatalf("Error creating network: %s", err)
+	}
+
+	op, err = config.clientCompute.Subnetworks.Insert(fakeGcpProject, fakeGcpRegion, &compute.Subnetwork{
+		Name:        "subnetwork",
+		Network:     "global/networks/network",
+		IpCidrRange: "10.0.0.0/24",
+	}).Do()
+	if err == nil {
+		err = computeOperationWait(config, op, fakeGcpProject, "Creating Subnetwork")
+	}
+	if err != nil {
+		t.Fatalf("Error creating subnetwork: %s", err)
+	}
+
+	op, err = config.clientCompute.Firewalls.Insert(fakeGcpProject, &compute.Firewall{
+		Name:    "allow-ssh",
+		Network: "global/networks/network",
+		Allowed: []*compute.FirewallAllowed{{IPProtocol: "tcp", Ports: []string{"22"}}},
+	}).Do()
+	if err == nil {
+		err = computeOperationWait(config, op, fakeGcpProject, "Creating Firewall")
+	}
+	if err != nil {
+		t.Fatalf("Error creating firewall: %s", err)
+	}
+
+	op, err = config.clientCompute.Instances.Insert(fakeGcpProject, fakeGcpZone, &compute.Instance{
+		Name:        "instance",
+		MachineType: "zones/us-central1-a/machineTypes/n1-standard-1",
+		Disks: []*compute.AttachedDisk{{
+			Boot:       true,
+			AutoDelete: true,
+			InitializeParams: &compute.AttachedDiskInitializeParams{
+				SourceImage: "projects/debian-cloud/global/images/family/debian-9",
+			},
+		}},
+		NetworkInterfaces: []*compute.NetworkInterface{{
+			Subnetwork: "regions/us-central1/subnetworks/subnetwork",
+		}},
+	}).Do()
+	if err == nil {
+		err = computeOperationWait(config, op, fakeGcpProject, "Creating Instance")
+	}
+	if err != nil {
+		t.Fatalf("Error creating instance: %s", err)
+	}
+
+	if _, err := config.clientStorage.Buckets.Insert(fakeGcpProject, &storage.Bucket{Name: "fake-bucket"}).Do(); err != nil {
+		t.Fatalf("Error creating bucket: %s", err)
+	}
+
+	_, err = config.clientResourceManager.Projects.SetIamPolicy(fakeGcpProject, &cloudresourcemanager.SetIamPolicyRequest{
+		Policy: &cloudresourcemanager.Policy{
+			Bindings: []*cloudresourcemanager.Binding{{Role: "roles/viewer", Members: []string{"user:jane@example.com"}}},
+		},
+	}).Do()
+	if err != nil {
+		t.Fatalf("Error setting IAM policy: %s", err)
+	}
+}
+
+// testGenerateProjectPlan checks that planning the configuration against
+// the generated state, as `terraform plan` would, finds no changes.
+func testGenerateProjectPlan(t *testing.T, s *fakegcp.Server, generated string, state *terraform.State) {
+	dir, err := ioutil.TempDir("", "tf-generate-project")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := testFakeGcpProviderConfig(s) + generated
+	if err := ioutil.WriteFile(filepath.Join(dir, "main.tf"), []byte(cfg), 0644); err != nil {
+		t.Fatal(err)
+	}
+	mod, err := module.NewTreeModule("", dir)
+	if err != nil {
+		t.Fatalf("Error loading configuration: %s", err)
+	}
+	if err := mod.Load(&module.Storage{StorageDir: filepath.Join(dir, ".terraform"), Mode: module.GetModeNone}); err != nil {
+		t.Fatalf("Error loading configuration: %s", err)
+	}
+
+	ctx, err := terraform.NewContext(&terraform.ContextOpts{
+		Module: mod,
+		State:  state,
+		ProviderResolver: terraform.ResourceProviderResolverFixed(map[string]terraform.ResourceProviderFactory{
+			"google": terraform.ResourceProviderFactoryFixed(Provider()),
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diags := ctx.Validate(); diags.HasErrors() {
+		t.Fatalf("Configuration is invalid: %s", diags.Err())
+	}
+	if _, err := ctx.Refresh(); err != nil {
+		t.Fatalf("Error refreshing: %s", err)
+	}
+	plan, err := ctx.Plan()
+	if err != nil {
+		t.Fatalf("Error planning: %s", err)
+	}
+	if !plan.Diff.Empty() {
+		t.Errorf("Expected an empty plan, got:\n%s", plan)
+	}
+}
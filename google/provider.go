@@ -4,9 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -15,8 +17,8 @@ var mutexKV = mutexkv.NewMutexKV()
 
 // Provider returns a terraform.ResourceProvider.
 func Provider() terraform.ResourceProvider {
-	return &schema.Provider{
-		Schema: map[string]*schema.Schema{
+	provider := &schema.Provider{
+		Schema: mergeSchemas(map[string]*schema.Schema{
 			"credentials": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -25,7 +27,41 @@ func Provider() terraform.ResourceProvider {
 					"GOOGLE_CLOUD_KEYFILE_JSON",
 					"GCLOUD_KEYFILE_JSON",
 				}, nil),
-				ValidateFunc: validateCredentials,
+				ValidateFunc:  validateCredentials,
+				ConflictsWith: []string{"access_token"},
+			},
+
+			"access_token": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"GOOGLE_OAUTH_ACCESS_TOKEN",
+				}, nil),
+				ConflictsWith: []string{"credentials"},
+			},
+
+			"scopes": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"impersonate_service_account": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"GOOGLE_IMPERSONATE_SERVICE_ACCOUNT",
+				}, nil),
+				ValidateFunc: validateRegexp(ServiceAccountEmailOrLinkRegex),
+			},
+
+			"impersonate_service_account_delegates": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateRegexp(ServiceAccountEmailOrLinkRegex),
+				},
 			},
 
 			"project": &schema.Schema{
@@ -39,6 +75,81 @@ func Provider() terraform.ResourceProvider {
 				}, nil),
 			},
 
+			"billing_project": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"GOOGLE_BILLING_PROJECT",
+				}, nil),
+			},
+
+			"default_labels": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"user_project_override": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"USER_PROJECT_OVERRIDE",
+				}, false),
+			},
+
+			"request_timeout": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDuration(),
+			},
+
+			"max_retries": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"request_rate_limits": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(customEndpointArguments(), false),
+						},
+						"qps": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validatePositiveFloat,
+						},
+					},
+				},
+			},
+
+			"batching": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"send_after": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      defaultBatchingSendAfter,
+							ValidateFunc: validateDuration(),
+						},
+						"enable_batching": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+
 			"region": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -58,7 +169,7 @@ func Provider() terraform.ResourceProvider {
 					"CLOUDSDK_COMPUTE_ZONE",
 				}, nil),
 			},
-		},
+		}, customEndpointsSchema()),
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"google_active_folder":                   dataSourceGoogleActiveFolder(),
@@ -85,6 +196,8 @@ func Provider() terraform.ResourceProvider {
 			"google_container_registry_repository":   dataSourceGoogleContainerRepo(),
 			"google_container_registry_image":        dataSourceGoogleContainerImage(),
 			"google_iam_policy":                      dataSourceGoogleIamPolicy(),
+			"google_iam_role":                        dataSourceGoogleIamRole(),
+			"google_iam_testable_permissions":        dataSourceGoogleIamTestablePermissions(),
 			"google_kms_secret":                      dataSourceGoogleKmsSecret(),
 			"google_folder":                          dataSourceGoogleFolder(),
 			"google_organization":                    dataSourceGoogleOrganization(),
@@ -93,6 +206,26 @@ func Provider() terraform.ResourceProvider {
 			"google_storage_object_signed_url":       dataSourceGoogleSignedUrl(),
 			"google_storage_project_service_account": dataSourceGoogleStorageProjectServiceAccount(),
 			"google_compute_backend_service":         dataSourceGoogleComputeBackendService(),
+
+			"google_bigquery_dataset_iam_policy":        DataSourceIamPolicy(IamBigqueryDatasetSchema, NewBigqueryDatasetIamUpdater),
+			"google_bigtable_instance_iam_policy":       DataSourceIamPolicy(IamBigtableInstanceSchema, NewBigtableInstanceIamUpdater),
+			"google_billing_account_iam_policy":         DataSourceIamPolicy(IamBillingAccountSchema, NewBillingAccountIamUpdater),
+			"google_cloudfunctions_function_iam_policy": DataSourceIamPolicy(IamCloudFunctionsFunctionSchema, NewCloudFunctionsFunctionIamUpdater),
+			"google_compute_image_iam_policy":           DataSourceIamPolicy(IamComputeImageSchema, NewComputeImageIamUpdater),
+			"google_compute_instance_iam_policy":        DataSourceIamPolicy(IamComputeInstanceSchema, NewComputeInstanceIamUpdater),
+			"google_compute_subnetwork_iam_policy":      DataSourceIamPolicy(IamComputeSubnetworkSchema, NewComputeSubnetworkIamUpdater),
+			"google_folder_iam_policy":                  DataSourceIamPolicy(IamFolderSchema, NewFolderIamUpdater),
+			"google_kms_crypto_key_iam_policy":          DataSourceIamPolicy(IamKmsCryptoKeySchema, NewKmsCryptoKeyIamUpdater),
+			"google_kms_key_ring_iam_policy":            DataSourceIamPolicy(IamKmsKeyRingSchema, NewKmsKeyRingIamUpdater),
+			"google_organization_iam_policy":            DataSourceIamPolicy(IamOrganizationSchema, NewOrganizationIamUpdater),
+			"google_project_iam_policy":                 DataSourceIamPolicy(IamProjectSchema, NewProjectIamUpdater),
+			"google_pubsub_subscription_iam_policy":     DataSourceIamPolicy(IamPubsubSubscriptionSchema, NewPubsubSubscriptionIamUpdater),
+			"google_pubsub_topic_iam_policy":            DataSourceIamPolicy(IamPubsubTopicSchema, NewPubsubTopicIamUpdater),
+			"google_service_account_iam_policy":         DataSourceIamPolicy(IamServiceAccountSchema, NewServiceAccountIamUpdater),
+			"google_sourcerepo_repository_iam_policy":   DataSourceIamPolicy(IamSourceRepoRepositorySchema, NewSourceRepoRepositoryIamUpdater),
+			"google_spanner_database_iam_policy":        DataSourceIamPolicy(IamSpannerDatabaseSchema, NewSpannerDatabaseIamUpdater),
+			"google_spanner_instance_iam_policy":        DataSourceIamPolicy(IamSpannerInstanceSchema, NewSpannerInstanceIamUpdater),
+			"google_storage_bucket_iam_policy":          DataSourceIamPolicy(IamStorageBucketSchema, NewStorageBucketIamUpdater),
 		},
 
 		ResourcesMap: mergeResourceMaps(
@@ -101,11 +234,23 @@ func Provider() terraform.ResourceProvider {
 			GeneratedResourceManagerResourcesMap,
 			map[string]*schema.Resource{
 				"google_bigquery_dataset":                      resourceBigQueryDataset(),
+				"google_bigquery_dataset_iam_binding":          ResourceIamBindingWithImport(IamBigqueryDatasetSchema, NewBigqueryDatasetIamUpdater, BigqueryDatasetIdParseFunc),
+				"google_bigquery_dataset_iam_member":           ResourceIamMemberWithImport(IamBigqueryDatasetSchema, NewBigqueryDatasetIamUpdater, BigqueryDatasetIdParseFunc),
+				"google_bigquery_dataset_iam_policy":           ResourceIamPolicyWithImport(IamBigqueryDatasetSchema, NewBigqueryDatasetIamUpdater, BigqueryDatasetIdParseFunc),
 				"google_bigquery_table":                        resourceBigQueryTable(),
 				"google_bigtable_instance":                     resourceBigtableInstance(),
+				"google_bigtable_instance_iam_binding":         ResourceIamBindingWithImport(IamBigtableInstanceSchema, NewBigtableInstanceIamUpdater, BigtableInstanceIdParseFunc),
+				"google_bigtable_instance_iam_member":          ResourceIamMemberWithImport(IamBigtableInstanceSchema, NewBigtableInstanceIamUpdater, BigtableInstanceIdParseFunc),
+				"google_bigtable_instance_iam_policy":          ResourceIamPolicyWithImport(IamBigtableInstanceSchema, NewBigtableInstanceIamUpdater, BigtableInstanceIdParseFunc),
 				"google_bigtable_table":                        resourceBigtableTable(),
+				"google_billing_account_iam_binding":           ResourceIamBindingWithImport(IamBillingAccountSchema, NewBillingAccountIamUpdater, BillingAccountIdParseFunc),
+				"google_billing_account_iam_member":            ResourceIamMemberWithImport(IamBillingAccountSchema, NewBillingAccountIamUpdater, BillingAccountIdParseFunc),
+				"google_billing_account_iam_policy":            ResourceIamPolicyWithImport(IamBillingAccountSchema, NewBillingAccountIamUpdater, BillingAccountIdParseFunc),
 				"google_cloudbuild_trigger":                    resourceCloudBuildTrigger(),
 				"google_cloudfunctions_function":               resourceCloudFunctionsFunction(),
+				"google_cloudfunctions_function_iam_binding":   ResourceIamBindingWithImport(IamCloudFunctionsFunctionSchema, NewCloudFunctionsFunctionIamUpdater, CloudFunctionsFunctionIdParseFunc),
+				"google_cloudfunctions_function_iam_member":    ResourceIamMemberWithImport(IamCloudFunctionsFunctionSchema, NewCloudFunctionsFunctionIamUpdater, CloudFunctionsFunctionIdParseFunc),
+				"google_cloudfunctions_function_iam_policy":    ResourceIamPolicyWithImport(IamCloudFunctionsFunctionSchema, NewCloudFunctionsFunctionIamUpdater, CloudFunctionsFunctionIdParseFunc),
 				"google_cloudiot_registry":                     resourceCloudIoTRegistry(),
 				"google_compute_autoscaler":                    resourceComputeAutoscaler(),
 				"google_compute_address":                       resourceComputeAddress(),
@@ -117,7 +262,13 @@ func Provider() terraform.ResourceProvider {
 				"google_compute_global_forwarding_rule":        resourceComputeGlobalForwardingRule(),
 				"google_compute_health_check":                  resourceComputeHealthCheck(),
 				"google_compute_image":                         resourceComputeImage(),
+				"google_compute_image_iam_binding":             ResourceIamBindingWithImport(IamComputeImageSchema, NewComputeImageIamUpdater, ComputeImageIdParseFunc),
+				"google_compute_image_iam_member":              ResourceIamMemberWithImport(IamComputeImageSchema, NewComputeImageIamUpdater, ComputeImageIdParseFunc),
+				"google_compute_image_iam_policy":              ResourceIamPolicyWithImport(IamComputeImageSchema, NewComputeImageIamUpdater, ComputeImageIdParseFunc),
 				"google_compute_instance":                      resourceComputeInstance(),
+				"google_compute_instance_iam_binding":          ResourceIamBindingWithImport(IamComputeInstanceSchema, NewComputeInstanceIamUpdater, ComputeInstanceIdParseFunc),
+				"google_compute_instance_iam_member":           ResourceIamMemberWithImport(IamComputeInstanceSchema, NewComputeInstanceIamUpdater, ComputeInstanceIdParseFunc),
+				"google_compute_instance_iam_policy":           ResourceIamPolicyWithImport(IamComputeInstanceSchema, NewComputeInstanceIamUpdater, ComputeInstanceIdParseFunc),
 				"google_compute_instance_group":                resourceComputeInstanceGroup(),
 				"google_compute_instance_group_manager":        resourceComputeInstanceGroupManager(),
 				"google_compute_instance_template":             resourceComputeInstanceTemplate(),
@@ -132,6 +283,7 @@ func Provider() terraform.ResourceProvider {
 				"google_compute_router":                        resourceComputeRouter(),
 				"google_compute_router_interface":              resourceComputeRouterInterface(),
 				"google_compute_router_peer":                   resourceComputeRouterPeer(),
+				"google_compute_router_nat":                    resourceComputeRouterNat(),
 				"google_compute_security_policy":               resourceComputeSecurityPolicy(),
 				"google_compute_shared_vpc_host_project":       resourceComputeSharedVpcHostProject(),
 				"google_compute_shared_vpc_service_project":    resourceComputeSharedVpcServiceProject(),
@@ -156,9 +308,10 @@ func Provider() terraform.ResourceProvider {
 				"google_dns_record_set":                        resourceDnsRecordSet(),
 				"google_endpoints_service":                     resourceEndpointsService(),
 				"google_folder":                                resourceGoogleFolder(),
+				"google_folder_iam_audit_config":               ResourceIamAuditConfigWithImport(IamFolderSchema, NewFolderIamUpdater, FolderIdParseFunc),
 				"google_folder_iam_binding":                    ResourceIamBindingWithImport(IamFolderSchema, NewFolderIamUpdater, FolderIdParseFunc),
 				"google_folder_iam_member":                     ResourceIamMemberWithImport(IamFolderSchema, NewFolderIamUpdater, FolderIdParseFunc),
-				"google_folder_iam_policy":                     ResourceIamPolicyWithImport(IamFolderSchema, NewFolderIamUpdater, FolderIdParseFunc),
+				"google_folder_iam_policy":                     ResourceIamPolicyWithImport(mergeSchemas(IamFolderSchema, IamLockoutSchema), NewFolderIamUpdater, FolderIdParseFunc),
 				"google_folder_organization_policy":            resourceGoogleFolderOrganizationPolicy(),
 				"google_logging_billing_account_sink":          resourceLoggingBillingAccountSink(),
 				"google_logging_billing_account_exclusion":     ResourceLoggingExclusion(BillingAccountLoggingExclusionSchema, NewBillingAccountLoggingExclusionUpdater, billingAccountLoggingExclusionIdParseFunc),
@@ -176,6 +329,9 @@ func Provider() terraform.ResourceProvider {
 				"google_kms_crypto_key_iam_binding":            ResourceIamBindingWithImport(IamKmsCryptoKeySchema, NewKmsCryptoKeyIamUpdater, CryptoIdParseFunc),
 				"google_kms_crypto_key_iam_member":             ResourceIamMemberWithImport(IamKmsCryptoKeySchema, NewKmsCryptoKeyIamUpdater, CryptoIdParseFunc),
 				"google_sourcerepo_repository":                 resourceSourceRepoRepository(),
+				"google_sourcerepo_repository_iam_binding":     ResourceIamBindingWithImport(IamSourceRepoRepositorySchema, NewSourceRepoRepositoryIamUpdater, SourceRepoRepositoryIdParseFunc),
+				"google_sourcerepo_repository_iam_member":      ResourceIamMemberWithImport(IamSourceRepoRepositorySchema, NewSourceRepoRepositoryIamUpdater, SourceRepoRepositoryIdParseFunc),
+				"google_sourcerepo_repository_iam_policy":      ResourceIamPolicyWithImport(IamSourceRepoRepositorySchema, NewSourceRepoRepositoryIamUpdater, SourceRepoRepositoryIdParseFunc),
 				"google_spanner_instance":                      resourceSpannerInstance(),
 				"google_spanner_instance_iam_binding":          ResourceIamBindingWithImport(IamSpannerInstanceSchema, NewSpannerInstanceIamUpdater, SpannerInstanceIdParseFunc),
 				"google_spanner_instance_iam_member":           ResourceIamMemberWithImport(IamSpannerInstanceSchema, NewSpannerInstanceIamUpdater, SpannerInstanceIdParseFunc),
@@ -187,13 +343,15 @@ func Provider() terraform.ResourceProvider {
 				"google_sql_database":                          resourceSqlDatabase(),
 				"google_sql_database_instance":                 resourceSqlDatabaseInstance(),
 				"google_sql_user":                              resourceSqlUser(),
+				"google_organization_iam_audit_config":         ResourceIamAuditConfigWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc),
 				"google_organization_iam_binding":              ResourceIamBindingWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc),
 				"google_organization_iam_custom_role":          resourceGoogleOrganizationIamCustomRole(),
 				"google_organization_iam_member":               ResourceIamMemberWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc),
-				"google_organization_iam_policy":               ResourceIamPolicyWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc),
+				"google_organization_iam_policy":               ResourceIamPolicyWithImport(mergeSchemas(IamOrganizationSchema, IamLockoutSchema), NewOrganizationIamUpdater, OrgIdParseFunc),
 				"google_organization_policy":                   resourceGoogleOrganizationPolicy(),
 				"google_project":                               resourceGoogleProject(),
 				"google_project_iam_policy":                    resourceGoogleProjectIamPolicy(),
+				"google_project_iam_audit_config":              ResourceIamAuditConfigWithImport(IamProjectSchema, NewProjectIamUpdater, ProjectIdParseFunc),
 				"google_project_iam_binding":                   ResourceIamBindingWithImport(IamProjectSchema, NewProjectIamUpdater, ProjectIdParseFunc),
 				"google_project_iam_member":                    ResourceIamMemberWithImport(IamProjectSchema, NewProjectIamUpdater, ProjectIdParseFunc),
 				"google_project_service":                       resourceGoogleProjectService(),
@@ -218,30 +376,61 @@ func Provider() terraform.ResourceProvider {
 				"google_service_account_key":                   resourceGoogleServiceAccountKey(),
 				"google_storage_bucket":                        resourceStorageBucket(),
 				"google_storage_bucket_acl":                    resourceStorageBucketAcl(),
-				// Legacy roles such as roles/storage.legacyBucketReader are automatically added
-				// when creating a bucket. For this reason, it is better not to add the authoritative
-				// google_storage_bucket_iam_policy resource.
-				"google_storage_bucket_iam_binding": ResourceIamBinding(IamStorageBucketSchema, NewStorageBucketIamUpdater),
-				"google_storage_bucket_iam_member":  ResourceIamMember(IamStorageBucketSchema, NewStorageBucketIamUpdater),
-				"google_storage_bucket_iam_policy":  ResourceIamPolicy(IamStorageBucketSchema, NewStorageBucketIamUpdater),
-				"google_storage_bucket_object":      resourceStorageBucketObject(),
-				"google_storage_object_acl":         resourceStorageObjectAcl(),
-				"google_storage_default_object_acl": resourceStorageDefaultObjectAcl(),
-				"google_storage_notification":       resourceStorageNotification(),
+				"google_storage_bucket_iam_binding":            ResourceIamBindingWithImport(IamStorageBucketSchema, NewStorageBucketIamUpdater, StorageBucketIdParseFunc),
+				"google_storage_bucket_iam_member":             ResourceIamMemberWithImport(IamStorageBucketSchema, NewStorageBucketIamUpdater, StorageBucketIdParseFunc),
+				"google_storage_bucket_iam_policy":             ResourceIamPolicyWithImport(mergeSchemas(IamStorageBucketSchema, IamStorageBucketPolicySchema), NewStorageBucketIamUpdater, StorageBucketIdParseFunc),
+				"google_storage_bucket_object":                 resourceStorageBucketObject(),
+				"google_storage_object_acl":                    resourceStorageObjectAcl(),
+				"google_storage_default_object_acl":            resourceStorageDefaultObjectAcl(),
+				"google_storage_notification":                  resourceStorageNotification(),
 			},
 		),
+	}
 
-		ConfigureFunc: providerConfigure,
+	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		return providerConfigure(d, provider)
 	}
+
+	return provider
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+func providerConfigure(d *schema.ResourceData, p *schema.Provider) (interface{}, error) {
 	credentials := d.Get("credentials").(string)
 	config := Config{
-		Credentials: credentials,
-		Project:     d.Get("project").(string),
-		Region:      d.Get("region").(string),
-		Zone:        d.Get("zone").(string),
+		context:                   p.StopContext(),
+		Credentials:               credentials,
+		AccessToken:               d.Get("access_token").(string),
+		ImpersonateServiceAccount: d.Get("impersonate_service_account").(string),
+		Project:                   d.Get("project").(string),
+		BillingProject:            d.Get("billing_project").(string),
+		UserProjectOverride:       d.Get("user_project_override").(bool),
+		DefaultLabels:             expandStringMap(d, "default_labels"),
+		Region:                    d.Get("region").(string),
+		Zone:                      d.Get("zone").(string),
+		CustomEndpoints:           expandCustomEndpoints(d),
+		BigtableCustomEndpoint:    d.Get("bigtable_custom_endpoint").(string),
+		MaxRetries:                d.Get("max_retries").(int),
+	}
+
+	if v, ok := d.GetOk("request_timeout"); ok {
+		// The duration has already been validated.
+		config.RequestTimeout, _ = time.ParseDuration(v.(string))
+	}
+
+	config.BatchingSendAfter = expandBatchingSendAfter(d)
+
+	rateLimits, err := expandRequestRateLimits(d)
+	if err != nil {
+		return nil, err
+	}
+	config.RequestRateLimits = rateLimits
+
+	for _, scope := range d.Get("scopes").([]interface{}) {
+		config.Scopes = append(config.Scopes, scope.(string))
+	}
+
+	for _, delegate := range d.Get("impersonate_service_account_delegates").([]interface{}) {
+		config.ImpersonateServiceAccountDelegates = append(config.ImpersonateServiceAccountDelegates, delegate.(string))
 	}
 
 	if err := config.loadAndValidate(); err != nil {
@@ -251,6 +440,26 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	return &config, nil
 }
 
+// Modifications of the same IAM policy are batched by default, for as long as
+// it takes Terraform to walk a few resources in parallel.
+const defaultBatchingSendAfter = "3s"
+
+// expandBatchingSendAfter returns how long IAM policy modifications are
+// batched for, zero if batching is disabled.
+func expandBatchingSendAfter(d *schema.ResourceData) time.Duration {
+	sendAfter := defaultBatchingSendAfter
+	if v, ok := d.GetOk("batching.0"); ok {
+		batching := v.(map[string]interface{})
+		if !batching["enable_batching"].(bool) {
+			return 0
+		}
+		sendAfter = batching["send_after"].(string)
+	}
+	// The duration has already been validated.
+	duration, _ := time.ParseDuration(sendAfter)
+	return duration
+}
+
 func validateCredentials(v interface{}, k string) (warnings []string, errors []error) {
 	if v == nil || v.(string) == "" {
 		return
@@ -264,6 +473,10 @@ func validateCredentials(v interface{}, k string) (warnings []string, errors []e
 	if err := json.Unmarshal([]byte(creds), &account); err != nil {
 		errors = append(errors,
 			fmt.Errorf("credentials are not valid JSON '%s': %s", creds, err))
+		return
+	}
+	if err := account.validateType(); err != nil {
+		errors = append(errors, err)
 	}
 
 	return
@@ -75,7 +75,8 @@ func testAccPreCheck(t *testing.T) {
 		os.Setenv("GOOGLE_CREDENTIALS", string(creds))
 	}
 
-	if v := multiEnvSearch(credsEnvVars); v == "" {
+	// Replayed tests don't send requests to GCP.
+	if v := multiEnvSearch(credsEnvVars); v == "" && !vcrReplaying() {
 		t.Fatalf("One of %s must be set for acceptance tests", strings.Join(credsEnvVars, ", "))
 	}
 
@@ -86,6 +87,8 @@ func testAccPreCheck(t *testing.T) {
 	if v := multiEnvSearch(regionEnvVars); v != "us-central1" {
 		t.Fatalf("One of %s must be set to us-central1 for acceptance tests", strings.Join(regionEnvVars, ", "))
 	}
+
+	vcrStart(t)
 }
 
 func TestProvider_getRegionFromZone(t *testing.T) {
@@ -120,6 +123,13 @@ func TestProvider_loadCredentialsFromJSON(t *testing.T) {
 	}
 }
 
+func TestProvider_loadCredentialsUnsupportedType(t *testing.T) {
+	_, es := validateCredentials(`{"type": "gdch_service_account"}`, "")
+	if len(es) != 1 {
+		t.Fatalf("Expected 1 error, got %v", es)
+	}
+}
+
 // getTestRegion has the same logic as the provider's getRegion, to be used in tests.
 func getTestRegion(is *terraform.InstanceState, config *Config) (string, error) {
 	if res, ok := is.Attributes["region"]; ok {
@@ -0,0 +1,144 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// expandRequestRateLimits reads the `request_rate_limits` provider argument,
+// keyed by API.
+func expandRequestRateLimits(d *schema.ResourceData) (map[string]float64, error) {
+	limits := make(map[string]float64)
+	for _, raw := range d.Get("request_rate_limits").([]interface{}) {
+		limit := raw.(map[string]interface{})
+		service := limit["service"].(string)
+		if _, ok := limits[service]; ok {
+			return nil, fmt.Errorf("request_rate_limits: more than one limit for service %q", service)
+		}
+		limits[service] = limit["qps"].(float64)
+	}
+	return limits, nil
+}
+
+// rateLimiter is a token bucket allowing qps requests per second on average,
+// and bursts of up to burst requests.
+type rateLimiter struct {
+	mu     sync.Mutex
+	qps    float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+func newRateLimiter(qps float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		qps:    qps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+}
+
+// reserve takes a token from the bucket and returns how long to wait before
+// using it. Tokens may be taken before they are available, so that waiters
+// are served in order.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.qps)
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.qps * float64(time.Second))
+}
+
+// Wait blocks until a token is available or ctx is done.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	wait := l.reserve()
+	if wait == 0 {
+		return nil
+	}
+
+	t := time.NewTimer(wait)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+var rateLimitVersionRegex = regexp.MustCompile("(v[0-9]+[a-z0-9]*|alpha|beta)/$")
+
+// rateLimitPrefix returns the prefix shared by the URLs of every version of
+// the API at basePath, e.g. `https://www.googleapis.com/compute/` for both
+// the `v1` and `beta` versions of Compute.
+func rateLimitPrefix(basePath string) string {
+	return rateLimitVersionRegex.ReplaceAllString(basePath, "")
+}
+
+type prefixRateLimiter struct {
+	prefix  string
+	limiter *rateLimiter
+}
+
+// rateLimitTransport delays requests so that each API stays under the rate
+// configured for it by `request_rate_limits`. APIs are identified by the
+// prefix of their URLs, which covers every version of an API.
+type rateLimitTransport struct {
+	base     http.RoundTripper
+	limiters []prefixRateLimiter
+}
+
+func newRateLimitTransport(base http.RoundTripper, limiters map[string]*rateLimiter) *rateLimitTransport {
+	t := &rateLimitTransport{base: base}
+	for prefix, l := range limiters {
+		t.limiters = append(t.limiters, prefixRateLimiter{prefix, l})
+	}
+	// The most specific prefix applies.
+	sort.Slice(t.limiters, func(i, j int) bool {
+		return len(t.limiters[i].prefix) > len(t.limiters[j].prefix)
+	})
+	return t
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	url := req.URL.String()
+	for _, l := range t.limiters {
+		if !strings.HasPrefix(url, l.prefix) {
+			continue
+		}
+		if wait := l.limiter.reserve(); wait > 0 {
+			log.Printf("[DEBUG] Delaying %s %s by %s to stay under the rate limit of %s", req.Method, req.URL, wait, l.prefix)
+			select {
+			case <-req.Context().Done():
+				return nil, req.Context().Err()
+			case <-time.After(wait):
+			}
+		}
+		break
+	}
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,111 @@
+package google
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Now()
+	l := newRateLimiter(2, 2)
+	l.now = func() time.Time { return now }
+
+	expected := []time.Duration{0, 0, 500 * time.Millisecond, time.Second}
+	for i, e := range expected {
+		if wait := l.reserve(); wait != e {
+			t.Errorf("expected request %d to wait %s, got %s", i, e, wait)
+		}
+	}
+
+	// The bucket refills at qps, up to burst tokens.
+	now = now.Add(time.Minute)
+	expected = []time.Duration{0, 0, 500 * time.Millisecond}
+	for i, e := range expected {
+		if wait := l.reserve(); wait != e {
+			t.Errorf("expected request %d after a minute to wait %s, got %s", i, e, wait)
+		}
+	}
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	limited := newRateLimiter(1, 1)
+	client := &http.Client{
+		Transport: newRateLimitTransport(http.DefaultTransport, map[string]*rateLimiter{
+			server.URL + "/compute/":     limited,
+			server.URL + "/compute/v1/x": newRateLimiter(1000, 1000),
+		}),
+	}
+
+	for _, path := range []string{"/compute/beta/a", "/storage/v1/b", "/compute/v1/x/c"} {
+		res, err := client.Get(server.URL + path)
+		if err != nil {
+			t.Fatalf("error: %s", err)
+		}
+		res.Body.Close()
+	}
+
+	// Only the first request used the limiter of its API, so the next
+	// request must wait.
+	if wait := limited.reserve(); wait <= 0 {
+		t.Errorf("expected the request to the API to be rate limited")
+	}
+}
+
+func TestConfigRequestRateLimiters(t *testing.T) {
+	cases := map[string]struct {
+		Limits          map[string]float64
+		CustomEndpoints map[string]string
+		ExpectedQps     map[string]float64
+		ExpectError     bool
+	}{
+		"versions of an API share their limiter": {
+			Limits: map[string]float64{"compute": 10, "compute_beta": 10, "storage": 5},
+			ExpectedQps: map[string]float64{
+				"https://www.googleapis.com/compute/": 10,
+				"https://www.googleapis.com/storage/": 5,
+			},
+		},
+		"versions of an API with different rates": {
+			Limits:      map[string]float64{"compute": 10, "compute_beta": 5},
+			ExpectError: true,
+		},
+		"versions of an API at different endpoints": {
+			Limits:          map[string]float64{"compute": 10, "compute_beta": 5},
+			CustomEndpoints: map[string]string{ComputeBetaBasePathKey: "https://beta.example.com/compute/beta/"},
+			ExpectedQps: map[string]float64{
+				"https://www.googleapis.com/compute/": 10,
+				"https://beta.example.com/compute/":   5,
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		config := &Config{RequestRateLimits: tc.Limits, CustomEndpoints: tc.CustomEndpoints}
+		limiters, err := config.requestRateLimiters()
+		if tc.ExpectError {
+			if err == nil {
+				t.Errorf("bad: %s; expected an error", tn)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("bad: %s; unexpected error %s", tn, err)
+			continue
+		}
+		qps := make(map[string]float64)
+		for prefix, l := range limiters {
+			qps[prefix] = l.qps
+		}
+		if !reflect.DeepEqual(qps, tc.ExpectedQps) {
+			t.Errorf("bad: %s; expected limits %v, got %v", tn, tc.ExpectedQps, qps)
+		}
+	}
+}
@@ -1,64 +1,31 @@
 package google
 
 import (
-	"fmt"
-	"log"
 	"time"
 
-	"github.com/hashicorp/terraform/helper/resource"
 	"google.golang.org/api/redis/v1beta1"
 )
 
 type RedisOperationWaiter struct {
 	Service *redis.ProjectsLocationsService
-	Op      *redis.Operation
+	CommonOperationWaiter
 }
 
-func (w *RedisOperationWaiter) RefreshFunc() resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		op, err := w.Service.Operations.Get(w.Op.Name).Do()
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		log.Printf("[DEBUG] Got %v while polling for operation %s's 'done' status", op.Done, w.Op.Name)
-
-		return op, fmt.Sprint(op.Done), nil
-	}
-}
-
-func (w *RedisOperationWaiter) Conf() *resource.StateChangeConf {
-	return &resource.StateChangeConf{
-		Pending: []string{"false"},
-		Target:  []string{"true"},
-		Refresh: w.RefreshFunc(),
-	}
+func (w *RedisOperationWaiter) QueryOp() (interface{}, error) {
+	return w.Service.Operations.Get(w.Op.Name).Do()
 }
 
-func redisOperationWait(service *redis.Service, op *redis.Operation, project, activity string) error {
-	return redisOperationWaitTime(service, op, project, activity, 4)
+func redisOperationWait(config *Config, op *redis.Operation, project, activity string) error {
+	return redisOperationWaitTime(config, op, project, activity, 4*time.Minute)
 }
 
-func redisOperationWaitTime(service *redis.Service, op *redis.Operation, project, activity string, timeoutMin int) error {
+func redisOperationWaitTime(config *Config, op *redis.Operation, project, activity string, timeout time.Duration) error {
 	w := &RedisOperationWaiter{
-		Service: service.Projects.Locations,
-		Op:      op,
+		Service: config.clientRedis.Projects.Locations,
 	}
-
-	state := w.Conf()
-	state.Delay = 10 * time.Second
-	state.Timeout = time.Duration(timeoutMin) * time.Minute
-	state.MinTimeout = 2 * time.Second
-	opRaw, err := state.WaitForState()
-	if err != nil {
-		return fmt.Errorf("Error waiting for %s: %s", activity, err)
-	}
-
-	op = opRaw.(*redis.Operation)
-	if op.Error != nil {
-		return fmt.Errorf("Error code %v, message: %s", op.Error.Code, op.Error.Message)
+	if err := w.SetOp(op); err != nil {
+		return err
 	}
 
-	return nil
+	return OperationWait(config, w, activity, timeout)
 }
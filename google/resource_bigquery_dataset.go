@@ -17,6 +17,9 @@ func resourceBigQueryDataset() *schema.Resource {
 		Read:   resourceBigQueryDatasetRead,
 		Update: resourceBigQueryDatasetUpdate,
 		Delete: resourceBigQueryDatasetDelete,
+
+		CustomizeDiff: effectiveLabelsCustomizeDiff(false),
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -107,6 +110,8 @@ func resourceBigQueryDataset() *schema.Resource {
 				Elem:     schema.TypeString,
 			},
 
+			"effective_labels": effectiveLabelsSchema(),
+
 			// SelfLink: [Output-only] A URL that can be used to access the resource
 			// again. You can use this URL in Get or Update requests to the
 			// resource.
@@ -169,15 +174,7 @@ func resourceDataset(d *schema.ResourceData, meta interface{}) (*bigquery.Datase
 		dataset.DefaultTableExpirationMs = int64(v.(int))
 	}
 
-	if v, ok := d.GetOk("labels"); ok {
-		labels := map[string]string{}
-
-		for k, v := range v.(map[string]interface{}) {
-			labels[k] = v.(string)
-		}
-
-		dataset.Labels = labels
-	}
+	dataset.Labels = expandLabels(d, config)
 
 	return dataset, nil
 }
@@ -229,7 +226,9 @@ func resourceBigQueryDatasetRead(d *schema.ResourceData, meta interface{}) error
 
 	d.Set("project", projectID)
 	d.Set("etag", res.Etag)
-	d.Set("labels", res.Labels)
+	if err := setLabels(d, config, res.Labels); err != nil {
+		return fmt.Errorf("Error reading labels: %s", err)
+	}
 	d.Set("self_link", res.SelfLink)
 	d.Set("description", res.Description)
 	d.Set("friendly_name", res.FriendlyName)
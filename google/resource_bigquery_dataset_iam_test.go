@@ -0,0 +1,205 @@
+package google
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestBigqueryAccessToIamPolicy(t *testing.T) {
+	t.Parallel()
+
+	access := []interface{}{
+		map[string]interface{}{"role": "OWNER", "specialGroup": "projectOwners"},
+		map[string]interface{}{"role": "READER", "userByEmail": "jane@example.com"},
+		map[string]interface{}{"role": "READER", "userByEmail": "sa@my-project.iam.gserviceaccount.com"},
+		map[string]interface{}{"role": "WRITER", "groupByEmail": "team@example.com"},
+		map[string]interface{}{"role": "roles/bigquery.metadataViewer", "domain": "example.com"},
+		map[string]interface{}{"view": map[string]interface{}{"projectId": "p", "datasetId": "d", "tableId": "t"}},
+	}
+	expected := &IamPolicy{
+		Bindings: []*IamBinding{
+			{Role: "roles/bigquery.dataOwner", Members: []string{"projectOwners"}},
+			{Role: "roles/bigquery.dataViewer", Members: []string{"user:jane@example.com", "serviceAccount:sa@my-project.iam.gserviceaccount.com"}},
+			{Role: "roles/bigquery.dataEditor", Members: []string{"group:team@example.com"}},
+			{Role: "roles/bigquery.metadataViewer", Members: []string{"domain:example.com"}},
+		},
+	}
+
+	policy, err := bigqueryAccessToIamPolicy(access)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(policy, expected) {
+		t.Fatalf("expected policy %+v, got %+v", expected.Bindings, policy.Bindings)
+	}
+
+	// The views aren't part of the policy, and are left out of its access.
+	roundTrip, err := iamPolicyToBigqueryAccess(policy)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(roundTrip, access[:len(access)-1]) {
+		t.Fatalf("expected access %v, got %v", access[:len(access)-1], roundTrip)
+	}
+
+	policy.Bindings[0].Condition = &IamCondition{Title: "expires", Expression: `request.time < timestamp("2020-01-01T00:00:00Z")`}
+	if _, err := iamPolicyToBigqueryAccess(policy); err == nil {
+		t.Fatalf("expected an error for a conditional binding")
+	}
+}
+
+func TestBigqueryDatasetIamUpdater_etag(t *testing.T) {
+	t.Parallel()
+
+	var ifMatch string
+	etag := "v2"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "PATCH" {
+			ifMatch = r.Header.Get("If-Match")
+			if ifMatch != etag {
+				w.WriteHeader(http.StatusPreconditionFailed)
+				w.Write([]byte(`{"error": {"code": 412, "message": "Precondition check failed."}}`))
+				return
+			}
+			etag = "v3"
+		}
+		fmt.Fprintf(w, `{"etag": %q, "access": [{"role": "OWNER", "specialGroup": "projectOwners"}]}`, etag)
+	}))
+	defer server.Close()
+
+	u := &BigqueryDatasetIamUpdater{
+		project:   "my-project",
+		datasetId: "my_dataset",
+		Config: &Config{
+			client:          server.Client(),
+			CustomEndpoints: map[string]string{BigQueryBasePathKey: server.URL + "/"},
+		},
+	}
+
+	// A policy read before the dataset changed is rejected, and read again.
+	err := u.SetResourceIamPolicy(&IamPolicy{Etag: "v1"})
+	if err == nil {
+		t.Fatalf("expected an error for a stale etag")
+	}
+	if ok, _ := isConcurrentPolicyModificationError(err); !ok {
+		t.Fatalf("expected a concurrent modification error, got %s", err)
+	}
+
+	policy, err := u.GetResourceIamPolicy()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := u.SetResourceIamPolicy(policy); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if ifMatch != "v2" {
+		t.Fatalf("expected If-Match %q, got %q", "v2", ifMatch)
+	}
+
+	// Without an etag, the policy must apply to the dataset it was merged with.
+	if err := u.SetResourceIamPolicy(&IamPolicy{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if ifMatch != "v3" {
+		t.Fatalf("expected If-Match %q, got %q", "v3", ifMatch)
+	}
+}
+
+func TestAccBigqueryDatasetIamBinding(t *testing.T) {
+	t.Parallel()
+
+	dataset := "tf_test_" + randString(t, 10)
+	account := randomWithPrefix(t, "tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigqueryDatasetIamBinding_basic(dataset, account),
+				Check: testAccCheckIamUpdaterBinding(func(config *Config) ResourceIamUpdater {
+					return &BigqueryDatasetIamUpdater{project: getTestProjectFromEnv(), datasetId: dataset, Config: config}
+				}, "roles/bigquery.dataViewer", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+			{
+				ResourceName:      "google_bigquery_dataset_iam_binding.foo",
+				ImportStateId:     fmt.Sprintf("%s roles/bigquery.dataViewer", fmt.Sprintf("projects/%s/datasets/%s", getTestProjectFromEnv(), dataset)),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBigqueryDatasetIamMember(t *testing.T) {
+	t.Parallel()
+
+	dataset := "tf_test_" + randString(t, 10)
+	account := randomWithPrefix(t, "tf-test")
+	accountEmail := fmt.Sprintf("%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigqueryDatasetIamMember_basic(dataset, account),
+				Check: testAccCheckIamUpdaterBinding(func(config *Config) ResourceIamUpdater {
+					return &BigqueryDatasetIamUpdater{project: getTestProjectFromEnv(), datasetId: dataset, Config: config}
+				}, "roles/bigquery.dataViewer", []string{"serviceAccount:" + accountEmail}),
+			},
+			{
+				ResourceName:      "google_bigquery_dataset_iam_member.foo",
+				ImportStateId:     fmt.Sprintf("%s roles/bigquery.dataViewer serviceAccount:%s", fmt.Sprintf("projects/%s/datasets/%s", getTestProjectFromEnv(), dataset), accountEmail),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBigqueryDatasetIamBinding_basic(dataset, account string) string {
+	return fmt.Sprintf(`
+resource "google_bigquery_dataset" "dataset" {
+  dataset_id = "%s"
+}
+
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_bigquery_dataset_iam_binding" "foo" {
+  dataset_id = "${google_bigquery_dataset.dataset.dataset_id}"
+  role    = "roles/bigquery.dataViewer"
+  members = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, dataset, account)
+}
+
+func testAccBigqueryDatasetIamMember_basic(dataset, account string) string {
+	return fmt.Sprintf(`
+resource "google_bigquery_dataset" "dataset" {
+  dataset_id = "%s"
+}
+
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_bigquery_dataset_iam_member" "foo" {
+  dataset_id = "${google_bigquery_dataset.dataset.dataset_id}"
+  role   = "roles/bigquery.dataViewer"
+  member = "serviceAccount:${google_service_account.test-account.email}"
+}
+`, dataset, account)
+}
@@ -18,6 +18,9 @@ func resourceBigQueryTable() *schema.Resource {
 		Read:   resourceBigQueryTableRead,
 		Delete: resourceBigQueryTableDelete,
 		Update: resourceBigQueryTableUpdate,
+
+		CustomizeDiff: effectiveLabelsCustomizeDiff(false),
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -81,6 +84,8 @@ func resourceBigQueryTable() *schema.Resource {
 				Elem:     schema.TypeString,
 			},
 
+			"effective_labels": effectiveLabelsSchema(),
+
 			// Schema: [Optional] Describes the schema of this table.
 			"schema": {
 				Type:         schema.TypeString,
@@ -254,15 +259,7 @@ func resourceTable(d *schema.ResourceData, meta interface{}) (*bigquery.Table, e
 		table.FriendlyName = v.(string)
 	}
 
-	if v, ok := d.GetOk("labels"); ok {
-		labels := map[string]string{}
-
-		for k, v := range v.(map[string]interface{}) {
-			labels[k] = v.(string)
-		}
-
-		table.Labels = labels
-	}
+	table.Labels = expandLabels(d, config)
 
 	if v, ok := d.GetOk("schema"); ok {
 		schema, err := expandSchema(v)
@@ -328,7 +325,9 @@ func resourceBigQueryTableRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("description", res.Description)
 	d.Set("expiration_time", res.ExpirationTime)
 	d.Set("friendly_name", res.FriendlyName)
-	d.Set("labels", res.Labels)
+	if err := setLabels(d, config, res.Labels); err != nil {
+		return fmt.Errorf("Error reading labels: %s", err)
+	}
 	d.Set("creation_time", res.CreationTime)
 	d.Set("etag", res.Etag)
 	d.Set("last_modified_time", res.LastModifiedTime)
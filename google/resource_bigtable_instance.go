@@ -16,6 +16,9 @@ func resourceBigtableInstance() *schema.Resource {
 		Create: resourceBigtableInstanceCreate,
 		Read:   resourceBigtableInstanceRead,
 		Delete: resourceBigtableInstanceDestroy,
+		Importer: &schema.ResourceImporter{
+			State: resourceBigtableInstanceImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -206,3 +209,51 @@ func resourceBigtableInstanceDestroy(d *schema.ResourceData, meta interface{}) e
 
 	return nil
 }
+
+func resourceBigtableInstanceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/instances/(?P<name>[^/]+)", "(?P<project>[^/]+)/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	// The instance's cluster isn't available through the gRPC client, nor
+	// read by resourceBigtableInstanceRead, so read it over REST.
+	url, err := replaceVars(d, config, "{{BigtableAdminBasePath}}projects/{{project}}/instances/{{name}}")
+	if err != nil {
+		return nil, err
+	}
+	instance, err := Get(config, url, d.Get("project").(string))
+	if err != nil {
+		return nil, fmt.Errorf("Error reading Bigtable instance %q: %s", d.Id(), err)
+	}
+	res, err := Get(config, url+"/clusters", d.Get("project").(string))
+	if err != nil {
+		return nil, fmt.Errorf("Error reading clusters of Bigtable instance %q: %s", d.Id(), err)
+	}
+	clusters, _ := res["clusters"].([]interface{})
+	if len(clusters) != 1 {
+		return nil, fmt.Errorf("Bigtable instance %q has %d clusters, only instances with a single cluster can be imported", d.Id(), len(clusters))
+	}
+	cluster := clusters[0].(map[string]interface{})
+
+	instanceType, _ := instance["type"].(string)
+	d.Set("instance_type", instanceType)
+	d.Set("cluster_id", GetResourceNameFromSelfLink(cluster["name"].(string)))
+	d.Set("zone", GetResourceNameFromSelfLink(cluster["location"].(string)))
+	d.Set("storage_type", cluster["defaultStorageType"])
+	if instanceType == "PRODUCTION" {
+		// serveNodes is a number, which JSON decodes as a float64.
+		if nodes, ok := cluster["serveNodes"].(float64); ok {
+			d.Set("num_nodes", int(nodes))
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,107 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccBigtableInstanceIamBinding(t *testing.T) {
+	t.Parallel()
+
+	instance := "tf-test-" + randString(t, 10)
+	account := randomWithPrefix(t, "tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigtableInstanceIamBinding_basic(instance, account),
+				Check: testAccCheckIamUpdaterBinding(func(config *Config) ResourceIamUpdater {
+					return &BigtableInstanceIamUpdater{project: getTestProjectFromEnv(), instance: instance, Config: config}
+				}, "roles/bigtable.user", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+			{
+				ResourceName:      "google_bigtable_instance_iam_binding.foo",
+				ImportStateId:     fmt.Sprintf("%s roles/bigtable.user", fmt.Sprintf("projects/%s/instances/%s", getTestProjectFromEnv(), instance)),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBigtableInstanceIamMember(t *testing.T) {
+	t.Parallel()
+
+	instance := "tf-test-" + randString(t, 10)
+	account := randomWithPrefix(t, "tf-test")
+	accountEmail := fmt.Sprintf("%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigtableInstanceIamMember_basic(instance, account),
+				Check: testAccCheckIamUpdaterBinding(func(config *Config) ResourceIamUpdater {
+					return &BigtableInstanceIamUpdater{project: getTestProjectFromEnv(), instance: instance, Config: config}
+				}, "roles/bigtable.user", []string{"serviceAccount:" + accountEmail}),
+			},
+			{
+				ResourceName:      "google_bigtable_instance_iam_member.foo",
+				ImportStateId:     fmt.Sprintf("%s roles/bigtable.user serviceAccount:%s", fmt.Sprintf("projects/%s/instances/%s", getTestProjectFromEnv(), instance), accountEmail),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBigtableInstanceIamBinding_basic(instance, account string) string {
+	return fmt.Sprintf(`
+resource "google_bigtable_instance" "instance" {
+  name          = "%s"
+  cluster_id    = "%s"
+  zone          = "us-central1-b"
+  instance_type = "DEVELOPMENT"
+}
+
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_bigtable_instance_iam_binding" "foo" {
+  instance = "${google_bigtable_instance.instance.name}"
+  role    = "roles/bigtable.user"
+  members = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, instance, instance, account)
+}
+
+func testAccBigtableInstanceIamMember_basic(instance, account string) string {
+	return fmt.Sprintf(`
+resource "google_bigtable_instance" "instance" {
+  name          = "%s"
+  cluster_id    = "%s"
+  zone          = "us-central1-b"
+  instance_type = "DEVELOPMENT"
+}
+
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_bigtable_instance_iam_member" "foo" {
+  instance = "${google_bigtable_instance.instance.name}"
+  role   = "roles/bigtable.user"
+  member = "serviceAccount:${google_service_account.test-account.email}"
+}
+`, instance, instance, account)
+}
@@ -3,17 +3,50 @@ package google
 import (
 	"context"
 	"fmt"
+	"log"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	// Tables are deleted along with their instance.
+	addTestSweeper("gcp_bigtable_instance", testSweepBigtableInstances)
+}
+
+func testSweepBigtableInstances(region string) error {
+	config, err := sweeperConfig(region)
+	if err != nil {
+		return err
+	}
+
+	c, err := config.bigtableClientFactory.NewInstanceAdminClient(config.Project)
+	if err != nil {
+		return fmt.Errorf("error starting instance admin client: %s", err)
+	}
+	defer c.Close()
+
+	instances, err := c.Instances(context.Background())
+	if err != nil {
+		return fmt.Errorf("error listing Bigtable instances: %s", err)
+	}
+	for _, instance := range instances {
+		if !isSweepableTestResource(instance.Name) {
+			continue
+		}
+		log.Printf("[INFO] Sweeping Bigtable instance %s", instance.Name)
+		if err := c.DeleteInstance(context.Background(), instance.Name); err != nil {
+			log.Printf("[INFO] Error deleting Bigtable instance %s: %s", instance.Name, err)
+		}
+	}
+	return nil
+}
+
 func TestAccBigtableInstance_basic(t *testing.T) {
 	t.Parallel()
 
-	instanceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	instanceName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -27,6 +60,11 @@ func TestAccBigtableInstance_basic(t *testing.T) {
 						"google_bigtable_instance.instance"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "google_bigtable_instance.instance",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -34,7 +72,7 @@ func TestAccBigtableInstance_basic(t *testing.T) {
 func TestAccBigtableInstance_development(t *testing.T) {
 	t.Parallel()
 
-	instanceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	instanceName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -14,6 +14,9 @@ func resourceBigtableTable() *schema.Resource {
 		Create: resourceBigtableTableCreate,
 		Read:   resourceBigtableTableRead,
 		Delete: resourceBigtableTableDestroy,
+		Importer: &schema.ResourceImporter{
+			State: resourceBigtableTableImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -111,6 +114,7 @@ func resourceBigtableTableRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("project", project)
+	d.Set("name", name)
 
 	return nil
 }
@@ -142,3 +146,19 @@ func resourceBigtableTableDestroy(d *schema.ResourceData, meta interface{}) erro
 
 	return nil
 }
+
+func resourceBigtableTableImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/instances/(?P<instance_name>[^/]+)/tables/(?P<name>[^/]+)", "(?P<project>[^/]+)/(?P<instance_name>[^/]+)/(?P<name>[^/]+)", "(?P<instance_name>[^/]+)/(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,51 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccBillingAccountIamMember(t *testing.T) {
+	t.Parallel()
+
+	billing := getTestBillingAccountFromEnv(t)
+	account := randomWithPrefix(t, "tf-test")
+	role := "roles/billing.viewer"
+	accountEmail := fmt.Sprintf("%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBillingAccountIamMember_basic(billing, account, role),
+				Check: testAccCheckIamUpdaterBinding(func(config *Config) ResourceIamUpdater {
+					return &BillingAccountIamUpdater{billingAccountId: billing, Config: config}
+				}, role, []string{"serviceAccount:" + accountEmail}),
+			},
+			{
+				ResourceName:      "google_billing_account_iam_member.foo",
+				ImportStateId:     fmt.Sprintf("%s %s serviceAccount:%s", billing, role, accountEmail),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBillingAccountIamMember_basic(billing, account, role string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_billing_account_iam_member" "foo" {
+  billing_account_id = "%s"
+  role               = "%s"
+  member             = "serviceAccount:${google_service_account.test-account.email}"
+}
+`, account, billing, role)
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -12,7 +11,7 @@ import (
 func TestAccCloudBuildTrigger_basic(t *testing.T) {
 	t.Parallel()
 
-	projectID := "terraform-" + acctest.RandString(10)
+	projectID := "terraform-" + randString(t, 10)
 	projectOrg := getTestOrgFromEnv(t)
 	projectBillingAccount := getTestBillingAccountFromEnv(t)
 
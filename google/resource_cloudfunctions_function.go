@@ -82,6 +82,8 @@ func resourceCloudFunctionsFunction() *schema.Resource {
 		Update: resourceCloudFunctionsUpdate,
 		Delete: resourceCloudFunctionsDestroy,
 
+		CustomizeDiff: effectiveLabelsCustomizeDiff(false),
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -170,6 +172,8 @@ func resourceCloudFunctionsFunction() *schema.Resource {
 				Optional: true,
 			},
 
+			"effective_labels": effectiveLabelsSchema(),
+
 			"trigger_bucket": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -316,9 +320,7 @@ func resourceCloudFunctionsCreate(d *schema.ResourceData, meta interface{}) erro
 			"You must specify a trigger when deploying a new function.")
 	}
 
-	if _, ok := d.GetOk("labels"); ok {
-		function.Labels = expandLabels(d)
-	}
+	function.Labels = expandLabels(d, config)
 
 	log.Printf("[DEBUG] Creating cloud function: %s", function.Name)
 	op, err := config.clientCloudFunctions.Projects.Locations.Functions.Create(
@@ -330,7 +332,7 @@ func resourceCloudFunctionsCreate(d *schema.ResourceData, meta interface{}) erro
 	// Name of function should be unique
 	d.SetId(cloudFuncId.terraformId())
 
-	err = cloudFunctionsOperationWait(config.clientCloudFunctions, op, "Creating CloudFunctions Function")
+	err = cloudFunctionsOperationWait(config, op, "Creating CloudFunctions Function")
 	if err != nil {
 		return err
 	}
@@ -361,7 +363,9 @@ func resourceCloudFunctionsRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 	d.Set("timeout", timeout)
-	d.Set("labels", function.Labels)
+	if err := setLabels(d, config, function.Labels); err != nil {
+		return fmt.Errorf("Error reading labels: %s", err)
+	}
 	if function.SourceArchiveUrl != "" {
 		sourceArr := strings.Split(function.SourceArchiveUrl, "/")
 		d.Set("source_archive_bucket", sourceArr[2])
@@ -422,8 +426,8 @@ func resourceCloudFunctionsUpdate(d *schema.ResourceData, meta interface{}) erro
 		updateMaskArr = append(updateMaskArr, "timeout")
 	}
 
-	if d.HasChange("labels") {
-		function.Labels = expandLabels(d)
+	if hasLabelsChange(d) {
+		function.Labels = expandLabels(d, config)
 		updateMaskArr = append(updateMaskArr, "labels")
 	}
 
@@ -449,7 +453,7 @@ func resourceCloudFunctionsUpdate(d *schema.ResourceData, meta interface{}) erro
 			return fmt.Errorf("Error while updating cloudfunction configuration: %s", err)
 		}
 
-		err = cloudFunctionsOperationWait(config.clientCloudFunctions, op,
+		err = cloudFunctionsOperationWait(config, op,
 			"Updating CloudFunctions Function")
 		if err != nil {
 			return err
@@ -472,7 +476,7 @@ func resourceCloudFunctionsDestroy(d *schema.ResourceData, meta interface{}) err
 	if err != nil {
 		return err
 	}
-	err = cloudFunctionsOperationWait(config.clientCloudFunctions, op, "Deleting CloudFunctions Function")
+	err = cloudFunctionsOperationWait(config, op, "Deleting CloudFunctions Function")
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,123 @@
+package google
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccCloudFunctionsFunctionIamBinding(t *testing.T) {
+	t.Parallel()
+
+	functionName := "tf-test-" + randString(t, 10)
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", randInt(t))
+	account := randomWithPrefix(t, "tf-test")
+	zipFilePath, err := createZIPArchiveForIndexJs(testHTTPTriggerPath)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer os.Remove(zipFilePath) // clean up
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudFunctionsFunctionIamBinding_basic(functionName, bucketName, zipFilePath, account),
+				Check: testAccCheckIamUpdaterBinding(func(config *Config) ResourceIamUpdater {
+					return &CloudFunctionsFunctionIamUpdater{project: getTestProjectFromEnv(), region: getTestRegionFromEnv(), function: functionName, Config: config}
+				}, "roles/cloudfunctions.invoker", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+			{
+				ResourceName:      "google_cloudfunctions_function_iam_binding.foo",
+				ImportStateId:     fmt.Sprintf("%s roles/cloudfunctions.invoker", fmt.Sprintf("projects/%s/locations/%s/functions/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), functionName)),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudFunctionsFunctionIamMember(t *testing.T) {
+	t.Parallel()
+
+	functionName := "tf-test-" + randString(t, 10)
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", randInt(t))
+	account := randomWithPrefix(t, "tf-test")
+	accountEmail := fmt.Sprintf("%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv())
+	zipFilePath, err := createZIPArchiveForIndexJs(testHTTPTriggerPath)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer os.Remove(zipFilePath) // clean up
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudFunctionsFunctionIamMember_basic(functionName, bucketName, zipFilePath, account),
+				Check: testAccCheckIamUpdaterBinding(func(config *Config) ResourceIamUpdater {
+					return &CloudFunctionsFunctionIamUpdater{project: getTestProjectFromEnv(), region: getTestRegionFromEnv(), function: functionName, Config: config}
+				}, "roles/cloudfunctions.invoker", []string{"serviceAccount:" + accountEmail}),
+			},
+			{
+				ResourceName:      "google_cloudfunctions_function_iam_member.foo",
+				ImportStateId:     fmt.Sprintf("%s roles/cloudfunctions.invoker serviceAccount:%s", fmt.Sprintf("projects/%s/locations/%s/functions/%s", getTestProjectFromEnv(), getTestRegionFromEnv(), functionName), accountEmail),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudFunctionsFunctionIam_function(functionName, bucketName, zipFilePath, account string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+  name = "%s"
+}
+
+resource "google_storage_bucket_object" "archive" {
+  name   = "index.zip"
+  bucket = "${google_storage_bucket.bucket.name}"
+  source = "%s"
+}
+
+resource "google_cloudfunctions_function" "function" {
+  name                  = "%s"
+  source_archive_bucket = "${google_storage_bucket.bucket.name}"
+  source_archive_object = "${google_storage_bucket_object.archive.name}"
+  trigger_http          = true
+  entry_point           = "helloGET"
+}
+
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+`, bucketName, zipFilePath, functionName, account)
+}
+
+func testAccCloudFunctionsFunctionIamBinding_basic(functionName, bucketName, zipFilePath, account string) string {
+	return testAccCloudFunctionsFunctionIam_function(functionName, bucketName, zipFilePath, account) + `
+resource "google_cloudfunctions_function_iam_binding" "foo" {
+  cloud_function = "${google_cloudfunctions_function.function.name}"
+  role           = "roles/cloudfunctions.invoker"
+  members        = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`
+}
+
+func testAccCloudFunctionsFunctionIamMember_basic(functionName, bucketName, zipFilePath, account string) string {
+	return testAccCloudFunctionsFunctionIam_function(functionName, bucketName, zipFilePath, account) + `
+resource "google_cloudfunctions_function_iam_member" "foo" {
+  cloud_function = "${google_cloudfunctions_function.function.name}"
+  role           = "roles/cloudfunctions.invoker"
+  member         = "serviceAccount:${google_service_account.test-account.email}"
+}
+`
+}
@@ -10,7 +10,6 @@ import (
 	"archive/zip"
 	"io/ioutil"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/cloudfunctions/v1"
@@ -32,8 +31,8 @@ func TestAccCloudFunctionsFunction_basic(t *testing.T) {
 	var function cloudfunctions.CloudFunction
 
 	funcResourceName := "google_cloudfunctions_function.function"
-	functionName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	bucketName := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
+	functionName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", randInt(t))
 	zipFilePath, err := createZIPArchiveForIndexJs(testHTTPTriggerPath)
 	if err != nil {
 		t.Fatal(err.Error())
@@ -82,8 +81,8 @@ func TestAccCloudFunctionsFunction_update(t *testing.T) {
 	var function cloudfunctions.CloudFunction
 
 	funcResourceName := "google_cloudfunctions_function.function"
-	functionName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	bucketName := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
+	functionName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", randInt(t))
 	zipFilePath, err := createZIPArchiveForIndexJs(testHTTPTriggerPath)
 	if err != nil {
 		t.Fatal(err.Error())
@@ -129,9 +128,9 @@ func TestAccCloudFunctionsFunction_pubsub(t *testing.T) {
 	var function cloudfunctions.CloudFunction
 
 	funcResourceName := "google_cloudfunctions_function.function"
-	functionName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	bucketName := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
-	topicName := fmt.Sprintf("tf-test-sub-%s", acctest.RandString(10))
+	functionName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", randInt(t))
+	topicName := fmt.Sprintf("tf-test-sub-%s", randString(t, 10))
 	zipFilePath, err := createZIPArchiveForIndexJs(testPubSubTriggerPath)
 	if err != nil {
 		t.Fatal(err.Error())
@@ -175,8 +174,8 @@ func TestAccCloudFunctionsFunction_bucket(t *testing.T) {
 	var function cloudfunctions.CloudFunction
 
 	funcResourceName := "google_cloudfunctions_function.function"
-	functionName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	bucketName := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
+	functionName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", randInt(t))
 	zipFilePath, err := createZIPArchiveForIndexJs(testBucketTriggerPath)
 	if err != nil {
 		t.Fatal(err.Error())
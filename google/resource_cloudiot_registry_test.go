@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -12,7 +11,7 @@ import (
 func TestAccCloudIoTRegistryCreate_basic(t *testing.T) {
 	t.Parallel()
 
-	registryName := fmt.Sprintf("psregistry-test-%s", acctest.RandString(10))
+	registryName := fmt.Sprintf("psregistry-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -33,7 +32,7 @@ func TestAccCloudIoTRegistryCreate_basic(t *testing.T) {
 func TestAccCloudIoTRegistryCreate_extended(t *testing.T) {
 	t.Parallel()
 
-	registryName := fmt.Sprintf("psregistry-test-%s", acctest.RandString(10))
+	registryName := fmt.Sprintf("psregistry-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -41,7 +40,7 @@ func TestAccCloudIoTRegistryCreate_extended(t *testing.T) {
 		CheckDestroy: testAccCheckCloudIoTRegistryDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccCloudIoTRegistry_extended(registryName),
+				Config: testAccCloudIoTRegistry_extended(t, registryName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCloudIoTRegistryExists(
 						"google_cloudiot_registry.foobar"),
@@ -54,7 +53,7 @@ func TestAccCloudIoTRegistryCreate_extended(t *testing.T) {
 func TestAccCloudIoTRegistryUpdate(t *testing.T) {
 	t.Parallel()
 
-	registryName := fmt.Sprintf("psregistry-test-%s", acctest.RandString(10))
+	registryName := fmt.Sprintf("psregistry-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -69,7 +68,7 @@ func TestAccCloudIoTRegistryUpdate(t *testing.T) {
 				),
 			},
 			resource.TestStep{
-				Config: testAccCloudIoTRegistry_extended(registryName),
+				Config: testAccCloudIoTRegistry_extended(t, registryName),
 			},
 			resource.TestStep{
 				Config: testAccCloudIoTRegistry_basic(registryName),
@@ -117,7 +116,7 @@ resource "google_cloudiot_registry" "foobar" {
 }`, registryName)
 }
 
-func testAccCloudIoTRegistry_extended(registryName string) string {
+func testAccCloudIoTRegistry_extended(t *testing.T, registryName string) string {
 	return fmt.Sprintf(`
 resource "google_project_iam_binding" "cloud-iot-iam-binding" {
   members = ["serviceAccount:cloud-iot@system.gserviceaccount.com"]
@@ -162,5 +161,5 @@ resource "google_cloudiot_registry" "foobar" {
     },
   ]
 }
-`, acctest.RandString(10), acctest.RandString(10), registryName)
+`, randString(t, 10), randString(t, 10), registryName)
 }
@@ -120,7 +120,7 @@ func resourceComputeAddressCreate(d *schema.ResourceData, meta interface{}) erro
 		Name:    address.Name,
 	}.canonicalId())
 
-	err = computeSharedOperationWait(config.clientCompute, op, project, "Creating Address")
+	err = computeSharedOperationWait(config, op, project, "Creating Address")
 	if err != nil {
 		return err
 	}
@@ -172,7 +172,7 @@ func resourceComputeAddressDelete(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error deleting address: %s", err)
 	}
 
-	err = computeSharedOperationWait(config.clientCompute, op, addressId.Project, "Deleting Address")
+	err = computeSharedOperationWait(config, op, addressId.Project, "Deleting Address")
 	if err != nil {
 		return err
 	}
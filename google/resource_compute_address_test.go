@@ -4,13 +4,18 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-google/google/fakegcp"
 	computeBeta "google.golang.org/api/compute/v0.beta"
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_address", testSweepComputeResources("regions/{{region}}/addresses"),
+		"gcp_compute_forwarding_rule", "gcp_compute_instance")
+}
+
 // Unit tests
 
 func TestComputeAddressIdParsing(t *testing.T) {
@@ -73,6 +78,29 @@ func TestComputeAddressIdParsing(t *testing.T) {
 	}
 }
 
+func TestFakeComputeAddress_internal(t *testing.T) {
+	t.Parallel()
+
+	s := newFakeGcpServer(t)
+	defer s.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testFakeGcpProviders(),
+		CheckDestroy: testFakeGcpCheckDestroy(s),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testFakeComputeAddress_internal(s),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("google_compute_address.internal", "address"),
+					resource.TestCheckResourceAttrPair(
+						"google_compute_address.internal", "subnetwork", "google_compute_subnetwork.foobar", "self_link"),
+				),
+			},
+			testFakeGcpImportStep(s, "google_compute_address.internal"),
+		},
+	})
+}
+
 // Acceptance tests
 
 func TestAccComputeAddress_basic(t *testing.T) {
@@ -86,7 +114,7 @@ func TestAccComputeAddress_basic(t *testing.T) {
 		CheckDestroy: testAccCheckComputeAddressDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeAddress_basic(acctest.RandString(10)),
+				Config: testAccComputeAddress_basic(randString(t, 10)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeAddressExists(
 						"google_compute_address.foobar", &addr),
@@ -110,7 +138,7 @@ func TestAccComputeAddress_internal(t *testing.T) {
 		CheckDestroy: testAccCheckComputeAddressDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeAddress_internal(acctest.RandString(10)),
+				Config: testAccComputeAddress_internal(randString(t, 10)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeBetaAddressExists("google_compute_address.internal", &addr),
 					testAccCheckComputeBetaAddressExists("google_compute_address.internal_with_subnet", &addr),
@@ -273,3 +301,23 @@ resource "google_compute_address" "internal_with_subnet_and_address" {
 		i, // google_compute_address.internal_with_subnet_and_address name
 	)
 }
+
+func testFakeComputeAddress_internal(s *fakegcp.Server) string {
+	return testFakeGcpProviderConfig(s) + `
+resource "google_compute_network" "foobar" {
+	name                    = "network-test"
+	auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "foobar" {
+	name          = "subnetwork-test"
+	ip_cidr_range = "10.0.0.0/16"
+	network       = "${google_compute_network.foobar.self_link}"
+}
+
+resource "google_compute_address" "internal" {
+	name         = "address-test"
+	address_type = "INTERNAL"
+	subnetwork   = "${google_compute_subnetwork.foobar.name}"
+}`
+}
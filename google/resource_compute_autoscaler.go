@@ -228,7 +228,7 @@ func resourceComputeAutoscalerCreate(d *schema.ResourceData, meta interface{}) e
 	// It probably maybe worked, so store the ID now
 	d.SetId(scaler.Name)
 
-	err = computeOperationWait(config.clientCompute, op, project, "Creating Autoscaler")
+	err = computeOperationWait(config, op, project, "Creating Autoscaler")
 	if err != nil {
 		return err
 	}
@@ -354,7 +354,7 @@ func resourceComputeAutoscalerUpdate(d *schema.ResourceData, meta interface{}) e
 	// It probably maybe worked, so store the ID now
 	d.SetId(scaler.Name)
 
-	err = computeOperationWait(config.clientCompute, op, project, "Updating Autoscaler")
+	err = computeOperationWait(config, op, project, "Updating Autoscaler")
 	if err != nil {
 		return err
 	}
@@ -380,7 +380,7 @@ func resourceComputeAutoscalerDelete(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error deleting autoscaler: %s", err)
 	}
 
-	err = computeOperationWait(config.clientCompute, op, project, "Deleting Autoscaler")
+	err = computeOperationWait(config, op, project, "Deleting Autoscaler")
 	if err != nil {
 		return err
 	}
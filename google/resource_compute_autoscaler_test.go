@@ -4,21 +4,24 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_autoscaler", testSweepComputeResources("aggregated/autoscalers"))
+}
+
 func TestAccComputeAutoscaler_basic(t *testing.T) {
 	t.Parallel()
 
 	var ascaler compute.Autoscaler
 
-	var it_name = fmt.Sprintf("autoscaler-test-%s", acctest.RandString(10))
-	var tp_name = fmt.Sprintf("autoscaler-test-%s", acctest.RandString(10))
-	var igm_name = fmt.Sprintf("autoscaler-test-%s", acctest.RandString(10))
-	var autoscaler_name = fmt.Sprintf("autoscaler-test-%s", acctest.RandString(10))
+	var it_name = fmt.Sprintf("autoscaler-test-%s", randString(t, 10))
+	var tp_name = fmt.Sprintf("autoscaler-test-%s", randString(t, 10))
+	var igm_name = fmt.Sprintf("autoscaler-test-%s", randString(t, 10))
+	var autoscaler_name = fmt.Sprintf("autoscaler-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -47,10 +50,10 @@ func TestAccComputeAutoscaler_update(t *testing.T) {
 
 	var ascaler compute.Autoscaler
 
-	var it_name = fmt.Sprintf("autoscaler-test-%s", acctest.RandString(10))
-	var tp_name = fmt.Sprintf("autoscaler-test-%s", acctest.RandString(10))
-	var igm_name = fmt.Sprintf("autoscaler-test-%s", acctest.RandString(10))
-	var autoscaler_name = fmt.Sprintf("autoscaler-test-%s", acctest.RandString(10))
+	var it_name = fmt.Sprintf("autoscaler-test-%s", randString(t, 10))
+	var tp_name = fmt.Sprintf("autoscaler-test-%s", randString(t, 10))
+	var igm_name = fmt.Sprintf("autoscaler-test-%s", randString(t, 10))
+	var autoscaler_name = fmt.Sprintf("autoscaler-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -80,10 +83,10 @@ func TestAccComputeAutoscaler_update(t *testing.T) {
 func TestAccComputeAutoscaler_multicondition(t *testing.T) {
 	t.Parallel()
 
-	var it_name = fmt.Sprintf("autoscaler-test-%s", acctest.RandString(10))
-	var tp_name = fmt.Sprintf("autoscaler-test-%s", acctest.RandString(10))
-	var igm_name = fmt.Sprintf("autoscaler-test-%s", acctest.RandString(10))
-	var autoscaler_name = fmt.Sprintf("autoscaler-test-%s", acctest.RandString(10))
+	var it_name = fmt.Sprintf("autoscaler-test-%s", randString(t, 10))
+	var tp_name = fmt.Sprintf("autoscaler-test-%s", randString(t, 10))
+	var igm_name = fmt.Sprintf("autoscaler-test-%s", randString(t, 10))
+	var autoscaler_name = fmt.Sprintf("autoscaler-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
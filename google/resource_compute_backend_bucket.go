@@ -109,13 +109,13 @@ func resourceComputeBackendBucketCreate(d *schema.ResourceData, meta interface{}
 		"name":        nameProp,
 	}
 
-	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/global/backendBuckets")
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/backendBuckets")
 	if err != nil {
 		return err
 	}
 
 	log.Printf("[DEBUG] Creating new BackendBucket: %#v", obj)
-	res, err := Post(config, url, obj)
+	res, err := Post(config, url, project, obj)
 	if err != nil {
 		return fmt.Errorf("Error creating BackendBucket: %s", err)
 	}
@@ -134,12 +134,11 @@ func resourceComputeBackendBucketCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating BackendBucket",
-		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+		config, op, project, "Creating BackendBucket",
+		d.Timeout(schema.TimeoutCreate))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		clearIdUnlessStopped(d, config)
 		return fmt.Errorf("Error waiting to create BackendBucket: %s", waitErr)
 	}
 
@@ -156,12 +155,12 @@ func resourceComputeBackendBucketRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/global/backendBuckets/{{name}}")
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/backendBuckets/{{name}}")
 	if err != nil {
 		return err
 	}
 
-	res, err := Get(config, url)
+	res, err := Get(config, url, project)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("ComputeBackendBucket %q", d.Id()))
 	}
@@ -223,13 +222,13 @@ func resourceComputeBackendBucketUpdate(d *schema.ResourceData, meta interface{}
 		"name":        nameProp,
 	}
 
-	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/global/backendBuckets/{{name}}")
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/backendBuckets/{{name}}")
 	if err != nil {
 		return err
 	}
 
 	log.Printf("[DEBUG] Updating BackendBucket %q: %#v", d.Id(), obj)
-	res, err := sendRequest(config, "PUT", url, obj)
+	res, err := sendRequest(config, "PUT", url, project, obj)
 
 	if err != nil {
 		return fmt.Errorf("Error updating BackendBucket %q: %s", d.Id(), err)
@@ -242,8 +241,8 @@ func resourceComputeBackendBucketUpdate(d *schema.ResourceData, meta interface{}
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Updating BackendBucket",
-		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+		config, op, project, "Updating BackendBucket",
+		d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
 		return err
@@ -260,13 +259,13 @@ func resourceComputeBackendBucketDelete(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/global/backendBuckets/{{name}}")
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/backendBuckets/{{name}}")
 	if err != nil {
 		return err
 	}
 
 	log.Printf("[DEBUG] Deleting BackendBucket %q", d.Id())
-	res, err := Delete(config, url)
+	res, err := Delete(config, url, project)
 	if err != nil {
 		return handleNotFoundError(err, d, "BackendBucket")
 	}
@@ -278,8 +277,8 @@ func resourceComputeBackendBucketDelete(d *schema.ResourceData, meta interface{}
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting BackendBucket",
-		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+		config, op, project, "Deleting BackendBucket",
+		d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
 		return err
@@ -4,17 +4,20 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_backend_bucket", testSweepComputeResources("global/backendBuckets"), "gcp_compute_url_map")
+}
+
 func TestAccComputeBackendBucket_basic(t *testing.T) {
 	t.Parallel()
 
-	backendName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	storageName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	backendName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	storageName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 	var svc compute.BackendBucket
 
 	resource.Test(t, resource.TestCase{
@@ -45,9 +48,9 @@ func TestAccComputeBackendBucket_basic(t *testing.T) {
 func TestAccComputeBackendBucket_basicModified(t *testing.T) {
 	t.Parallel()
 
-	backendName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	storageName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	secondStorageName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	backendName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	storageName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	secondStorageName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 	var svc compute.BackendBucket
 
 	resource.Test(t, resource.TestCase{
@@ -128,8 +131,8 @@ func testAccCheckComputeBackendBucketExists(n string, svc *compute.BackendBucket
 func TestAccComputeBackendBucket_withCdnEnabled(t *testing.T) {
 	t.Parallel()
 
-	backendName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	storageName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	backendName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	storageName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 	var svc compute.BackendBucket
 
 	resource.Test(t, resource.TestCase{
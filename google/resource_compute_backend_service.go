@@ -265,10 +265,9 @@ func resourceComputeBackendServiceCreate(d *schema.ResourceData, meta interface{
 	d.SetId(service.Name)
 
 	// Wait for the operation to complete
-	waitErr := computeSharedOperationWait(config.clientCompute, op, project, "Creating Backend Service")
+	waitErr := computeSharedOperationWait(config, op, project, "Creating Backend Service")
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		clearIdUnlessStopped(d, config)
 		return waitErr
 	}
 
@@ -281,7 +280,7 @@ func resourceComputeBackendServiceCreate(d *schema.ResourceData, meta interface{
 		if err != nil {
 			return errwrap.Wrapf("Error setting Backend Service security policy: {{err}}", err)
 		}
-		waitErr := computeSharedOperationWait(config.clientCompute, op, project, "Adding Backend Service Security Policy")
+		waitErr := computeSharedOperationWait(config, op, project, "Adding Backend Service Security Policy")
 		if waitErr != nil {
 			return waitErr
 		}
@@ -347,7 +346,7 @@ func resourceComputeBackendServiceUpdate(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error updating backend service: %s", err)
 	}
 
-	err = computeSharedOperationWait(config.clientCompute, op, project, "Updating Backend Service")
+	err = computeSharedOperationWait(config, op, project, "Updating Backend Service")
 	if err != nil {
 		return err
 	}
@@ -364,7 +363,7 @@ func resourceComputeBackendServiceUpdate(d *schema.ResourceData, meta interface{
 		if err != nil {
 			return err
 		}
-		waitErr := computeSharedOperationWait(config.clientCompute, op, project, "Adding Backend Service Security Policy")
+		waitErr := computeSharedOperationWait(config, op, project, "Adding Backend Service Security Policy")
 		if waitErr != nil {
 			return waitErr
 		}
@@ -388,7 +387,7 @@ func resourceComputeBackendServiceDelete(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error deleting backend service: %s", err)
 	}
 
-	err = computeOperationWait(config.clientCompute, op, project, "Deleting Backend Service")
+	err = computeOperationWait(config, op, project, "Deleting Backend Service")
 	if err != nil {
 		return err
 	}
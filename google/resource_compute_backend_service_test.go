@@ -5,18 +5,22 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_backend_service", testSweepComputeResources("global/backendServices"),
+		"gcp_compute_url_map", "gcp_compute_target_ssl_proxy", "gcp_compute_target_tcp_proxy")
+}
+
 func TestAccComputeBackendService_basic(t *testing.T) {
 	t.Parallel()
 
-	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	checkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	extraCheckName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	serviceName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	checkName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	extraCheckName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 	var svc compute.BackendService
 
 	resource.Test(t, resource.TestCase{
@@ -51,10 +55,10 @@ func TestAccComputeBackendService_basic(t *testing.T) {
 func TestAccComputeBackendService_withBackend(t *testing.T) {
 	t.Parallel()
 
-	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	igName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	itName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	checkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	serviceName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	igName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	itName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	checkName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 	var svc compute.BackendService
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -97,10 +101,10 @@ func TestAccComputeBackendService_withBackend(t *testing.T) {
 }
 
 func TestAccComputeBackendService_withBackendAndIAP(t *testing.T) {
-	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	igName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	itName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	checkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	serviceName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	igName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	itName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	checkName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 	var svc compute.BackendService
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -146,8 +150,8 @@ func TestAccComputeBackendService_withBackendAndIAP(t *testing.T) {
 func TestAccComputeBackendService_updatePreservesOptionalParameters(t *testing.T) {
 	t.Parallel()
 
-	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	checkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	serviceName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	checkName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 	var svc compute.BackendService
 
 	resource.Test(t, resource.TestCase{
@@ -182,8 +186,8 @@ func TestAccComputeBackendService_updatePreservesOptionalParameters(t *testing.T
 func TestAccComputeBackendService_withConnectionDraining(t *testing.T) {
 	t.Parallel()
 
-	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	checkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	serviceName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	checkName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 	var svc compute.BackendService
 
 	resource.Test(t, resource.TestCase{
@@ -214,8 +218,8 @@ func TestAccComputeBackendService_withConnectionDraining(t *testing.T) {
 func TestAccComputeBackendService_withConnectionDrainingAndUpdate(t *testing.T) {
 	t.Parallel()
 
-	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	checkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	serviceName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	checkName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 	var svc compute.BackendService
 
 	resource.Test(t, resource.TestCase{
@@ -248,8 +252,8 @@ func TestAccComputeBackendService_withConnectionDrainingAndUpdate(t *testing.T)
 func TestAccComputeBackendService_withHttpsHealthCheck(t *testing.T) {
 	t.Parallel()
 
-	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	checkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	serviceName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	checkName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 	var svc compute.BackendService
 
 	resource.Test(t, resource.TestCase{
@@ -276,8 +280,8 @@ func TestAccComputeBackendService_withHttpsHealthCheck(t *testing.T) {
 func TestAccComputeBackendService_withCdnPolicy(t *testing.T) {
 	t.Parallel()
 
-	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	checkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	serviceName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	checkName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 	var svc compute.BackendService
 
 	resource.Test(t, resource.TestCase{
@@ -304,9 +308,9 @@ func TestAccComputeBackendService_withCdnPolicy(t *testing.T) {
 func TestAccComputeBackendService_withSecurityPolicy(t *testing.T) {
 	t.Parallel()
 
-	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	checkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	polName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	serviceName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	checkName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	polName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 	var svc compute.BackendService
 
 	resource.Test(t, resource.TestCase{
@@ -441,8 +445,8 @@ func testAccCheckComputeBackendServiceExistsWithoutIAP(n string, svc *compute.Ba
 func TestAccComputeBackendService_withCDNEnabled(t *testing.T) {
 	t.Parallel()
 
-	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	checkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	serviceName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	checkName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 	var svc compute.BackendService
 
 	resource.Test(t, resource.TestCase{
@@ -469,8 +473,8 @@ func TestAccComputeBackendService_withCDNEnabled(t *testing.T) {
 func TestAccComputeBackendService_withSessionAffinity(t *testing.T) {
 	t.Parallel()
 
-	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	checkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	serviceName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	checkName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 	var svc compute.BackendService
 
 	resource.Test(t, resource.TestCase{
@@ -505,10 +509,10 @@ func TestAccComputeBackendService_withSessionAffinity(t *testing.T) {
 func TestAccComputeBackendService_withMaxConnections(t *testing.T) {
 	t.Parallel()
 
-	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	igName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	itName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	checkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	serviceName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	igName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	itName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	checkName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	var svc compute.BackendService
 	resource.Test(t, resource.TestCase{
@@ -548,10 +552,10 @@ func TestAccComputeBackendService_withMaxConnections(t *testing.T) {
 func TestAccComputeBackendService_withMaxConnectionsPerInstance(t *testing.T) {
 	t.Parallel()
 
-	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	igName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	itName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	checkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	serviceName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	igName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	itName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	checkName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	var svc compute.BackendService
 	resource.Test(t, resource.TestCase{
@@ -591,8 +595,8 @@ func TestAccComputeBackendService_withMaxConnectionsPerInstance(t *testing.T) {
 func TestAccComputeBackendService_withCustomHeaders(t *testing.T) {
 	t.Parallel()
 
-	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	checkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	serviceName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	checkName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -22,6 +22,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/schema"
 	compute "google.golang.org/api/compute/v1"
@@ -261,7 +262,8 @@ func resourceComputeDisk() *schema.Resource {
 			Delete: schema.DefaultTimeout(240 * time.Second),
 		},
 		CustomizeDiff: customdiff.All(
-			customdiff.ForceNewIfChange("size", isDiskShrinkage)),
+			customdiff.ForceNewIfChange("size", isDiskShrinkage),
+			effectiveLabelsCustomizeDiff(false)),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -285,6 +287,7 @@ func resourceComputeDisk() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"effective_labels": effectiveLabelsSchema(),
 			"size": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -497,13 +500,13 @@ func resourceComputeDiskCreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/zones/{{zone}}/disks")
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/zones/{{zone}}/disks")
 	if err != nil {
 		return err
 	}
 
 	log.Printf("[DEBUG] Creating new Disk: %#v", obj)
-	res, err := Post(config, url, obj)
+	res, err := Post(config, url, project, obj)
 	if err != nil {
 		return fmt.Errorf("Error creating Disk: %s", err)
 	}
@@ -522,12 +525,11 @@ func resourceComputeDiskCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating Disk",
-		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+		config, op, project, "Creating Disk",
+		d.Timeout(schema.TimeoutCreate))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		clearIdUnlessStopped(d, config)
 		return fmt.Errorf("Error waiting to create Disk: %s", waitErr)
 	}
 
@@ -544,12 +546,12 @@ func resourceComputeDiskRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/zones/{{zone}}/disks/{{name}}")
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/zones/{{zone}}/disks/{{name}}")
 	if err != nil {
 		return err
 	}
 
-	res, err := Get(config, url)
+	res, err := Get(config, url, project)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("ComputeDisk %q", d.Id()))
 	}
@@ -571,7 +573,7 @@ func resourceComputeDiskRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("last_detach_timestamp", flattenComputeDiskLastDetachTimestamp(res["lastDetachTimestamp"])); err != nil {
 		return fmt.Errorf("Error reading Disk: %s", err)
 	}
-	if err := d.Set("labels", flattenComputeDiskLabels(res["labels"])); err != nil {
+	if err := setLabels(d, config, flattenComputeDiskLabels(res["labels"])); err != nil {
 		return fmt.Errorf("Error reading Disk: %s", err)
 	}
 	if err := d.Set("name", flattenComputeDiskName(res["name"])); err != nil {
@@ -635,7 +637,7 @@ func resourceComputeDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	d.Partial(true)
 
-	if d.HasChange("labels") {
+	if hasLabelsChange(d) {
 		labelsProp, err := expandComputeDiskLabels(d.Get("labels"), d, config)
 		if err != nil {
 			return err
@@ -644,11 +646,11 @@ func resourceComputeDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 		obj = map[string]interface{}{
 			"labels":           labelsProp,
 			"labelFingerprint": d.Get("label_fingerprint").(string)}
-		url, err = replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/zones/{{zone}}/disks/{{name}}/setLabels")
+		url, err = replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/zones/{{zone}}/disks/{{name}}/setLabels")
 		if err != nil {
 			return err
 		}
-		res, err = sendRequest(config, "POST", url, obj)
+		res, err = sendRequest(config, "POST", url, project, obj)
 		if err != nil {
 			return fmt.Errorf("Error updating Disk %q: %s", d.Id(), err)
 		}
@@ -659,8 +661,8 @@ func resourceComputeDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating Disk",
-			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+			config, op, project, "Updating Disk",
+			d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
 			return err
@@ -677,11 +679,11 @@ func resourceComputeDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 		obj = map[string]interface{}{
 			"sizeGb": sizeGbProp,
 		}
-		url, err = replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/zones/{{zone}}/disks/{{name}}/resize")
+		url, err = replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/zones/{{zone}}/disks/{{name}}/resize")
 		if err != nil {
 			return err
 		}
-		res, err = sendRequest(config, "POST", url, obj)
+		res, err = sendRequest(config, "POST", url, project, obj)
 		if err != nil {
 			return fmt.Errorf("Error updating Disk %q: %s", d.Id(), err)
 		}
@@ -692,8 +694,8 @@ func resourceComputeDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating Disk",
-			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+			config, op, project, "Updating Disk",
+			d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
 			return err
@@ -715,7 +717,7 @@ func resourceComputeDiskDelete(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/zones/{{zone}}/disks/{{name}}")
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/zones/{{zone}}/disks/{{name}}")
 	if err != nil {
 		return err
 	}
@@ -758,10 +760,10 @@ func resourceComputeDiskDelete(d *schema.ResourceData, meta interface{}) error {
 				return fmt.Errorf("Error detaching disk %s from instance %s/%s/%s: %s", call.deviceName, call.project,
 					call.zone, call.instance, err.Error())
 			}
-			err = computeOperationWait(config.clientCompute, op, call.project,
+			err = computeOperationWait(config, op, call.project,
 				fmt.Sprintf("Detaching disk from %s/%s/%s", call.project, call.zone, call.instance))
 			if err != nil {
-				if opErr, ok := err.(ComputeOperationError); ok && len(opErr.Errors) == 1 && opErr.Errors[0].Code == "RESOURCE_NOT_FOUND" {
+				if opErr, ok := errwrap.GetType(err, ComputeOperationError{}).(ComputeOperationError); ok && len(opErr.Errors) == 1 && opErr.Errors[0].Code == "RESOURCE_NOT_FOUND" {
 					log.Printf("[WARN] instance %q was deleted while awaiting detach", call.instance)
 					continue
 				}
@@ -770,7 +772,7 @@ func resourceComputeDiskDelete(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 	log.Printf("[DEBUG] Deleting Disk %q", d.Id())
-	res, err := Delete(config, url)
+	res, err := Delete(config, url, project)
 	if err != nil {
 		return handleNotFoundError(err, d, "Disk")
 	}
@@ -782,8 +784,8 @@ func resourceComputeDiskDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting Disk",
-		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+		config, op, project, "Deleting Disk",
+		d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
 		return err
@@ -849,8 +851,11 @@ func flattenComputeDiskLastDetachTimestamp(v interface{}) interface{} {
 	return v
 }
 
-func flattenComputeDiskLabels(v interface{}) interface{} {
-	return v
+func flattenComputeDiskLabels(v interface{}) map[string]string {
+	if v == nil {
+		return nil
+	}
+	return convertStringMap(v.(map[string]interface{}))
 }
 
 func flattenComputeDiskName(v interface{}) interface{} {
@@ -961,13 +966,13 @@ func expandComputeDiskDescription(v interface{}, d *schema.ResourceData, config
 
 func expandComputeDiskLabels(v interface{}, d *schema.ResourceData, config *Config) (map[string]string, error) {
 	if v == nil {
-		return map[string]string{}, nil
+		return mergeDefaultLabels(config, nil), nil
 	}
 	m := make(map[string]string)
 	for k, val := range v.(map[string]interface{}) {
 		m[k] = val.(string)
 	}
-	return m, nil
+	return mergeDefaultLabels(config, m), nil
 }
 
 func expandComputeDiskName(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
@@ -7,12 +7,15 @@ import (
 	"strconv"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_disk", testSweepComputeResources("aggregated/disks"), "gcp_compute_instance")
+}
+
 func TestDiskImageDiffSuppress(t *testing.T) {
 	cases := map[string]struct {
 		Old, New           string
@@ -203,7 +206,7 @@ func TestAccComputeDisk_imageDiffSuppressPublicVendorsFamilyNames(t *testing.T)
 func TestAccComputeDisk_basic(t *testing.T) {
 	t.Parallel()
 
-	diskName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	diskName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 	var disk compute.Disk
 
 	resource.Test(t, resource.TestCase{
@@ -237,7 +240,7 @@ func TestAccComputeDisk_timeout(t *testing.T) {
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config:      testAccComputeDisk_timeout(),
+				Config:      testAccComputeDisk_timeout(t),
 				ExpectError: regexp.MustCompile("timeout"),
 			},
 		},
@@ -247,7 +250,7 @@ func TestAccComputeDisk_timeout(t *testing.T) {
 func TestAccComputeDisk_update(t *testing.T) {
 	t.Parallel()
 
-	diskName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	diskName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 	var disk compute.Disk
 
 	resource.Test(t, resource.TestCase{
@@ -282,9 +285,9 @@ func TestAccComputeDisk_update(t *testing.T) {
 func TestAccComputeDisk_fromSnapshot(t *testing.T) {
 	t.Parallel()
 
-	diskName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	firstDiskName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	snapshotName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	diskName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	firstDiskName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	snapshotName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 	projectName := getTestProjectFromEnv()
 
 	var disk compute.Disk
@@ -315,7 +318,7 @@ func TestAccComputeDisk_fromSnapshot(t *testing.T) {
 func TestAccComputeDisk_encryption(t *testing.T) {
 	t.Parallel()
 
-	diskName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	diskName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 	var disk compute.Disk
 
 	resource.Test(t, resource.TestCase{
@@ -359,8 +362,8 @@ func TestAccComputeDisk_encryption(t *testing.T) {
 func TestAccComputeDisk_deleteDetach(t *testing.T) {
 	t.Parallel()
 
-	diskName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	instanceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	diskName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	instanceName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 	var disk compute.Disk
 
 	resource.Test(t, resource.TestCase{
@@ -395,9 +398,9 @@ func TestAccComputeDisk_deleteDetach(t *testing.T) {
 func TestAccComputeDisk_deleteDetachIGM(t *testing.T) {
 	t.Parallel()
 
-	diskName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	diskName2 := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	mgrName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	diskName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	diskName2 := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	mgrName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 	var disk compute.Disk
 
 	resource.Test(t, resource.TestCase{
@@ -612,7 +615,7 @@ resource "google_compute_disk" "foobar" {
 }`, diskName)
 }
 
-func testAccComputeDisk_timeout() string {
+func testAccComputeDisk_timeout(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_disk" "foobar" {
 	name  = "%s"
@@ -623,7 +626,7 @@ resource "google_compute_disk" "foobar" {
 	timeouts {
 		Create = "1s"
 	}
-}`, acctest.RandString(10))
+}`, randString(t, 10))
 }
 
 func testAccComputeDisk_updated(diskName string) string {
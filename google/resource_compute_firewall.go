@@ -216,7 +216,7 @@ func resourceComputeFirewallCreate(d *schema.ResourceData, meta interface{}) err
 	// It probably maybe worked, so store the ID now
 	d.SetId(firewall.Name)
 
-	err = computeSharedOperationWait(config.clientCompute, op, project, "Creating Firewall")
+	err = computeSharedOperationWait(config, op, project, "Creating Firewall")
 	if err != nil {
 		return err
 	}
@@ -300,7 +300,7 @@ func resourceComputeFirewallUpdate(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error updating firewall: %s", err)
 	}
 
-	err = computeSharedOperationWait(config.clientCompute, op, project, "Updating Firewall")
+	err = computeSharedOperationWait(config, op, project, "Updating Firewall")
 	if err != nil {
 		return err
 	}
@@ -324,7 +324,7 @@ func resourceComputeFirewallDelete(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error deleting firewall: %s", err)
 	}
 
-	err = computeSharedOperationWait(config.clientCompute, op, project, "Deleting Firewall")
+	err = computeSharedOperationWait(config, op, project, "Deleting Firewall")
 	if err != nil {
 		return err
 	}
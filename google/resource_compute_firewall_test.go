@@ -4,9 +4,9 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-google/google/fakegcp"
 
 	"strings"
 
@@ -14,12 +14,39 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_firewall", testSweepComputeResources("global/firewalls"))
+}
+
+func TestFakeComputeFirewall_networkName(t *testing.T) {
+	t.Parallel()
+
+	s := newFakeGcpServer(t)
+	defer s.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testFakeGcpProviders(),
+		CheckDestroy: testFakeGcpCheckDestroy(s),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testFakeComputeFirewall_networkName(s, "80"),
+				Check: resource.TestCheckResourceAttrPair(
+					"google_compute_firewall.foobar", "network", "google_compute_network.foobar", "self_link"),
+			},
+			testFakeGcpImportStep(s, "google_compute_firewall.foobar"),
+			resource.TestStep{
+				Config: testFakeComputeFirewall_networkName(s, "443"),
+			},
+		},
+	})
+}
+
 func TestAccComputeFirewall_basic(t *testing.T) {
 	t.Parallel()
 
 	var firewall compute.Firewall
-	networkName := fmt.Sprintf("firewall-test-%s", acctest.RandString(10))
-	firewallName := fmt.Sprintf("firewall-test-%s", acctest.RandString(10))
+	networkName := fmt.Sprintf("firewall-test-%s", randString(t, 10))
+	firewallName := fmt.Sprintf("firewall-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -47,8 +74,8 @@ func TestAccComputeFirewall_update(t *testing.T) {
 	t.Parallel()
 
 	var firewall compute.Firewall
-	networkName := fmt.Sprintf("firewall-test-%s", acctest.RandString(10))
-	firewallName := fmt.Sprintf("firewall-test-%s", acctest.RandString(10))
+	networkName := fmt.Sprintf("firewall-test-%s", randString(t, 10))
+	firewallName := fmt.Sprintf("firewall-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -81,8 +108,8 @@ func TestAccComputeFirewall_priority(t *testing.T) {
 	t.Parallel()
 
 	var firewall compute.Firewall
-	networkName := fmt.Sprintf("firewall-test-%s", acctest.RandString(10))
-	firewallName := fmt.Sprintf("firewall-test-%s", acctest.RandString(10))
+	networkName := fmt.Sprintf("firewall-test-%s", randString(t, 10))
+	firewallName := fmt.Sprintf("firewall-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -111,8 +138,8 @@ func TestAccComputeFirewall_noSource(t *testing.T) {
 	t.Parallel()
 
 	var firewall compute.Firewall
-	networkName := fmt.Sprintf("firewall-test-%s", acctest.RandString(10))
-	firewallName := fmt.Sprintf("firewall-test-%s", acctest.RandString(10))
+	networkName := fmt.Sprintf("firewall-test-%s", randString(t, 10))
+	firewallName := fmt.Sprintf("firewall-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -140,8 +167,8 @@ func TestAccComputeFirewall_denied(t *testing.T) {
 	t.Parallel()
 
 	var firewall compute.Firewall
-	networkName := fmt.Sprintf("firewall-test-%s", acctest.RandString(10))
-	firewallName := fmt.Sprintf("firewall-test-%s", acctest.RandString(10))
+	networkName := fmt.Sprintf("firewall-test-%s", randString(t, 10))
+	firewallName := fmt.Sprintf("firewall-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -169,8 +196,8 @@ func TestAccComputeFirewall_egress(t *testing.T) {
 	t.Parallel()
 
 	var firewall compute.Firewall
-	networkName := fmt.Sprintf("firewall-test-%s", acctest.RandString(10))
-	firewallName := fmt.Sprintf("firewall-test-%s", acctest.RandString(10))
+	networkName := fmt.Sprintf("firewall-test-%s", randString(t, 10))
+	firewallName := fmt.Sprintf("firewall-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -198,11 +225,11 @@ func TestAccComputeFirewall_serviceAccounts(t *testing.T) {
 	t.Parallel()
 
 	var firewall compute.Firewall
-	networkName := fmt.Sprintf("firewall-test-%s", acctest.RandString(10))
-	firewallName := fmt.Sprintf("firewall-test-%s", acctest.RandString(10))
+	networkName := fmt.Sprintf("firewall-test-%s", randString(t, 10))
+	firewallName := fmt.Sprintf("firewall-test-%s", randString(t, 10))
 
-	sourceSa := fmt.Sprintf("firewall-test-%s", acctest.RandString(10))
-	targetSa := fmt.Sprintf("firewall-test-%s", acctest.RandString(10))
+	sourceSa := fmt.Sprintf("firewall-test-%s", randString(t, 10))
+	targetSa := fmt.Sprintf("firewall-test-%s", randString(t, 10))
 	project := getTestProjectFromEnv()
 	sourceSaEmail := fmt.Sprintf("%s@%s.iam.gserviceaccount.com", sourceSa, project)
 	targetSaEmail := fmt.Sprintf("%s@%s.iam.gserviceaccount.com", targetSa, project)
@@ -232,8 +259,8 @@ func TestAccComputeFirewall_serviceAccounts(t *testing.T) {
 func TestAccComputeFirewall_disabled(t *testing.T) {
 	t.Parallel()
 
-	networkName := fmt.Sprintf("firewall-test-%s", acctest.RandString(10))
-	firewallName := fmt.Sprintf("firewall-test-%s", acctest.RandString(10))
+	networkName := fmt.Sprintf("firewall-test-%s", randString(t, 10))
+	firewallName := fmt.Sprintf("firewall-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -594,3 +621,22 @@ func testAccComputeFirewall_disabled(network, firewall string) string {
 		disabled = true
 	}`, network, firewall)
 }
+
+func testFakeComputeFirewall_networkName(s *fakegcp.Server, port string) string {
+	return testFakeGcpProviderConfig(s) + fmt.Sprintf(`
+resource "google_compute_network" "foobar" {
+	name                    = "network-test"
+	auto_create_subnetworks = false
+}
+
+resource "google_compute_firewall" "foobar" {
+	name          = "firewall-test"
+	network       = "${google_compute_network.foobar.name}"
+	source_ranges = ["10.0.0.0/8"]
+
+	allow {
+		protocol = "tcp"
+		ports    = ["%s"]
+	}
+}`, port)
+}
@@ -4,16 +4,19 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_forwarding_rule", testSweepComputeResources("regions/{{region}}/forwardingRules"))
+}
+
 func TestAccComputeForwardingRule_update(t *testing.T) {
 	t.Parallel()
 
-	poolName := fmt.Sprintf("tf-%s", acctest.RandString(10))
-	ruleName := fmt.Sprintf("tf-%s", acctest.RandString(10))
+	poolName := fmt.Sprintf("tf-%s", randString(t, 10))
+	ruleName := fmt.Sprintf("tf-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -43,8 +46,8 @@ func TestAccComputeForwardingRule_update(t *testing.T) {
 func TestAccComputeForwardingRule_singlePort(t *testing.T) {
 	t.Parallel()
 
-	poolName := fmt.Sprintf("tf-%s", acctest.RandString(10))
-	ruleName := fmt.Sprintf("tf-%s", acctest.RandString(10))
+	poolName := fmt.Sprintf("tf-%s", randString(t, 10))
+	ruleName := fmt.Sprintf("tf-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -66,9 +69,9 @@ func TestAccComputeForwardingRule_singlePort(t *testing.T) {
 func TestAccComputeForwardingRule_ip(t *testing.T) {
 	t.Parallel()
 
-	addrName := fmt.Sprintf("tf-%s", acctest.RandString(10))
-	poolName := fmt.Sprintf("tf-%s", acctest.RandString(10))
-	ruleName := fmt.Sprintf("tf-%s", acctest.RandString(10))
+	addrName := fmt.Sprintf("tf-%s", randString(t, 10))
+	poolName := fmt.Sprintf("tf-%s", randString(t, 10))
+	ruleName := fmt.Sprintf("tf-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -90,11 +93,11 @@ func TestAccComputeForwardingRule_ip(t *testing.T) {
 func TestAccComputeForwardingRule_internalLoadBalancing(t *testing.T) {
 	t.Parallel()
 
-	serviceName := fmt.Sprintf("tf-%s", acctest.RandString(10))
-	checkName := fmt.Sprintf("tf-%s", acctest.RandString(10))
-	networkName := fmt.Sprintf("tf-%s", acctest.RandString(10))
-	ruleName1 := fmt.Sprintf("tf-%s", acctest.RandString(10))
-	ruleName2 := fmt.Sprintf("tf-%s", acctest.RandString(10))
+	serviceName := fmt.Sprintf("tf-%s", randString(t, 10))
+	checkName := fmt.Sprintf("tf-%s", randString(t, 10))
+	networkName := fmt.Sprintf("tf-%s", randString(t, 10))
+	ruleName1 := fmt.Sprintf("tf-%s", randString(t, 10))
+	ruleName2 := fmt.Sprintf("tf-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -105,13 +105,13 @@ func resourceComputeGlobalAddressCreate(d *schema.ResourceData, meta interface{}
 		"ipVersion":   ipVersionProp,
 	}
 
-	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/global/addresses")
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/addresses")
 	if err != nil {
 		return err
 	}
 
 	log.Printf("[DEBUG] Creating new GlobalAddress: %#v", obj)
-	res, err := Post(config, url, obj)
+	res, err := Post(config, url, project, obj)
 	if err != nil {
 		return fmt.Errorf("Error creating GlobalAddress: %s", err)
 	}
@@ -130,12 +130,11 @@ func resourceComputeGlobalAddressCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating GlobalAddress",
-		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+		config, op, project, "Creating GlobalAddress",
+		d.Timeout(schema.TimeoutCreate))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		clearIdUnlessStopped(d, config)
 		return fmt.Errorf("Error waiting to create GlobalAddress: %s", waitErr)
 	}
 
@@ -152,12 +151,12 @@ func resourceComputeGlobalAddressRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/global/addresses/{{name}}")
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/addresses/{{name}}")
 	if err != nil {
 		return err
 	}
 
-	res, err := Get(config, url)
+	res, err := Get(config, url, project)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("ComputeGlobalAddress %q", d.Id()))
 	}
@@ -195,13 +194,13 @@ func resourceComputeGlobalAddressDelete(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/global/addresses/{{name}}")
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/addresses/{{name}}")
 	if err != nil {
 		return err
 	}
 
 	log.Printf("[DEBUG] Deleting GlobalAddress %q", d.Id())
-	res, err := Delete(config, url)
+	res, err := Delete(config, url, project)
 	if err != nil {
 		return handleNotFoundError(err, d, "GlobalAddress")
 	}
@@ -213,8 +212,8 @@ func resourceComputeGlobalAddressDelete(d *schema.ResourceData, meta interface{}
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting GlobalAddress",
-		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+		config, op, project, "Deleting GlobalAddress",
+		d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
 		return err
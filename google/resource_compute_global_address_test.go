@@ -4,13 +4,17 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_global_address", testSweepComputeResources("global/addresses"),
+		"gcp_compute_global_forwarding_rule")
+}
+
 func TestAccComputeGlobalAddress_basic(t *testing.T) {
 	t.Parallel()
 
@@ -22,7 +26,7 @@ func TestAccComputeGlobalAddress_basic(t *testing.T) {
 		CheckDestroy: testAccCheckComputeGlobalAddressDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeGlobalAddress_basic(),
+				Config: testAccComputeGlobalAddress_basic(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeGlobalAddressExists(
 						"google_compute_global_address.foobar", &addr),
@@ -51,7 +55,7 @@ func TestAccComputeGlobalAddress_ipv6(t *testing.T) {
 		CheckDestroy: testAccCheckComputeGlobalAddressDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeGlobalAddress_ipv6(),
+				Config: testAccComputeGlobalAddress_ipv6(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeGlobalAddressExists(
 						"google_compute_global_address.foobar", &addr),
@@ -140,19 +144,19 @@ func testAccCheckComputeGlobalAddressIpVersion(n, version string) resource.TestC
 	}
 }
 
-func testAccComputeGlobalAddress_basic() string {
+func testAccComputeGlobalAddress_basic(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_global_address" "foobar" {
 	name = "address-test-%s"
 	description = "Created for Terraform acceptance testing"
-}`, acctest.RandString(10))
+}`, randString(t, 10))
 }
 
-func testAccComputeGlobalAddress_ipv6() string {
+func testAccComputeGlobalAddress_ipv6(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_global_address" "foobar" {
 	name = "address-test-%s"
 	description = "Created for Terraform acceptance testing"
 	ip_version = "IPV6"
-}`, acctest.RandString(10))
+}`, randString(t, 10))
 }
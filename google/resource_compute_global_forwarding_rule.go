@@ -18,6 +18,8 @@ func resourceComputeGlobalForwardingRule() *schema.Resource {
 		Update: resourceComputeGlobalForwardingRuleUpdate,
 		Delete: resourceComputeGlobalForwardingRuleDelete,
 
+		CustomizeDiff: effectiveLabelsCustomizeDiff(false),
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -62,6 +64,8 @@ func resourceComputeGlobalForwardingRule() *schema.Resource {
 				Set:      schema.HashString,
 			},
 
+			"effective_labels": effectiveLabelsSchema(),
+
 			"label_fingerprint": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -129,14 +133,13 @@ func resourceComputeGlobalForwardingRuleCreate(d *schema.ResourceData, meta inte
 	// It probably maybe worked, so store the ID now
 	d.SetId(frule.Name)
 
-	err = computeSharedOperationWait(config.clientCompute, op, project, "Creating Global Fowarding Rule")
+	err = computeSharedOperationWait(config, op, project, "Creating Global Fowarding Rule")
 	if err != nil {
 		return err
 	}
 
 	// If we have labels to set, try to set those too
-	if _, ok := d.GetOk("labels"); ok {
-		labels := expandLabels(d)
+	if labels := expandLabels(d, config); len(labels) > 0 {
 		// Do a read to get the fingerprint value so we can update
 		fingerprint, err := resourceComputeGlobalForwardingRuleReadLabelFingerprint(config, project, frule.Name)
 		if err != nil {
@@ -172,15 +175,15 @@ func resourceComputeGlobalForwardingRuleUpdate(d *schema.ResourceData, meta inte
 			return fmt.Errorf("Error updating target: %s", err)
 		}
 
-		err = computeSharedOperationWait(config.clientCompute, op, project, "Updating Global Forwarding Rule")
+		err = computeSharedOperationWait(config, op, project, "Updating Global Forwarding Rule")
 		if err != nil {
 			return err
 		}
 
 		d.SetPartial("target")
 	}
-	if d.HasChange("labels") {
-		labels := expandLabels(d)
+	if hasLabelsChange(d) {
+		labels := expandLabels(d, config)
 		fingerprint := d.Get("label_fingerprint").(string)
 
 		err = resourceComputeGlobalForwardingRuleSetLabels(config, project, d.Get("name").(string), labels, fingerprint)
@@ -217,7 +220,9 @@ func resourceComputeGlobalForwardingRuleRead(d *schema.ResourceData, meta interf
 	d.Set("ip_protocol", frule.IPProtocol)
 	d.Set("ip_version", frule.IpVersion)
 	d.Set("self_link", ConvertSelfLinkToV1(frule.SelfLink))
-	d.Set("labels", frule.Labels)
+	if err := setLabels(d, config, frule.Labels); err != nil {
+		return fmt.Errorf("Error reading labels: %s", err)
+	}
 	d.Set("label_fingerprint", frule.LabelFingerprint)
 	d.Set("project", project)
 
@@ -238,7 +243,7 @@ func resourceComputeGlobalForwardingRuleDelete(d *schema.ResourceData, meta inte
 	if err != nil {
 		return fmt.Errorf("Error deleting GlobalForwardingRule: %s", err)
 	}
-	err = computeSharedOperationWait(config.clientCompute, op, project, "Deleting GlobalForwarding Rule")
+	err = computeSharedOperationWait(config, op, project, "Deleting GlobalForwarding Rule")
 	if err != nil {
 		return err
 	}
@@ -269,7 +274,7 @@ func resourceComputeGlobalForwardingRuleSetLabels(config *Config, project, name
 		return err
 	}
 
-	err = computeSharedOperationWait(config.clientCompute, op, project, "Setting labels on Global Forwarding Rule")
+	err = computeSharedOperationWait(config, op, project, "Setting labels on Global Forwarding Rule")
 	if err != nil {
 		return err
 	}
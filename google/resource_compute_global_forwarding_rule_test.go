@@ -4,22 +4,25 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 
 	computeBeta "google.golang.org/api/compute/v0.beta"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_global_forwarding_rule", testSweepComputeResources("global/forwardingRules"))
+}
+
 func TestAccComputeGlobalForwardingRule_basic(t *testing.T) {
 	t.Parallel()
 
-	fr := fmt.Sprintf("forwardrule-test-%s", acctest.RandString(10))
-	proxy1 := fmt.Sprintf("forwardrule-test-%s", acctest.RandString(10))
-	proxy2 := fmt.Sprintf("forwardrule-test-%s", acctest.RandString(10))
-	backend := fmt.Sprintf("forwardrule-test-%s", acctest.RandString(10))
-	hc := fmt.Sprintf("forwardrule-test-%s", acctest.RandString(10))
-	urlmap := fmt.Sprintf("forwardrule-test-%s", acctest.RandString(10))
+	fr := fmt.Sprintf("forwardrule-test-%s", randString(t, 10))
+	proxy1 := fmt.Sprintf("forwardrule-test-%s", randString(t, 10))
+	proxy2 := fmt.Sprintf("forwardrule-test-%s", randString(t, 10))
+	backend := fmt.Sprintf("forwardrule-test-%s", randString(t, 10))
+	hc := fmt.Sprintf("forwardrule-test-%s", randString(t, 10))
+	urlmap := fmt.Sprintf("forwardrule-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -48,12 +51,12 @@ func TestAccComputeGlobalForwardingRule_basic(t *testing.T) {
 func TestAccComputeGlobalForwardingRule_update(t *testing.T) {
 	t.Parallel()
 
-	fr := fmt.Sprintf("forwardrule-test-%s", acctest.RandString(10))
-	proxy1 := fmt.Sprintf("forwardrule-test-%s", acctest.RandString(10))
-	proxy2 := fmt.Sprintf("forwardrule-test-%s", acctest.RandString(10))
-	backend := fmt.Sprintf("forwardrule-test-%s", acctest.RandString(10))
-	hc := fmt.Sprintf("forwardrule-test-%s", acctest.RandString(10))
-	urlmap := fmt.Sprintf("forwardrule-test-%s", acctest.RandString(10))
+	fr := fmt.Sprintf("forwardrule-test-%s", randString(t, 10))
+	proxy1 := fmt.Sprintf("forwardrule-test-%s", randString(t, 10))
+	proxy2 := fmt.Sprintf("forwardrule-test-%s", randString(t, 10))
+	backend := fmt.Sprintf("forwardrule-test-%s", randString(t, 10))
+	hc := fmt.Sprintf("forwardrule-test-%s", randString(t, 10))
+	urlmap := fmt.Sprintf("forwardrule-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -84,12 +87,12 @@ func TestAccComputeGlobalForwardingRule_ipv6(t *testing.T) {
 
 	var frule computeBeta.ForwardingRule
 
-	fr := fmt.Sprintf("forwardrule-test-%s", acctest.RandString(10))
-	proxy1 := fmt.Sprintf("forwardrule-test-%s", acctest.RandString(10))
-	proxy2 := fmt.Sprintf("forwardrule-test-%s", acctest.RandString(10))
-	backend := fmt.Sprintf("forwardrule-test-%s", acctest.RandString(10))
-	hc := fmt.Sprintf("forwardrule-test-%s", acctest.RandString(10))
-	urlmap := fmt.Sprintf("forwardrule-test-%s", acctest.RandString(10))
+	fr := fmt.Sprintf("forwardrule-test-%s", randString(t, 10))
+	proxy1 := fmt.Sprintf("forwardrule-test-%s", randString(t, 10))
+	proxy2 := fmt.Sprintf("forwardrule-test-%s", randString(t, 10))
+	backend := fmt.Sprintf("forwardrule-test-%s", randString(t, 10))
+	hc := fmt.Sprintf("forwardrule-test-%s", randString(t, 10))
+	urlmap := fmt.Sprintf("forwardrule-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -118,12 +121,12 @@ func TestAccComputeGlobalForwardingRule_labels(t *testing.T) {
 
 	var frule computeBeta.ForwardingRule
 
-	fr := fmt.Sprintf("forwardrule-test-%s", acctest.RandString(10))
-	proxy1 := fmt.Sprintf("forwardrule-test-%s", acctest.RandString(10))
-	proxy2 := fmt.Sprintf("forwardrule-test-%s", acctest.RandString(10))
-	backend := fmt.Sprintf("forwardrule-test-%s", acctest.RandString(10))
-	hc := fmt.Sprintf("forwardrule-test-%s", acctest.RandString(10))
-	urlmap := fmt.Sprintf("forwardrule-test-%s", acctest.RandString(10))
+	fr := fmt.Sprintf("forwardrule-test-%s", randString(t, 10))
+	proxy1 := fmt.Sprintf("forwardrule-test-%s", randString(t, 10))
+	proxy2 := fmt.Sprintf("forwardrule-test-%s", randString(t, 10))
+	backend := fmt.Sprintf("forwardrule-test-%s", randString(t, 10))
+	hc := fmt.Sprintf("forwardrule-test-%s", randString(t, 10))
+	urlmap := fmt.Sprintf("forwardrule-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -5,18 +5,23 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_health_check", testSweepComputeResources("global/healthChecks"),
+		"gcp_compute_backend_service", "gcp_compute_region_backend_service",
+		"gcp_compute_instance_group_manager", "gcp_compute_region_instance_group_manager")
+}
+
 func TestAccComputeHealthCheck_tcp(t *testing.T) {
 	t.Parallel()
 
 	var healthCheck compute.HealthCheck
 
-	hckName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	hckName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -47,7 +52,7 @@ func TestAccComputeHealthCheck_tcp_update(t *testing.T) {
 
 	var healthCheck compute.HealthCheck
 
-	hckName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	hckName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -83,7 +88,7 @@ func TestAccComputeHealthCheck_ssl(t *testing.T) {
 
 	var healthCheck compute.HealthCheck
 
-	hckName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	hckName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -113,7 +118,7 @@ func TestAccComputeHealthCheck_http(t *testing.T) {
 
 	var healthCheck compute.HealthCheck
 
-	hckName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	hckName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -143,7 +148,7 @@ func TestAccComputeHealthCheck_https(t *testing.T) {
 
 	var healthCheck compute.HealthCheck
 
-	hckName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	hckName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -171,7 +176,7 @@ func TestAccComputeHealthCheck_https(t *testing.T) {
 func TestAccComputeHealthCheck_typeTransition(t *testing.T) {
 	t.Parallel()
 
-	hckName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	hckName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -200,7 +205,7 @@ func TestAccComputeHealthCheck_typeTransition(t *testing.T) {
 func TestAccComputeHealthCheck_tcpAndSsl_shouldFail(t *testing.T) {
 	t.Parallel()
 
-	hckName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	hckName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -160,13 +160,13 @@ func resourceComputeHttpHealthCheckCreate(d *schema.ResourceData, meta interface
 		"unhealthyThreshold": unhealthyThresholdProp,
 	}
 
-	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/global/httpHealthChecks")
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/httpHealthChecks")
 	if err != nil {
 		return err
 	}
 
 	log.Printf("[DEBUG] Creating new HttpHealthCheck: %#v", obj)
-	res, err := Post(config, url, obj)
+	res, err := Post(config, url, project, obj)
 	if err != nil {
 		return fmt.Errorf("Error creating HttpHealthCheck: %s", err)
 	}
@@ -185,12 +185,11 @@ func resourceComputeHttpHealthCheckCreate(d *schema.ResourceData, meta interface
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating HttpHealthCheck",
-		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+		config, op, project, "Creating HttpHealthCheck",
+		d.Timeout(schema.TimeoutCreate))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		clearIdUnlessStopped(d, config)
 		return fmt.Errorf("Error waiting to create HttpHealthCheck: %s", waitErr)
 	}
 
@@ -207,12 +206,12 @@ func resourceComputeHttpHealthCheckRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/global/httpHealthChecks/{{name}}")
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/httpHealthChecks/{{name}}")
 	if err != nil {
 		return err
 	}
 
-	res, err := Get(config, url)
+	res, err := Get(config, url, project)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("ComputeHttpHealthCheck %q", d.Id()))
 	}
@@ -314,13 +313,13 @@ func resourceComputeHttpHealthCheckUpdate(d *schema.ResourceData, meta interface
 		"unhealthyThreshold": unhealthyThresholdProp,
 	}
 
-	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/global/httpHealthChecks/{{name}}")
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/httpHealthChecks/{{name}}")
 	if err != nil {
 		return err
 	}
 
 	log.Printf("[DEBUG] Updating HttpHealthCheck %q: %#v", d.Id(), obj)
-	res, err := sendRequest(config, "PUT", url, obj)
+	res, err := sendRequest(config, "PUT", url, project, obj)
 
 	if err != nil {
 		return fmt.Errorf("Error updating HttpHealthCheck %q: %s", d.Id(), err)
@@ -333,8 +332,8 @@ func resourceComputeHttpHealthCheckUpdate(d *schema.ResourceData, meta interface
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Updating HttpHealthCheck",
-		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+		config, op, project, "Updating HttpHealthCheck",
+		d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
 		return err
@@ -351,13 +350,13 @@ func resourceComputeHttpHealthCheckDelete(d *schema.ResourceData, meta interface
 		return err
 	}
 
-	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/global/httpHealthChecks/{{name}}")
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/httpHealthChecks/{{name}}")
 	if err != nil {
 		return err
 	}
 
 	log.Printf("[DEBUG] Deleting HttpHealthCheck %q", d.Id())
-	res, err := Delete(config, url)
+	res, err := Delete(config, url, project)
 	if err != nil {
 		return handleNotFoundError(err, d, "HttpHealthCheck")
 	}
@@ -369,8 +368,8 @@ func resourceComputeHttpHealthCheckDelete(d *schema.ResourceData, meta interface
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting HttpHealthCheck",
-		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+		config, op, project, "Deleting HttpHealthCheck",
+		d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
 		return err
@@ -160,13 +160,13 @@ func resourceComputeHttpsHealthCheckCreate(d *schema.ResourceData, meta interfac
 		"unhealthyThreshold": unhealthyThresholdProp,
 	}
 
-	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/global/httpsHealthChecks")
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/httpsHealthChecks")
 	if err != nil {
 		return err
 	}
 
 	log.Printf("[DEBUG] Creating new HttpsHealthCheck: %#v", obj)
-	res, err := Post(config, url, obj)
+	res, err := Post(config, url, project, obj)
 	if err != nil {
 		return fmt.Errorf("Error creating HttpsHealthCheck: %s", err)
 	}
@@ -185,12 +185,11 @@ func resourceComputeHttpsHealthCheckCreate(d *schema.ResourceData, meta interfac
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating HttpsHealthCheck",
-		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+		config, op, project, "Creating HttpsHealthCheck",
+		d.Timeout(schema.TimeoutCreate))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		clearIdUnlessStopped(d, config)
 		return fmt.Errorf("Error waiting to create HttpsHealthCheck: %s", waitErr)
 	}
 
@@ -207,12 +206,12 @@ func resourceComputeHttpsHealthCheckRead(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/global/httpsHealthChecks/{{name}}")
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/httpsHealthChecks/{{name}}")
 	if err != nil {
 		return err
 	}
 
-	res, err := Get(config, url)
+	res, err := Get(config, url, project)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("ComputeHttpsHealthCheck %q", d.Id()))
 	}
@@ -314,13 +313,13 @@ func resourceComputeHttpsHealthCheckUpdate(d *schema.ResourceData, meta interfac
 		"unhealthyThreshold": unhealthyThresholdProp,
 	}
 
-	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/global/httpsHealthChecks/{{name}}")
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/httpsHealthChecks/{{name}}")
 	if err != nil {
 		return err
 	}
 
 	log.Printf("[DEBUG] Updating HttpsHealthCheck %q: %#v", d.Id(), obj)
-	res, err := sendRequest(config, "PUT", url, obj)
+	res, err := sendRequest(config, "PUT", url, project, obj)
 
 	if err != nil {
 		return fmt.Errorf("Error updating HttpsHealthCheck %q: %s", d.Id(), err)
@@ -333,8 +332,8 @@ func resourceComputeHttpsHealthCheckUpdate(d *schema.ResourceData, meta interfac
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Updating HttpsHealthCheck",
-		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+		config, op, project, "Updating HttpsHealthCheck",
+		d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
 		return err
@@ -351,13 +350,13 @@ func resourceComputeHttpsHealthCheckDelete(d *schema.ResourceData, meta interfac
 		return err
 	}
 
-	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/global/httpsHealthChecks/{{name}}")
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/httpsHealthChecks/{{name}}")
 	if err != nil {
 		return err
 	}
 
 	log.Printf("[DEBUG] Deleting HttpsHealthCheck %q", d.Id())
-	res, err := Delete(config, url)
+	res, err := Delete(config, url, project)
 	if err != nil {
 		return handleNotFoundError(err, d, "HttpsHealthCheck")
 	}
@@ -369,8 +368,8 @@ func resourceComputeHttpsHealthCheckDelete(d *schema.ResourceData, meta interfac
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting HttpsHealthCheck",
-		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+		config, op, project, "Deleting HttpsHealthCheck",
+		d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
 		return err
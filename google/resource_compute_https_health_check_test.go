@@ -4,18 +4,22 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_https_health_check", testSweepComputeResources("global/httpsHealthChecks"),
+		"gcp_compute_backend_service", "gcp_compute_target_pool")
+}
+
 func TestAccComputeHttpsHealthCheck_basic(t *testing.T) {
 	t.Parallel()
 
 	var healthCheck compute.HttpsHealthCheck
 
-	hhckName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	hhckName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -47,7 +51,7 @@ func TestAccComputeHttpsHealthCheck_update(t *testing.T) {
 
 	var healthCheck compute.HttpsHealthCheck
 
-	hhckName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	hhckName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
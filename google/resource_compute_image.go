@@ -17,6 +17,9 @@ func resourceComputeImage() *schema.Resource {
 		Read:   resourceComputeImageRead,
 		Update: resourceComputeImageUpdate,
 		Delete: resourceComputeImageDelete,
+
+		CustomizeDiff: effectiveLabelsCustomizeDiff(false),
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -106,6 +109,8 @@ func resourceComputeImage() *schema.Resource {
 				Set:      schema.HashString,
 			},
 
+			"effective_labels": effectiveLabelsSchema(),
+
 			"label_fingerprint": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -154,16 +159,14 @@ func resourceComputeImageCreate(d *schema.ResourceData, meta interface{}) error
 		image.RawDisk = imageRawDisk
 	}
 
-	if _, ok := d.GetOk("labels"); ok {
-		image.Labels = expandLabels(d)
-	}
+	image.Labels = expandLabels(d, config)
 
 	// Read create timeout
-	var createTimeout int
+	var createTimeout time.Duration
 	if v, ok := d.GetOk("create_timeout"); ok {
-		createTimeout = v.(int)
+		createTimeout = time.Duration(v.(int)) * time.Minute
 	} else {
-		createTimeout = int(d.Timeout(schema.TimeoutCreate).Minutes())
+		createTimeout = d.Timeout(schema.TimeoutCreate)
 	}
 
 	// Insert the image
@@ -176,7 +179,7 @@ func resourceComputeImageCreate(d *schema.ResourceData, meta interface{}) error
 	// Store the ID
 	d.SetId(image.Name)
 
-	err = computeOperationWaitTime(config.clientCompute, op, project, "Creating Image", createTimeout)
+	err = computeOperationWaitTime(config, op, project, "Creating Image", createTimeout)
 	if err != nil {
 		return err
 	}
@@ -212,7 +215,9 @@ func resourceComputeImageRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("description", image.Description)
 	d.Set("family", image.Family)
 	d.Set("self_link", image.SelfLink)
-	d.Set("labels", image.Labels)
+	if err := setLabels(d, config, image.Labels); err != nil {
+		return fmt.Errorf("Error reading labels: %s", err)
+	}
 	d.Set("label_fingerprint", image.LabelFingerprint)
 	d.Set("project", project)
 
@@ -230,8 +235,8 @@ func resourceComputeImageUpdate(d *schema.ResourceData, meta interface{}) error
 	// Technically we are only updating one attribute, but setting d.Partial here makes it easier to add updates later
 	d.Partial(true)
 
-	if d.HasChange("labels") {
-		labels := expandLabels(d)
+	if hasLabelsChange(d) {
+		labels := expandLabels(d, config)
 		labelFingerprint := d.Get("label_fingerprint").(string)
 		setLabelsRequest := compute.GlobalSetLabelsRequest{
 			LabelFingerprint: labelFingerprint,
@@ -246,7 +251,7 @@ func resourceComputeImageUpdate(d *schema.ResourceData, meta interface{}) error
 
 		d.SetPartial("labels")
 
-		err = computeOperationWaitTime(config.clientCompute, op, project, "Setting labels", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+		err = computeOperationWaitTime(config, op, project, "Setting labels", d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return err
 		}
@@ -279,7 +284,7 @@ func resourceComputeImageDelete(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error deleting image: %s", err)
 	}
 
-	err = computeOperationWaitTime(config.clientCompute, op, project, "Deleting image", int(d.Timeout(schema.TimeoutDelete).Minutes()))
+	err = computeOperationWaitTime(config, op, project, "Deleting image", d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,107 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccComputeImageIamBinding(t *testing.T) {
+	t.Parallel()
+
+	image := "tf-test-" + randString(t, 10)
+	account := randomWithPrefix(t, "tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeImageIamBinding_basic(image, account),
+				Check: testAccCheckIamUpdaterBinding(func(config *Config) ResourceIamUpdater {
+					return &ComputeImageIamUpdater{project: getTestProjectFromEnv(), image: image, Config: config}
+				}, "roles/compute.imageUser", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+			{
+				ResourceName:      "google_compute_image_iam_binding.foo",
+				ImportStateId:     fmt.Sprintf("%s roles/compute.imageUser", fmt.Sprintf("projects/%s/global/images/%s", getTestProjectFromEnv(), image)),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeImageIamMember(t *testing.T) {
+	t.Parallel()
+
+	image := "tf-test-" + randString(t, 10)
+	account := randomWithPrefix(t, "tf-test")
+	accountEmail := fmt.Sprintf("%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeImageIamMember_basic(image, account),
+				Check: testAccCheckIamUpdaterBinding(func(config *Config) ResourceIamUpdater {
+					return &ComputeImageIamUpdater{project: getTestProjectFromEnv(), image: image, Config: config}
+				}, "roles/compute.imageUser", []string{"serviceAccount:" + accountEmail}),
+			},
+			{
+				ResourceName:      "google_compute_image_iam_member.foo",
+				ImportStateId:     fmt.Sprintf("%s roles/compute.imageUser serviceAccount:%s", fmt.Sprintf("projects/%s/global/images/%s", getTestProjectFromEnv(), image), accountEmail),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputeImageIamBinding_basic(image, account string) string {
+	return fmt.Sprintf(`
+resource "google_compute_image" "image" {
+  name = "%s"
+  raw_disk {
+    source = "https://storage.googleapis.com/bosh-cpi-artifacts/bosh-stemcell-3262.4-google-kvm-ubuntu-trusty-go_agent-raw.tar.gz"
+  }
+}
+
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_compute_image_iam_binding" "foo" {
+  image   = "${google_compute_image.image.name}"
+  role    = "roles/compute.imageUser"
+  members = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, image, account)
+}
+
+func testAccComputeImageIamMember_basic(image, account string) string {
+	return fmt.Sprintf(`
+resource "google_compute_image" "image" {
+  name = "%s"
+  raw_disk {
+    source = "https://storage.googleapis.com/bosh-cpi-artifacts/bosh-stemcell-3262.4-google-kvm-ubuntu-trusty-go_agent-raw.tar.gz"
+  }
+}
+
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_compute_image_iam_member" "foo" {
+  image  = "${google_compute_image.image.name}"
+  role   = "roles/compute.imageUser"
+  member = "serviceAccount:${google_service_account.test-account.email}"
+}
+`, image, account)
+}
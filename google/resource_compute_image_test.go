@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_image", testSweepComputeResources("global/images"))
+}
+
 func TestAccComputeImage_basic(t *testing.T) {
 	t.Parallel()
 
@@ -21,7 +24,7 @@ func TestAccComputeImage_basic(t *testing.T) {
 		CheckDestroy: testAccCheckComputeImageDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeImage_basic("image-test-" + acctest.RandString(10)),
+				Config: testAccComputeImage_basic("image-test-" + randString(t, 10)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeImageExists(
 						"google_compute_image.foobar", &image),
@@ -41,7 +44,7 @@ func TestAccComputeImage_update(t *testing.T) {
 
 	var image compute.Image
 
-	name := "image-test-" + acctest.RandString(10)
+	name := "image-test-" + randString(t, 10)
 	// Only labels supports an update
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -90,7 +93,7 @@ func TestAccComputeImage_basedondisk(t *testing.T) {
 		CheckDestroy: testAccCheckComputeImageDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeImage_basedondisk(),
+				Config: testAccComputeImage_basedondisk(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeImageExists(
 						"google_compute_image.foobar", &image),
@@ -259,7 +262,7 @@ resource "google_compute_image" "foobar" {
 }`, name)
 }
 
-func testAccComputeImage_basedondisk() string {
+func testAccComputeImage_basedondisk(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_disk" "foobar" {
 	name = "disk-test-%s"
@@ -269,5 +272,5 @@ resource "google_compute_disk" "foobar" {
 resource "google_compute_image" "foobar" {
 	name = "image-test-%s"
 	source_disk = "${google_compute_disk.foobar.self_link}"
-}`, acctest.RandString(10), acctest.RandString(10))
+}`, randString(t, 10), randString(t, 10))
 }
@@ -12,20 +12,24 @@ import (
 
 	"sort"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_instance_group_manager", testSweepComputeResources("aggregated/instanceGroupManagers"),
+		"gcp_compute_autoscaler", "gcp_compute_backend_service", "gcp_compute_region_backend_service")
+}
+
 func TestAccInstanceGroupManager_basic(t *testing.T) {
 	t.Parallel()
 
 	var manager compute.InstanceGroupManager
 
-	template := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	target := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	igm1 := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	igm2 := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+	template := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	target := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	igm1 := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	igm2 := fmt.Sprintf("igm-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -60,8 +64,8 @@ func TestAccInstanceGroupManager_targetSizeZero(t *testing.T) {
 
 	var manager compute.InstanceGroupManager
 
-	templateName := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	igmName := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+	templateName := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	igmName := fmt.Sprintf("igm-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -88,11 +92,11 @@ func TestAccInstanceGroupManager_update(t *testing.T) {
 
 	var manager compute.InstanceGroupManager
 
-	template1 := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	target1 := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	target2 := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	template2 := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	igm := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+	template1 := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	target1 := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	target2 := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	template2 := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	igm := fmt.Sprintf("igm-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -136,7 +140,7 @@ func TestAccInstanceGroupManager_updateLifecycle(t *testing.T) {
 
 	tag1 := "tag1"
 	tag2 := "tag2"
-	igm := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+	igm := fmt.Sprintf("igm-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -167,7 +171,7 @@ func TestAccInstanceGroupManager_updateStrategy(t *testing.T) {
 	t.Parallel()
 
 	var manager compute.InstanceGroupManager
-	igm := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+	igm := fmt.Sprintf("igm-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -192,7 +196,7 @@ func TestAccInstanceGroupManager_rollingUpdatePolicy(t *testing.T) {
 
 	var manager computeBeta.InstanceGroupManager
 
-	igm := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+	igm := fmt.Sprintf("igm-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -248,8 +252,8 @@ func TestAccInstanceGroupManager_separateRegions(t *testing.T) {
 
 	var manager compute.InstanceGroupManager
 
-	igm1 := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	igm2 := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+	igm1 := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	igm2 := fmt.Sprintf("igm-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -274,10 +278,10 @@ func TestAccInstanceGroupManager_autoHealingPolicies(t *testing.T) {
 
 	var manager computeBeta.InstanceGroupManager
 
-	template := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	target := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	igm := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	hck := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+	template := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	target := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	igm := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	hck := fmt.Sprintf("igm-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -310,11 +314,11 @@ func TestAccInstanceGroupManager_selfLinkStability(t *testing.T) {
 
 	var manager computeBeta.InstanceGroupManager
 
-	template := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	target := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	igm := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	hck := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	autoscaler := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+	template := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	target := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	igm := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	hck := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	autoscaler := fmt.Sprintf("igm-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -6,16 +6,21 @@ import (
 
 	"google.golang.org/api/compute/v1"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_instance_group", testSweepComputeResources("aggregated/instanceGroups"),
+		"gcp_compute_backend_service", "gcp_compute_region_backend_service",
+		"gcp_compute_instance_group_manager")
+}
+
 func TestAccComputeInstanceGroup_basic(t *testing.T) {
 	t.Parallel()
 
 	var instanceGroup compute.InstanceGroup
-	var instanceName = fmt.Sprintf("instancegroup-test-%s", acctest.RandString(10))
+	var instanceName = fmt.Sprintf("instancegroup-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -44,7 +49,7 @@ func TestAccComputeInstanceGroup_recreatedInstances(t *testing.T) {
 	t.Parallel()
 
 	var instanceGroup compute.InstanceGroup
-	var instanceName = fmt.Sprintf("instancegroup-test-%s", acctest.RandString(10))
+	var instanceName = fmt.Sprintf("instancegroup-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -75,7 +80,7 @@ func TestAccComputeInstanceGroup_update(t *testing.T) {
 	t.Parallel()
 
 	var instanceGroup compute.InstanceGroup
-	var instanceName = fmt.Sprintf("instancegroup-test-%s", acctest.RandString(10))
+	var instanceName = fmt.Sprintf("instancegroup-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -114,7 +119,7 @@ func TestAccComputeInstanceGroup_outOfOrderInstances(t *testing.T) {
 	t.Parallel()
 
 	var instanceGroup compute.InstanceGroup
-	var instanceName = fmt.Sprintf("instancegroup-test-%s", acctest.RandString(10))
+	var instanceName = fmt.Sprintf("instancegroup-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -136,7 +141,7 @@ func TestAccComputeInstanceGroup_network(t *testing.T) {
 	t.Parallel()
 
 	var instanceGroup compute.InstanceGroup
-	var instanceName = fmt.Sprintf("instancegroup-test-%s", acctest.RandString(10))
+	var instanceName = fmt.Sprintf("instancegroup-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -0,0 +1,156 @@
+package google
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeInstanceIamBinding(t *testing.T) {
+	t.Parallel()
+
+	instance := "tf-test-" + randString(t, 10)
+	account := randomWithPrefix(t, "tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstanceIamBinding_basic(instance, account),
+				Check: testAccCheckIamUpdaterBinding(func(config *Config) ResourceIamUpdater {
+					return &ComputeInstanceIamUpdater{project: getTestProjectFromEnv(), zone: "us-central1-a", instanceName: instance, Config: config}
+				}, "roles/compute.osLogin", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+			{
+				ResourceName:      "google_compute_instance_iam_binding.foo",
+				ImportStateId:     fmt.Sprintf("%s roles/compute.osLogin", fmt.Sprintf("projects/%s/zones/us-central1-a/instances/%s", getTestProjectFromEnv(), instance)),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeInstanceIamMember(t *testing.T) {
+	t.Parallel()
+
+	instance := "tf-test-" + randString(t, 10)
+	account := randomWithPrefix(t, "tf-test")
+	accountEmail := fmt.Sprintf("%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstanceIamMember_basic(instance, account),
+				Check: testAccCheckIamUpdaterBinding(func(config *Config) ResourceIamUpdater {
+					return &ComputeInstanceIamUpdater{project: getTestProjectFromEnv(), zone: "us-central1-a", instanceName: instance, Config: config}
+				}, "roles/compute.osLogin", []string{"serviceAccount:" + accountEmail}),
+			},
+			{
+				ResourceName:      "google_compute_instance_iam_member.foo",
+				ImportStateId:     fmt.Sprintf("%s roles/compute.osLogin serviceAccount:%s", fmt.Sprintf("projects/%s/zones/us-central1-a/instances/%s", getTestProjectFromEnv(), instance), accountEmail),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputeInstanceIamBinding_basic(instance, account string) string {
+	return fmt.Sprintf(`
+resource "google_compute_instance" "instance" {
+  name         = "%s"
+  machine_type = "n1-standard-1"
+  zone         = "us-central1-a"
+
+  boot_disk {
+    initialize_params {
+      image = "debian-cloud/debian-9"
+    }
+  }
+
+  network_interface {
+    network = "default"
+  }
+}
+
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_compute_instance_iam_binding" "foo" {
+  zone          = "${google_compute_instance.instance.zone}"
+  instance_name = "${google_compute_instance.instance.name}"
+  role    = "roles/compute.osLogin"
+  members = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, instance, account)
+}
+
+func testAccComputeInstanceIamMember_basic(instance, account string) string {
+	return fmt.Sprintf(`
+resource "google_compute_instance" "instance" {
+  name         = "%s"
+  machine_type = "n1-standard-1"
+  zone         = "us-central1-a"
+
+  boot_disk {
+    initialize_params {
+      image = "debian-cloud/debian-9"
+    }
+  }
+
+  network_interface {
+    network = "default"
+  }
+}
+
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_compute_instance_iam_member" "foo" {
+  zone          = "${google_compute_instance.instance.zone}"
+  instance_name = "${google_compute_instance.instance.name}"
+  role   = "roles/compute.osLogin"
+  member = "serviceAccount:${google_service_account.test-account.email}"
+}
+`, instance, account)
+}
+
+// testAccCheckIamUpdaterBinding checks that the policy read by the updater
+// returned by newUpdater grants role to exactly members.
+func testAccCheckIamUpdaterBinding(newUpdater func(config *Config) ResourceIamUpdater, role string, members []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		updater := newUpdater(testAccProvider.Meta().(*Config))
+		p, err := updater.GetResourceIamPolicy()
+		if err != nil {
+			return err
+		}
+
+		for _, binding := range p.Bindings {
+			if binding.Role != role || binding.Condition != nil {
+				continue
+			}
+			sort.Strings(members)
+			sort.Strings(binding.Members)
+			if reflect.DeepEqual(members, binding.Members) {
+				return nil
+			}
+			return fmt.Errorf("Binding found but expected members is %v, got %v", members, binding.Members)
+		}
+
+		return fmt.Errorf("No binding for role %q in the policy of %s", role, updater.DescribeResource())
+	}
+}
@@ -20,6 +20,8 @@ func resourceComputeInstanceTemplate() *schema.Resource {
 		SchemaVersion: 1,
 		MigrateState:  resourceComputeInstanceTemplateMigrateState,
 
+		CustomizeDiff: effectiveLabelsCustomizeDiff(true),
+
 		// A compute instance template is more or less a subset of a compute
 		// instance. Please attempt to maintain consistency with the
 		// resource_compute_instance schema when updating this one.
@@ -407,6 +409,8 @@ func resourceComputeInstanceTemplate() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+
+			"effective_labels": effectiveLabelsSchema(),
 		},
 	}
 }
@@ -589,9 +593,7 @@ func resourceComputeInstanceTemplateCreate(d *schema.ResourceData, meta interfac
 	instanceProperties.GuestAccelerators = expandInstanceTemplateGuestAccelerators(d, config)
 
 	instanceProperties.Tags = resourceInstanceTags(d)
-	if _, ok := d.GetOk("labels"); ok {
-		instanceProperties.Labels = expandLabels(d)
-	}
+	instanceProperties.Labels = expandLabels(d, config)
 
 	var itName string
 	if v, ok := d.GetOk("name"); ok {
@@ -615,7 +617,7 @@ func resourceComputeInstanceTemplateCreate(d *schema.ResourceData, meta interfac
 	// Store the ID now
 	d.SetId(instanceTemplate.Name)
 
-	err = computeSharedOperationWait(config.clientCompute, op, project, "Creating Instance Template")
+	err = computeSharedOperationWait(config, op, project, "Creating Instance Template")
 	if err != nil {
 		return err
 	}
@@ -689,8 +691,8 @@ func resourceComputeInstanceTemplateRead(d *schema.ResourceData, meta interface{
 			return fmt.Errorf("Error setting tags_fingerprint: %s", err)
 		}
 	}
-	if instanceTemplate.Properties.Labels != nil {
-		d.Set("labels", instanceTemplate.Properties.Labels)
+	if err = setLabels(d, config, instanceTemplate.Properties.Labels); err != nil {
+		return fmt.Errorf("Error setting labels: %s", err)
 	}
 	if err = d.Set("self_link", instanceTemplate.SelfLink); err != nil {
 		return fmt.Errorf("Error setting self_link: %s", err)
@@ -776,7 +778,7 @@ func resourceComputeInstanceTemplateDelete(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("Error deleting instance template: %s", err)
 	}
 
-	err = computeOperationWait(config.clientCompute, op, project, "Deleting Instance Template")
+	err = computeOperationWait(config, op, project, "Deleting Instance Template")
 	if err != nil {
 		return err
 	}
@@ -6,12 +6,16 @@ import (
 	"testing"
 	"time"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_instance_template", testSweepComputeResources("global/instanceTemplates"),
+		"gcp_compute_instance_group_manager", "gcp_compute_region_instance_group_manager")
+}
+
 const DEFAULT_MIN_CPU_TEST_VALUE = "Intel Haswell"
 
 func TestAccComputeInstanceTemplate_basic(t *testing.T) {
@@ -25,7 +29,7 @@ func TestAccComputeInstanceTemplate_basic(t *testing.T) {
 		CheckDestroy: testAccCheckComputeInstanceTemplateDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeInstanceTemplate_basic(),
+				Config: testAccComputeInstanceTemplate_basic(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeInstanceTemplateExists(
 						"google_compute_instance_template.foobar", &instanceTemplate),
@@ -55,7 +59,7 @@ func TestAccComputeInstanceTemplate_preemptible(t *testing.T) {
 		CheckDestroy: testAccCheckComputeInstanceTemplateDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeInstanceTemplate_preemptible(),
+				Config: testAccComputeInstanceTemplate_preemptible(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeInstanceTemplateExists(
 						"google_compute_instance_template.foobar", &instanceTemplate),
@@ -83,7 +87,7 @@ func TestAccComputeInstanceTemplate_IP(t *testing.T) {
 		CheckDestroy: testAccCheckComputeInstanceTemplateDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeInstanceTemplate_ip(),
+				Config: testAccComputeInstanceTemplate_ip(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeInstanceTemplateExists(
 						"google_compute_instance_template.foobar", &instanceTemplate),
@@ -111,7 +115,7 @@ func TestAccComputeInstanceTemplate_networkIP(t *testing.T) {
 		CheckDestroy: testAccCheckComputeInstanceTemplateDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeInstanceTemplate_networkIP(networkIP),
+				Config: testAccComputeInstanceTemplate_networkIP(t, networkIP),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeInstanceTemplateExists(
 						"google_compute_instance_template.foobar", &instanceTemplate),
@@ -140,7 +144,7 @@ func TestAccComputeInstanceTemplate_address(t *testing.T) {
 		CheckDestroy: testAccCheckComputeInstanceTemplateDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeInstanceTemplate_address(address),
+				Config: testAccComputeInstanceTemplate_address(t, address),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeInstanceTemplateExists(
 						"google_compute_instance_template.foobar", &instanceTemplate),
@@ -169,7 +173,7 @@ func TestAccComputeInstanceTemplate_disks(t *testing.T) {
 		CheckDestroy: testAccCheckComputeInstanceTemplateDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeInstanceTemplate_disks(),
+				Config: testAccComputeInstanceTemplate_disks(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeInstanceTemplateExists(
 						"google_compute_instance_template.foobar", &instanceTemplate),
@@ -190,7 +194,7 @@ func TestAccComputeInstanceTemplate_subnet_auto(t *testing.T) {
 	t.Parallel()
 
 	var instanceTemplate compute.InstanceTemplate
-	network := "network-" + acctest.RandString(10)
+	network := "network-" + randString(t, 10)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -198,7 +202,7 @@ func TestAccComputeInstanceTemplate_subnet_auto(t *testing.T) {
 		CheckDestroy: testAccCheckComputeInstanceTemplateDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeInstanceTemplate_subnet_auto(network),
+				Config: testAccComputeInstanceTemplate_subnet_auto(t, network),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeInstanceTemplateExists(
 						"google_compute_instance_template.foobar", &instanceTemplate),
@@ -225,7 +229,7 @@ func TestAccComputeInstanceTemplate_subnet_custom(t *testing.T) {
 		CheckDestroy: testAccCheckComputeInstanceTemplateDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeInstanceTemplate_subnet_custom(),
+				Config: testAccComputeInstanceTemplate_subnet_custom(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeInstanceTemplateExists(
 						"google_compute_instance_template.foobar", &instanceTemplate),
@@ -255,7 +259,7 @@ func TestAccComputeInstanceTemplate_subnet_xpn(t *testing.T) {
 		CheckDestroy: testAccCheckComputeInstanceTemplateDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeInstanceTemplate_subnet_xpn(org, billingId, projectName),
+				Config: testAccComputeInstanceTemplate_subnet_xpn(t, org, billingId, projectName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeInstanceTemplateExistsInProject(
 						"google_compute_instance_template.foobar", fmt.Sprintf("%s-service", projectName),
@@ -278,7 +282,7 @@ func TestAccComputeInstanceTemplate_metadata_startup_script(t *testing.T) {
 		CheckDestroy: testAccCheckComputeInstanceTemplateDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeInstanceTemplate_startup_script(),
+				Config: testAccComputeInstanceTemplate_startup_script(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeInstanceTemplateExists(
 						"google_compute_instance_template.foobar", &instanceTemplate),
@@ -299,7 +303,7 @@ func TestAccComputeInstanceTemplate_primaryAliasIpRange(t *testing.T) {
 		CheckDestroy: testAccCheckComputeInstanceTemplateDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeInstanceTemplate_primaryAliasIpRange(acctest.RandString(10)),
+				Config: testAccComputeInstanceTemplate_primaryAliasIpRange(randString(t, 10)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeInstanceTemplateExists("google_compute_instance_template.foobar", &instanceTemplate),
 					testAccCheckComputeInstanceTemplateHasAliasIpRange(&instanceTemplate, "", "/24"),
@@ -325,7 +329,7 @@ func TestAccComputeInstanceTemplate_secondaryAliasIpRange(t *testing.T) {
 		CheckDestroy: testAccCheckComputeInstanceTemplateDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeInstanceTemplate_secondaryAliasIpRange(acctest.RandString(10)),
+				Config: testAccComputeInstanceTemplate_secondaryAliasIpRange(randString(t, 10)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeInstanceTemplateExists("google_compute_instance_template.foobar", &instanceTemplate),
 					testAccCheckComputeInstanceTemplateHasAliasIpRange(&instanceTemplate, "inst-test-secondary", "/24"),
@@ -351,7 +355,7 @@ func TestAccComputeInstanceTemplate_guestAccelerator(t *testing.T) {
 		CheckDestroy: testAccCheckComputeInstanceTemplateDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeInstanceTemplate_guestAccelerator(acctest.RandString(10), 1),
+				Config: testAccComputeInstanceTemplate_guestAccelerator(randString(t, 10), 1),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeInstanceTemplateExists("google_compute_instance_template.foobar", &instanceTemplate),
 					testAccCheckComputeInstanceTemplateHasGuestAccelerator(&instanceTemplate, "nvidia-tesla-k80", 1),
@@ -378,7 +382,7 @@ func TestAccComputeInstanceTemplate_guestAcceleratorSkip(t *testing.T) {
 		CheckDestroy: testAccCheckComputeInstanceTemplateDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeInstanceTemplate_guestAccelerator(acctest.RandString(10), 0),
+				Config: testAccComputeInstanceTemplate_guestAccelerator(randString(t, 10), 0),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeInstanceTemplateExists("google_compute_instance_template.foobar", &instanceTemplate),
 					testAccCheckComputeInstanceTemplateLacksGuestAccelerator(&instanceTemplate),
@@ -400,7 +404,7 @@ func TestAccComputeInstanceTemplate_minCpuPlatform(t *testing.T) {
 		CheckDestroy: testAccCheckComputeInstanceTemplateDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeInstanceTemplate_minCpuPlatform(acctest.RandString(10)),
+				Config: testAccComputeInstanceTemplate_minCpuPlatform(randString(t, 10)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeInstanceTemplateExists("google_compute_instance_template.foobar", &instanceTemplate),
 					testAccCheckComputeInstanceTemplateHasMinCpuPlatform(&instanceTemplate, DEFAULT_MIN_CPU_TEST_VALUE),
@@ -706,7 +710,7 @@ func testAccCheckComputeInstanceTemplateHasMinCpuPlatform(instanceTemplate *comp
 	}
 }
 
-func testAccComputeInstanceTemplate_basic() string {
+func testAccComputeInstanceTemplate_basic(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_instance_template" "foobar" {
 	name = "instancet-test-%s"
@@ -740,10 +744,10 @@ resource "google_compute_instance_template" "foobar" {
     labels {
         my_label = "foobar"
     }
-}`, acctest.RandString(10))
+}`, randString(t, 10))
 }
 
-func testAccComputeInstanceTemplate_preemptible() string {
+func testAccComputeInstanceTemplate_preemptible(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_instance_template" "foobar" {
 	name = "instancet-test-%s"
@@ -773,10 +777,10 @@ resource "google_compute_instance_template" "foobar" {
 	service_account {
 		scopes = ["userinfo-email", "compute-ro", "storage-ro"]
 	}
-}`, acctest.RandString(10))
+}`, randString(t, 10))
 }
 
-func testAccComputeInstanceTemplate_ip() string {
+func testAccComputeInstanceTemplate_ip(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_address" "foo" {
 	name = "instancet-test-%s"
@@ -801,10 +805,10 @@ resource "google_compute_instance_template" "foobar" {
 	metadata {
 		foo = "bar"
 	}
-}`, acctest.RandString(10), acctest.RandString(10))
+}`, randString(t, 10), randString(t, 10))
 }
 
-func testAccComputeInstanceTemplate_networkIP(networkIP string) string {
+func testAccComputeInstanceTemplate_networkIP(t *testing.T, networkIP string) string {
 	return fmt.Sprintf(`
 resource "google_compute_instance_template" "foobar" {
 	name = "instancet-test-%s"
@@ -823,10 +827,10 @@ resource "google_compute_instance_template" "foobar" {
 	metadata {
 		foo = "bar"
 	}
-}`, acctest.RandString(10), networkIP)
+}`, randString(t, 10), networkIP)
 }
 
-func testAccComputeInstanceTemplate_address(address string) string {
+func testAccComputeInstanceTemplate_address(t *testing.T, address string) string {
 	return fmt.Sprintf(`
 resource "google_compute_instance_template" "foobar" {
 	name = "instancet-test-%s"
@@ -845,10 +849,10 @@ resource "google_compute_instance_template" "foobar" {
 	metadata {
 		foo = "bar"
 	}
-}`, acctest.RandString(10), address)
+}`, randString(t, 10), address)
 }
 
-func testAccComputeInstanceTemplate_disks() string {
+func testAccComputeInstanceTemplate_disks(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_disk" "foobar" {
 	name = "instancet-test-%s"
@@ -882,10 +886,10 @@ resource "google_compute_instance_template" "foobar" {
 	metadata {
 		foo = "bar"
 	}
-}`, acctest.RandString(10), acctest.RandString(10))
+}`, randString(t, 10), randString(t, 10))
 }
 
-func testAccComputeInstanceTemplate_subnet_auto(network string) string {
+func testAccComputeInstanceTemplate_subnet_auto(t *testing.T, network string) string {
 	return fmt.Sprintf(`
 	resource "google_compute_network" "auto-network" {
 		name = "%s"
@@ -910,10 +914,10 @@ func testAccComputeInstanceTemplate_subnet_auto(network string) string {
 		metadata {
 			foo = "bar"
 		}
-	}`, network, acctest.RandString(10))
+	}`, network, randString(t, 10))
 }
 
-func testAccComputeInstanceTemplate_subnet_custom() string {
+func testAccComputeInstanceTemplate_subnet_custom(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_network" "network" {
 	name = "network-%s"
@@ -946,10 +950,10 @@ resource "google_compute_instance_template" "foobar" {
 	metadata {
 		foo = "bar"
 	}
-}`, acctest.RandString(10), acctest.RandString(10), acctest.RandString(10))
+}`, randString(t, 10), randString(t, 10), randString(t, 10))
 }
 
-func testAccComputeInstanceTemplate_subnet_xpn(org, billingId, projectName string) string {
+func testAccComputeInstanceTemplate_subnet_xpn(t *testing.T, org, billingId, projectName string) string {
 	return fmt.Sprintf(`
 	resource "google_project" "host_project" {
 		name = "Test Project XPN Host"
@@ -1019,10 +1023,10 @@ func testAccComputeInstanceTemplate_subnet_xpn(org, billingId, projectName strin
 			foo = "bar"
 		}
 		project = "${google_compute_shared_vpc_service_project.service_project.service_project}"
-	}`, projectName, org, billingId, projectName, org, billingId, acctest.RandString(10), acctest.RandString(10), acctest.RandString(10))
+	}`, projectName, org, billingId, projectName, org, billingId, randString(t, 10), randString(t, 10), randString(t, 10))
 }
 
-func testAccComputeInstanceTemplate_startup_script() string {
+func testAccComputeInstanceTemplate_startup_script(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_instance_template" "foobar" {
 	name = "instance-test-%s"
@@ -1044,7 +1048,7 @@ resource "google_compute_instance_template" "foobar" {
 	}
 
 	metadata_startup_script = "echo 'Hello'"
-}`, acctest.RandString(10))
+}`, randString(t, 10))
 }
 
 func testAccComputeInstanceTemplate_primaryAliasIpRange(i string) string {
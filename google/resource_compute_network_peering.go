@@ -17,6 +17,9 @@ func resourceComputeNetworkPeering() *schema.Resource {
 		Create: resourceComputeNetworkPeeringCreate,
 		Read:   resourceComputeNetworkPeeringRead,
 		Delete: resourceComputeNetworkPeeringDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeNetworkPeeringImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -75,7 +78,7 @@ func resourceComputeNetworkPeeringCreate(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error adding network peering: %s", err)
 	}
 
-	err = computeOperationWait(config.clientCompute, addOp, networkFieldValue.Project, "Adding Network Peering")
+	err = computeOperationWait(config, addOp, networkFieldValue.Project, "Adding Network Peering")
 	if err != nil {
 		return err
 	}
@@ -145,7 +148,7 @@ func resourceComputeNetworkPeeringDelete(d *schema.ResourceData, meta interface{
 			return fmt.Errorf("Error removing peering `%s` from network `%s`: %s", name, networkFieldValue.Name, err)
 		}
 	} else {
-		err = computeOperationWait(config.clientCompute, removeOp, networkFieldValue.Project, "Removing Network Peering")
+		err = computeOperationWait(config, removeOp, networkFieldValue.Project, "Removing Network Peering")
 		if err != nil {
 			return err
 		}
@@ -154,6 +157,21 @@ func resourceComputeNetworkPeeringDelete(d *schema.ResourceData, meta interface{
 	return nil
 }
 
+func resourceComputeNetworkPeeringImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	// The network is stored as a self link, and peerings have no project
+	// of their own, so parse the project and name of the network aside.
+	fields, err := parseImportIdFields([]string{"(?P<project>[^/]+)/(?P<network>[^/]+)/(?P<name>[^/]+)", "(?P<network>[^/]+)/(?P<name>[^/]+)"}, []string{"project", "network"}, d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("network", fmt.Sprintf("%sprojects/%s/global/networks/%s", config.basePath(ComputeBasePathKey), fields["project"], fields["network"]))
+	d.SetId(fmt.Sprintf("%s/%s", fields["network"], d.Get("name").(string)))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func findPeeringFromNetwork(network *compute.Network, peeringName string) *compute.NetworkPeering {
 	for _, p := range network.Peerings {
 		if p.Name == peeringName {
@@ -2,7 +2,6 @@ package google
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/compute/v1"
@@ -21,7 +20,7 @@ func TestAccComputeNetworkPeering_basic(t *testing.T) {
 		CheckDestroy: testAccComputeNetworkPeeringDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeNetworkPeering_basic(),
+				Config: testAccComputeNetworkPeering_basic(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeNetworkPeeringExist("google_compute_network_peering.foo", &peering),
 					testAccCheckComputeNetworkPeeringAutoCreateRoutes(true, &peering),
@@ -29,6 +28,11 @@ func TestAccComputeNetworkPeering_basic(t *testing.T) {
 					testAccCheckComputeNetworkPeeringAutoCreateRoutes(true, &peering),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "google_compute_network_peering.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 
@@ -97,7 +101,7 @@ func testAccCheckComputeNetworkPeeringAutoCreateRoutes(v bool, peering *compute.
 	}
 }
 
-func testAccComputeNetworkPeering_basic() string {
+func testAccComputeNetworkPeering_basic(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_network" "network1" {
 	name = "network-test-1-%s"
@@ -121,5 +125,5 @@ resource "google_compute_network_peering" "bar" {
 	network = "${google_compute_network.network2.self_link}"
 	peer_network = "${google_compute_network.network1.self_link}"
 }
-`, acctest.RandString(10), acctest.RandString(10), acctest.RandString(10), acctest.RandString(10))
+`, randString(t, 10), randString(t, 10), randString(t, 10), randString(t, 10))
 }
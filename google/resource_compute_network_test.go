@@ -4,12 +4,20 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-google/google/fakegcp"
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_network", testSweepComputeResources("global/networks"),
+		"gcp_compute_subnetwork", "gcp_compute_firewall", "gcp_compute_route", "gcp_compute_router",
+		"gcp_compute_vpn_gateway", "gcp_compute_global_address", "gcp_compute_instance",
+		"gcp_compute_instance_template", "gcp_container_cluster", "gcp_dataproc_cluster",
+		"gcp_redis_instance")
+}
+
 func TestAccComputeNetwork_basic(t *testing.T) {
 	t.Parallel()
 
@@ -21,7 +29,7 @@ func TestAccComputeNetwork_basic(t *testing.T) {
 		CheckDestroy: testAccCheckComputeNetworkDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeNetwork_basic(),
+				Config: testAccComputeNetwork_basic(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeNetworkExists(
 						"google_compute_network.foobar", &network),
@@ -47,7 +55,7 @@ func TestAccComputeNetwork_auto_subnet(t *testing.T) {
 		CheckDestroy: testAccCheckComputeNetworkDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeNetwork_auto_subnet(),
+				Config: testAccComputeNetwork_auto_subnet(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeNetworkExists(
 						"google_compute_network.bar", &network),
@@ -75,7 +83,7 @@ func TestAccComputeNetwork_custom_subnet(t *testing.T) {
 		CheckDestroy: testAccCheckComputeNetworkDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeNetwork_custom_subnet(),
+				Config: testAccComputeNetwork_custom_subnet(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeNetworkExists(
 						"google_compute_network.baz", &network),
@@ -103,7 +111,7 @@ func TestAccComputeNetwork_routing_mode(t *testing.T) {
 		CheckDestroy: testAccCheckComputeNetworkDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeNetwork_routing_mode("GLOBAL"),
+				Config: testAccComputeNetwork_routing_mode(t, "GLOBAL"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeNetworkExists(
 						"google_compute_network.acc_network_routing_mode", &network),
@@ -113,7 +121,7 @@ func TestAccComputeNetwork_routing_mode(t *testing.T) {
 			},
 			// Test updating the routing field (only updateable field).
 			resource.TestStep{
-				Config: testAccComputeNetwork_routing_mode("REGIONAL"),
+				Config: testAccComputeNetwork_routing_mode(t, "REGIONAL"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeNetworkExists(
 						"google_compute_network.acc_network_routing_mode", &network),
@@ -138,7 +146,7 @@ func TestAccComputeNetwork_default_routing_mode(t *testing.T) {
 		CheckDestroy: testAccCheckComputeNetworkDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeNetwork_basic(),
+				Config: testAccComputeNetwork_basic(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeNetworkExists(
 						"google_compute_network.foobar", &network),
@@ -150,6 +158,33 @@ func TestAccComputeNetwork_default_routing_mode(t *testing.T) {
 	})
 }
 
+func TestFakeComputeNetwork_routingMode(t *testing.T) {
+	t.Parallel()
+
+	s := newFakeGcpServer(t)
+	defer s.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testFakeGcpProviders(),
+		CheckDestroy: testFakeGcpCheckDestroy(s),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testFakeComputeNetwork_routingMode(s, "GLOBAL"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_network.foobar", "routing_mode", "GLOBAL"),
+					resource.TestCheckResourceAttr("google_compute_network.foobar", "self_link",
+						s.URL()+"/compute/v1/projects/fake-project/global/networks/network-test"),
+				),
+			},
+			testFakeGcpImportStep(s, "google_compute_network.foobar"),
+			resource.TestStep{
+				Config: testFakeComputeNetwork_routingMode(s, "REGIONAL"),
+				Check:  resource.TestCheckResourceAttr("google_compute_network.foobar", "routing_mode", "REGIONAL"),
+			},
+		},
+	})
+}
+
 func testAccCheckComputeNetworkDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -270,33 +305,42 @@ func testAccCheckComputeNetworkHasRoutingMode(n string, network *compute.Network
 	}
 }
 
-func testAccComputeNetwork_basic() string {
+func testAccComputeNetwork_basic(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_network" "foobar" {
 	name = "network-test-%s"
-}`, acctest.RandString(10))
+}`, randString(t, 10))
 }
 
-func testAccComputeNetwork_auto_subnet() string {
+func testAccComputeNetwork_auto_subnet(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_network" "bar" {
 	name = "network-test-%s"
 	auto_create_subnetworks = true
-}`, acctest.RandString(10))
+}`, randString(t, 10))
 }
 
-func testAccComputeNetwork_custom_subnet() string {
+func testAccComputeNetwork_custom_subnet(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_network" "baz" {
 	name = "network-test-%s"
 	auto_create_subnetworks = false
-}`, acctest.RandString(10))
+}`, randString(t, 10))
 }
 
-func testAccComputeNetwork_routing_mode(routingMode string) string {
+func testAccComputeNetwork_routing_mode(t *testing.T, routingMode string) string {
 	return fmt.Sprintf(`
 resource "google_compute_network" "acc_network_routing_mode" {
 	name         = "network-test-%s"
 	routing_mode = "%s"
-}`, acctest.RandString(10), routingMode)
+}`, randString(t, 10), routingMode)
+}
+
+func testFakeComputeNetwork_routingMode(s *fakegcp.Server, routingMode string) string {
+	return testFakeGcpProviderConfig(s) + fmt.Sprintf(`
+resource "google_compute_network" "foobar" {
+	name                    = "network-test"
+	auto_create_subnetworks = false
+	routing_mode            = "%s"
+}`, routingMode)
 }
@@ -14,6 +14,9 @@ func resourceComputeProjectMetadata() *schema.Resource {
 		Read:   resourceComputeProjectMetadataRead,
 		Update: resourceComputeProjectMetadataUpdate,
 		Delete: resourceComputeProjectMetadataDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeProjectMetadataImport,
+		},
 
 		SchemaVersion: 0,
 
@@ -77,7 +80,7 @@ func resourceComputeProjectMetadataCreate(d *schema.ResourceData, meta interface
 
 		log.Printf("[DEBUG] SetCommonMetadata: %d (%s)", op.Id, op.SelfLink)
 
-		return computeOperationWait(config.clientCompute, op, project.Name, "SetCommonMetadata")
+		return computeOperationWait(config, op, project.Name, "SetCommonMetadata")
 	}
 
 	err = MetadataRetryWrapper(createMD)
@@ -156,7 +159,7 @@ func resourceComputeProjectMetadataUpdate(d *schema.ResourceData, meta interface
 			// Optimistic locking requires the fingerprint received to match
 			// the fingerprint we send the server, if there is a mismatch then we
 			// are working on old data, and must retry
-			return computeOperationWait(config.clientCompute, op, project.Name, "SetCommonMetadata")
+			return computeOperationWait(config, op, project.Name, "SetCommonMetadata")
 		}
 
 		err := MetadataRetryWrapper(updateMD)
@@ -198,10 +201,32 @@ func resourceComputeProjectMetadataDelete(d *schema.ResourceData, meta interface
 
 	log.Printf("[DEBUG] SetCommonMetadata: %d (%s)", op.Id, op.SelfLink)
 
-	err = computeOperationWait(config.clientCompute, op, project.Name, "SetCommonMetadata")
+	err = computeOperationWait(config, op, project.Name, "SetCommonMetadata")
 	if err != nil {
 		return err
 	}
 
 	return resourceComputeProjectMetadataRead(d, meta)
 }
+
+func resourceComputeProjectMetadataImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)", "(?P<project>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Only the keys already in state are read, so start from every key of
+	// the project.
+	projectID := d.Get("project").(string)
+	project, err := config.clientCompute.Projects.Get(projectID).Do()
+	if err != nil {
+		return nil, fmt.Errorf("Error loading project '%s': %s", projectID, err)
+	}
+	if err := d.Set("metadata", flattenMetadata(project.CommonInstanceMetadata)); err != nil {
+		return nil, fmt.Errorf("Error setting metadata: %s", err)
+	}
+
+	d.SetId("common_metadata")
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -14,7 +13,7 @@ func TestAccComputeProjectMetadataItem_basic(t *testing.T) {
 	// Key must be unique to avoid concurrent tests interfering with each other
 	)
 
-	key := "myKey" + acctest.RandString(10)
+	key := "myKey" + randString(t, 10)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -41,8 +40,8 @@ func TestAccComputeProjectMetadataItem_basicMultiple(t *testing.T) {
 	// Generate a config of two config keys
 	)
 
-	config := testAccProjectMetadataItem_basic("myKey", "myValue") +
-		testAccProjectMetadataItem_basic("myOtherKey", "myOtherValue")
+	config := testAccProjectMetadataItem_basic(t, "myKey", "myValue") +
+		testAccProjectMetadataItem_basic(t, "myOtherKey", "myOtherValue")
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -64,7 +63,7 @@ func TestAccComputeProjectMetadataItem_basicWithEmptyVal(t *testing.T) {
 	// Key must be unique to avoid concurrent tests interfering with each other
 	)
 
-	key := "myKey" + acctest.RandString(10)
+	key := "myKey" + randString(t, 10)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -91,7 +90,7 @@ func TestAccComputeProjectMetadataItem_basicUpdate(t *testing.T) {
 	// Key must be unique to avoid concurrent tests interfering with each other
 	)
 
-	key := "myKey" + acctest.RandString(10)
+	key := "myKey" + randString(t, 10)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -160,8 +159,8 @@ func testAccCheckProjectMetadataItemDestroy(s *terraform.State) error {
 	return nil
 }
 
-func testAccProjectMetadataItem_basic(key, val string) string {
-	return testAccProjectMetadataItem_basicWithResourceName(acctest.RandString(10), key, val)
+func testAccProjectMetadataItem_basic(t *testing.T, key, val string) string {
+	return testAccProjectMetadataItem_basicWithResourceName(randString(t, 10), key, val)
 }
 
 func testAccProjectMetadataItem_basicWithResourceName(resourceName, key, val string) string {
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/compute/v1"
@@ -17,7 +16,7 @@ func TestAccComputeProjectMetadata_basic(t *testing.T) {
 	org := getTestOrgFromEnv(t)
 	billingId := getTestBillingAccountFromEnv(t)
 	var project compute.Project
-	projectID := "terrafom-test-" + acctest.RandString(10)
+	projectID := "terrafom-test-" + randString(t, 10)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -34,6 +33,12 @@ func TestAccComputeProjectMetadata_basic(t *testing.T) {
 					testAccCheckComputeProjectMetadataSize(projectID, 2),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "google_compute_project_metadata.fizzbuzz",
+				ImportStateId:     projectID,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -45,7 +50,7 @@ func TestAccComputeProjectMetadata_modify_1(t *testing.T) {
 	org := getTestOrgFromEnv(t)
 	billingId := getTestBillingAccountFromEnv(t)
 	var project compute.Project
-	projectID := "terrafom-test-" + acctest.RandString(10)
+	projectID := "terrafom-test-" + randString(t, 10)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -86,7 +91,7 @@ func TestAccComputeProjectMetadata_modify_2(t *testing.T) {
 	org := getTestOrgFromEnv(t)
 	billingId := getTestBillingAccountFromEnv(t)
 	var project compute.Project
-	projectID := "terraform-test-" + acctest.RandString(10)
+	projectID := "terraform-test-" + randString(t, 10)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -4,19 +4,22 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_region_autoscaler", testSweepComputeResources("regions/{{region}}/autoscalers"))
+}
+
 func TestAccComputeRegionAutoscaler_basic(t *testing.T) {
 	var ascaler compute.Autoscaler
 
-	var it_name = fmt.Sprintf("region-autoscaler-test-%s", acctest.RandString(10))
-	var tp_name = fmt.Sprintf("region-autoscaler-test-%s", acctest.RandString(10))
-	var igm_name = fmt.Sprintf("region-autoscaler-test-%s", acctest.RandString(10))
-	var autoscaler_name = fmt.Sprintf("region-autoscaler-test-%s", acctest.RandString(10))
+	var it_name = fmt.Sprintf("region-autoscaler-test-%s", randString(t, 10))
+	var tp_name = fmt.Sprintf("region-autoscaler-test-%s", randString(t, 10))
+	var igm_name = fmt.Sprintf("region-autoscaler-test-%s", randString(t, 10))
+	var autoscaler_name = fmt.Sprintf("region-autoscaler-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -42,10 +45,10 @@ func TestAccComputeRegionAutoscaler_basic(t *testing.T) {
 func TestAccComputeRegionAutoscaler_update(t *testing.T) {
 	var ascaler compute.Autoscaler
 
-	var it_name = fmt.Sprintf("region-autoscaler-test-%s", acctest.RandString(10))
-	var tp_name = fmt.Sprintf("region-autoscaler-test-%s", acctest.RandString(10))
-	var igm_name = fmt.Sprintf("region-autoscaler-test-%s", acctest.RandString(10))
-	var autoscaler_name = fmt.Sprintf("region-autoscaler-test-%s", acctest.RandString(10))
+	var it_name = fmt.Sprintf("region-autoscaler-test-%s", randString(t, 10))
+	var tp_name = fmt.Sprintf("region-autoscaler-test-%s", randString(t, 10))
+	var igm_name = fmt.Sprintf("region-autoscaler-test-%s", randString(t, 10))
+	var autoscaler_name = fmt.Sprintf("region-autoscaler-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -17,6 +17,9 @@ func resourceComputeRegionBackendService() *schema.Resource {
 		Read:   resourceComputeRegionBackendServiceRead,
 		Update: resourceComputeRegionBackendServiceUpdate,
 		Delete: resourceComputeRegionBackendServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeRegionBackendServiceImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -179,7 +182,7 @@ func resourceComputeRegionBackendServiceCreate(d *schema.ResourceData, meta inte
 
 	d.SetId(service.Name)
 
-	err = computeSharedOperationWait(config.clientCompute, op, project, "Creating Region Backend Service")
+	err = computeSharedOperationWait(config, op, project, "Creating Region Backend Service")
 	if err != nil {
 		return err
 	}
@@ -206,6 +209,7 @@ func resourceComputeRegionBackendServiceRead(d *schema.ResourceData, meta interf
 		return handleNotFoundError(err, d, fmt.Sprintf("Region Backend Service %q", d.Get("name").(string)))
 	}
 
+	d.Set("name", service.Name)
 	d.Set("description", service.Description)
 	d.Set("protocol", service.Protocol)
 	d.Set("session_affinity", service.SessionAffinity)
@@ -287,7 +291,7 @@ func resourceComputeRegionBackendServiceUpdate(d *schema.ResourceData, meta inte
 
 	d.SetId(service.Name)
 
-	err = computeSharedOperationWait(config.clientCompute, op, project, "Updating Backend Service")
+	err = computeSharedOperationWait(config, op, project, "Updating Backend Service")
 	if err != nil {
 		return err
 	}
@@ -315,7 +319,7 @@ func resourceComputeRegionBackendServiceDelete(d *schema.ResourceData, meta inte
 		return fmt.Errorf("Error deleting backend service: %s", err)
 	}
 
-	err = computeOperationWait(config.clientCompute, op, project, "Deleting Backend Service")
+	err = computeOperationWait(config, op, project, "Deleting Backend Service")
 	if err != nil {
 		return err
 	}
@@ -324,6 +328,22 @@ func resourceComputeRegionBackendServiceDelete(d *schema.ResourceData, meta inte
 	return nil
 }
 
+func resourceComputeRegionBackendServiceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/backendServices/(?P<name>[^/]+)", "(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceGoogleComputeRegionBackendServiceBackendHash(v interface{}) int {
 	if v == nil {
 		return 0
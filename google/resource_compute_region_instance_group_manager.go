@@ -256,7 +256,7 @@ func resourceComputeRegionInstanceGroupManagerCreate(d *schema.ResourceData, met
 	d.SetId(manager.Name)
 
 	// Wait for the operation to complete
-	err = computeSharedOperationWait(config.clientCompute, op, project, "Creating InstanceGroupManager")
+	err = computeSharedOperationWait(config, op, project, "Creating InstanceGroupManager")
 	if err != nil {
 		return err
 	}
@@ -337,7 +337,7 @@ func resourceComputeRegionInstanceGroupManagerRead(d *schema.ResourceData, meta
 			Refresh: waitForInstancesRefreshFunc(getRegionalManager, d, meta),
 			Timeout: d.Timeout(schema.TimeoutCreate),
 		}
-		_, err := conf.WaitForState()
+		_, err := waitForStateContext(config.stopContext(), &conf)
 		if err != nil {
 			return err
 		}
@@ -379,7 +379,7 @@ func resourceComputeRegionInstanceGroupManagerUpdate(d *schema.ResourceData, met
 		}
 
 		// Wait for the operation to complete
-		err = computeSharedOperationWait(config.clientCompute, op, project, "Updating RegionInstanceGroupManager")
+		err = computeSharedOperationWait(config, op, project, "Updating RegionInstanceGroupManager")
 		if err != nil {
 			return err
 		}
@@ -401,7 +401,7 @@ func resourceComputeRegionInstanceGroupManagerUpdate(d *schema.ResourceData, met
 		}
 
 		// Wait for the operation to complete
-		err = computeSharedOperationWait(config.clientCompute, op, project, "Updating InstanceGroupManager")
+		err = computeSharedOperationWait(config, op, project, "Updating InstanceGroupManager")
 		if err != nil {
 			return err
 		}
@@ -422,7 +422,7 @@ func resourceComputeRegionInstanceGroupManagerUpdate(d *schema.ResourceData, met
 				return fmt.Errorf("Error updating managed group instances: %s", err)
 			}
 
-			err = computeSharedOperationWait(config.clientCompute, op, project, "Updating managed group instances")
+			err = computeSharedOperationWait(config, op, project, "Updating managed group instances")
 			if err != nil {
 				return err
 			}
@@ -447,7 +447,7 @@ func resourceComputeRegionInstanceGroupManagerUpdate(d *schema.ResourceData, met
 		}
 
 		// Wait for the operation to complete:
-		err = computeSharedOperationWait(config.clientCompute, op, project, "Updating RegionInstanceGroupManager")
+		err = computeSharedOperationWait(config, op, project, "Updating RegionInstanceGroupManager")
 		if err != nil {
 			return err
 		}
@@ -465,7 +465,7 @@ func resourceComputeRegionInstanceGroupManagerUpdate(d *schema.ResourceData, met
 		}
 
 		// Wait for the operation to complete
-		err = computeSharedOperationWait(config.clientCompute, op, project, "Resizing RegionInstanceGroupManager")
+		err = computeSharedOperationWait(config, op, project, "Resizing RegionInstanceGroupManager")
 		if err != nil {
 			return err
 		}
@@ -487,7 +487,7 @@ func resourceComputeRegionInstanceGroupManagerUpdate(d *schema.ResourceData, met
 		}
 
 		// Wait for the operation to complete
-		err = computeSharedOperationWait(config.clientCompute, op, project, "Updating AutoHealingPolicies")
+		err = computeSharedOperationWait(config, op, project, "Updating AutoHealingPolicies")
 		if err != nil {
 			return err
 		}
@@ -517,7 +517,7 @@ func resourceComputeRegionInstanceGroupManagerDelete(d *schema.ResourceData, met
 	}
 
 	// Wait for the operation to complete
-	err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutDelete).Minutes()), "Deleting RegionInstanceGroupManager")
+	err = computeSharedOperationWaitTime(config, op, project, d.Timeout(schema.TimeoutDelete), "Deleting RegionInstanceGroupManager")
 
 	d.SetId("")
 	return nil
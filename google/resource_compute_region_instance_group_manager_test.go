@@ -11,20 +11,25 @@ import (
 
 	"sort"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_region_instance_group_manager", testSweepComputeResources("regions/{{region}}/instanceGroupManagers"),
+		"gcp_compute_region_autoscaler", "gcp_compute_backend_service",
+		"gcp_compute_region_backend_service")
+}
+
 func TestAccRegionInstanceGroupManager_basic(t *testing.T) {
 	t.Parallel()
 
 	var manager compute.InstanceGroupManager
 
-	template := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	target := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	igm1 := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	igm2 := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+	template := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	target := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	igm1 := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	igm2 := fmt.Sprintf("igm-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -49,8 +54,8 @@ func TestAccRegionInstanceGroupManager_targetSizeZero(t *testing.T) {
 
 	var manager compute.InstanceGroupManager
 
-	templateName := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	igmName := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+	templateName := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	igmName := fmt.Sprintf("igm-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -77,11 +82,11 @@ func TestAccRegionInstanceGroupManager_update(t *testing.T) {
 
 	var manager compute.InstanceGroupManager
 
-	template1 := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	target1 := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	target2 := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	template2 := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	igm := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+	template1 := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	target1 := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	target2 := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	template2 := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	igm := fmt.Sprintf("igm-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -130,7 +135,7 @@ func TestAccRegionInstanceGroupManager_updateLifecycle(t *testing.T) {
 
 	tag1 := "tag1"
 	tag2 := "tag2"
-	igm := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+	igm := fmt.Sprintf("igm-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -161,7 +166,7 @@ func TestAccRegionInstanceGroupManager_updateStrategy(t *testing.T) {
 	t.Parallel()
 
 	var manager compute.InstanceGroupManager
-	igm := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+	igm := fmt.Sprintf("igm-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -186,7 +191,7 @@ func TestAccRegionInstanceGroupManager_rollingUpdatePolicy(t *testing.T) {
 
 	var manager computeBeta.InstanceGroupManager
 
-	igm := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+	igm := fmt.Sprintf("igm-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -241,8 +246,8 @@ func TestAccRegionInstanceGroupManager_separateRegions(t *testing.T) {
 
 	var manager compute.InstanceGroupManager
 
-	igm1 := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	igm2 := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+	igm1 := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	igm2 := fmt.Sprintf("igm-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -267,10 +272,10 @@ func TestAccRegionInstanceGroupManager_autoHealingPolicies(t *testing.T) {
 
 	var manager computeBeta.InstanceGroupManager
 
-	template := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	target := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	igm := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	hck := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+	template := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	target := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	igm := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	hck := fmt.Sprintf("igm-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -294,8 +299,8 @@ func TestAccRegionInstanceGroupManager_distributionPolicy(t *testing.T) {
 
 	var manager computeBeta.InstanceGroupManager
 
-	template := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
-	igm := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+	template := fmt.Sprintf("igm-test-%s", randString(t, 10))
+	igm := fmt.Sprintf("igm-test-%s", randString(t, 10))
 	zones := []string{"us-central1-a", "us-central1-b"}
 
 	resource.Test(t, resource.TestCase{
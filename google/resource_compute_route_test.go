@@ -5,12 +5,16 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_route", testSweepComputeResources("global/routes"),
+		"gcp_compute_vpn_tunnel", "gcp_compute_instance")
+}
+
 func TestAccComputeRoute_basic(t *testing.T) {
 	t.Parallel()
 
@@ -22,7 +26,7 @@ func TestAccComputeRoute_basic(t *testing.T) {
 		CheckDestroy: testAccCheckComputeRouteDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeRoute_basic(),
+				Config: testAccComputeRoute_basic(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeRouteExists(
 						"google_compute_route.foobar", &route),
@@ -50,7 +54,7 @@ func TestAccComputeRoute_defaultInternetGateway(t *testing.T) {
 		CheckDestroy: testAccCheckComputeRouteDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeRoute_defaultInternetGateway(),
+				Config: testAccComputeRoute_defaultInternetGateway(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeRouteExists(
 						"google_compute_route.foobar", &route),
@@ -68,7 +72,7 @@ func TestAccComputeRoute_defaultInternetGateway(t *testing.T) {
 func TestAccComputeRoute_hopInstance(t *testing.T) {
 	var route compute.Route
 
-	instanceName := "tf" + acctest.RandString(10)
+	instanceName := "tf" + randString(t, 10)
 	zone := "us-central1-b"
 	instanceNameRegexp := regexp.MustCompile(fmt.Sprintf("projects/(.+)/zones/%s/instances/%s$", zone, instanceName))
 
@@ -78,7 +82,7 @@ func TestAccComputeRoute_hopInstance(t *testing.T) {
 		CheckDestroy: testAccCheckComputeRouteDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeRoute_hopInstance(instanceName, zone),
+				Config: testAccComputeRoute_hopInstance(t, instanceName, zone),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeRouteExists(
 						"google_compute_route.foobar", &route),
@@ -142,7 +146,7 @@ func testAccCheckComputeRouteExists(n string, route *compute.Route) resource.Tes
 	}
 }
 
-func testAccComputeRoute_basic() string {
+func testAccComputeRoute_basic(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_network" "foobar" {
 	name = "route-test-%s"
@@ -156,10 +160,10 @@ resource "google_compute_route" "foobar" {
 	dest_range = "15.0.0.0/24"
 	network = "${google_compute_network.foobar.name}"
 	next_hop_ip = "10.0.1.5"
-}`, acctest.RandString(10), acctest.RandString(10))
+}`, randString(t, 10), randString(t, 10))
 }
 
-func testAccComputeRoute_defaultInternetGateway() string {
+func testAccComputeRoute_defaultInternetGateway(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_route" "foobar" {
 	name = "route-test-%s"
@@ -167,10 +171,10 @@ resource "google_compute_route" "foobar" {
 	network = "default"
 	next_hop_gateway = "default-internet-gateway"
 	priority = 100
-}`, acctest.RandString(10))
+}`, randString(t, 10))
 }
 
-func testAccComputeRoute_hopInstance(instanceName, zone string) string {
+func testAccComputeRoute_hopInstance(t *testing.T, instanceName, zone string) string {
 	return fmt.Sprintf(`
 resource "google_compute_instance" "foo" {
   name         = "%s"
@@ -195,5 +199,5 @@ resource "google_compute_route" "foobar" {
   	next_hop_instance = "${google_compute_instance.foo.name}"
   	next_hop_instance_zone = "${google_compute_instance.foo.zone}"
 	priority = 100
-}`, instanceName, zone, acctest.RandString(10))
+}`, instanceName, zone, randString(t, 10))
 }
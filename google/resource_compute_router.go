@@ -131,7 +131,7 @@ func resourceComputeRouterCreate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error Inserting Router %s into network %s: %s", name, network.Name, err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s", region, name))
-	err = computeOperationWait(config.clientCompute, op, project, "Inserting Router")
+	err = computeOperationWait(config, op, project, "Inserting Router")
 	if err != nil {
 		d.SetId("")
 		return fmt.Errorf("Error Waiting to Insert Router %s into network %s: %s", name, network.Name, err)
@@ -209,7 +209,7 @@ func resourceComputeRouterDelete(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error Reading Router %s: %s", name, err)
 	}
 
-	err = computeOperationWait(config.clientCompute, op, project, "Deleting Router")
+	err = computeOperationWait(config, op, project, "Deleting Router")
 	if err != nil {
 		return fmt.Errorf("Error Waiting to Delete Router %s: %s", name, err)
 	}
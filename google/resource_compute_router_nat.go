@@ -0,0 +1,532 @@
+package google
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"google.golang.org/api/compute/v1"
+)
+
+// The default timeouts of NAT mappings, used by the API when they aren't set.
+var computeRouterNatTimeoutDefaults = map[string]int{
+	"udp_idle_timeout_sec":             30,
+	"icmp_idle_timeout_sec":            30,
+	"tcp_established_idle_timeout_sec": 1200,
+	"tcp_transitory_idle_timeout_sec":  30,
+}
+
+var computeRouterNatTimeoutFields = map[string]string{
+	"udp_idle_timeout_sec":             "udpIdleTimeoutSec",
+	"icmp_idle_timeout_sec":            "icmpIdleTimeoutSec",
+	"tcp_established_idle_timeout_sec": "tcpEstablishedIdleTimeoutSec",
+	"tcp_transitory_idle_timeout_sec":  "tcpTransitoryIdleTimeoutSec",
+}
+
+func resourceComputeRouterNat() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeRouterNatCreate,
+		Read:   resourceComputeRouterNatRead,
+		Update: resourceComputeRouterNatUpdate,
+		Delete: resourceComputeRouterNatDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeRouterNatImportState,
+		},
+
+		CustomizeDiff: resourceComputeRouterNatCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRFC1035Name(2, 63),
+			},
+			"router": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"nat_ip_allocate_option": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"MANUAL_ONLY", "AUTO_ONLY"}, false),
+			},
+			"nat_ips": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      computeRouterNatIPsHash,
+			},
+			"source_subnetwork_ip_ranges_to_nat": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ALL_SUBNETWORKS_ALL_IP_RANGES",
+					"ALL_SUBNETWORKS_ALL_PRIMARY_IP_RANGES",
+					"LIST_OF_SUBNETWORKS",
+				}, false),
+			},
+			"subnetwork": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: compareSelfLinkOrResourceName,
+						},
+						"source_ip_ranges_to_nat": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"ALL_IP_RANGES",
+									"LIST_OF_SECONDARY_IP_RANGES",
+									"PRIMARY_IP_RANGE",
+								}, false),
+							},
+							Set: schema.HashString,
+						},
+						"secondary_ip_range_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+					},
+				},
+				Set: computeRouterNatSubnetworkHash,
+			},
+			"min_ports_per_vm": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"udp_idle_timeout_sec": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  computeRouterNatTimeoutDefaults["udp_idle_timeout_sec"],
+			},
+			"icmp_idle_timeout_sec": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  computeRouterNatTimeoutDefaults["icmp_idle_timeout_sec"],
+			},
+			"tcp_established_idle_timeout_sec": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  computeRouterNatTimeoutDefaults["tcp_established_idle_timeout_sec"],
+			},
+			"tcp_transitory_idle_timeout_sec": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  computeRouterNatTimeoutDefaults["tcp_transitory_idle_timeout_sec"],
+			},
+			"log_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"filter": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "ALL",
+							ValidateFunc: validation.StringInSlice([]string{
+								"ERRORS_ONLY",
+								"TRANSLATIONS_ONLY",
+								"ALL",
+							}, false),
+						},
+					},
+				},
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+// resourceComputeRouterNatCustomizeDiff rejects NAT IPs and subnetworks which
+// the options of the NAT would ignore. Their absence isn't checked, as they
+// may not be known until the resources they reference are created.
+func resourceComputeRouterNatCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("nat_ip_allocate_option").(string) == "AUTO_ONLY" && d.Get("nat_ips").(*schema.Set).Len() > 0 {
+		return fmt.Errorf("nat_ips can only be set when nat_ip_allocate_option is MANUAL_ONLY")
+	}
+	if d.Get("source_subnetwork_ip_ranges_to_nat").(string) != "LIST_OF_SUBNETWORKS" && d.Get("subnetwork").(*schema.Set).Len() > 0 {
+		return fmt.Errorf("subnetwork can only be set when source_subnetwork_ip_ranges_to_nat is LIST_OF_SUBNETWORKS")
+	}
+	return nil
+}
+
+func resourceComputeRouterNatCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	routerName := d.Get("router").(string)
+	natName := d.Get("name").(string)
+
+	routerLock := getRouterLockName(region, routerName)
+	mutexKV.Lock(routerLock)
+	defer mutexKV.Unlock(routerLock)
+
+	url := computeRouterUrl(config, project, region, routerName)
+	router, err := Get(config, url, project)
+	if err != nil {
+		return fmt.Errorf("Error reading router %s/%s: %s", region, routerName, err)
+	}
+
+	nats, _ := router["nats"].([]interface{})
+	if findComputeRouterNat(nats, natName) >= 0 {
+		return fmt.Errorf("Router %s/%s has NAT %s already", region, routerName, natName)
+	}
+
+	log.Printf("[INFO] Adding NAT %s to router %s/%s", natName, region, routerName)
+	nats = append(nats, expandComputeRouterNat(d))
+	if err := patchComputeRouterNats(config, url, project, region, routerName, nats); err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", region, routerName, natName))
+
+	return resourceComputeRouterNatRead(d, meta)
+}
+
+func resourceComputeRouterNatRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	routerName := d.Get("router").(string)
+	natName := d.Get("name").(string)
+
+	router, err := Get(config, computeRouterUrl(config, project, region, routerName), project)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Router NAT %s/%s/%s", region, routerName, natName))
+	}
+
+	nats, _ := router["nats"].([]interface{})
+	i := findComputeRouterNat(nats, natName)
+	if i < 0 {
+		log.Printf("[WARN] Removing router NAT %s/%s/%s because it is gone", region, routerName, natName)
+		d.SetId("")
+		return nil
+	}
+	nat := nats[i].(map[string]interface{})
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", region, routerName, natName))
+	d.Set("nat_ip_allocate_option", nat["natIpAllocateOption"])
+	d.Set("source_subnetwork_ip_ranges_to_nat", nat["sourceSubnetworkIpRangesToNat"])
+	if err := d.Set("nat_ips", nat["natIps"]); err != nil {
+		return fmt.Errorf("Error reading router NAT: %s", err)
+	}
+	if err := d.Set("subnetwork", flattenComputeRouterNatSubnetworks(nat["subnetworks"])); err != nil {
+		return fmt.Errorf("Error reading router NAT: %s", err)
+	}
+	d.Set("min_ports_per_vm", flattenComputeRouterNatInt(nat["minPortsPerVm"], 0))
+	for field, apiField := range computeRouterNatTimeoutFields {
+		d.Set(field, flattenComputeRouterNatInt(nat[apiField], computeRouterNatTimeoutDefaults[field]))
+	}
+	if err := d.Set("log_config", flattenComputeRouterNatLogConfig(nat["logConfig"])); err != nil {
+		return fmt.Errorf("Error reading router NAT: %s", err)
+	}
+	d.Set("region", region)
+	d.Set("project", project)
+
+	return nil
+}
+
+func resourceComputeRouterNatUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	routerName := d.Get("router").(string)
+	natName := d.Get("name").(string)
+
+	routerLock := getRouterLockName(region, routerName)
+	mutexKV.Lock(routerLock)
+	defer mutexKV.Unlock(routerLock)
+
+	url := computeRouterUrl(config, project, region, routerName)
+	router, err := Get(config, url, project)
+	if err != nil {
+		return fmt.Errorf("Error reading router %s/%s: %s", region, routerName, err)
+	}
+
+	nats, _ := router["nats"].([]interface{})
+	i := findComputeRouterNat(nats, natName)
+	if i < 0 {
+		return fmt.Errorf("Router %s/%s has no NAT %s", region, routerName, natName)
+	}
+
+	log.Printf("[INFO] Updating NAT %s of router %s/%s", natName, region, routerName)
+	nats[i] = expandComputeRouterNat(d)
+	if err := patchComputeRouterNats(config, url, project, region, routerName, nats); err != nil {
+		return err
+	}
+
+	return resourceComputeRouterNatRead(d, meta)
+}
+
+func resourceComputeRouterNatDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	routerName := d.Get("router").(string)
+	natName := d.Get("name").(string)
+
+	routerLock := getRouterLockName(region, routerName)
+	mutexKV.Lock(routerLock)
+	defer mutexKV.Unlock(routerLock)
+
+	url := computeRouterUrl(config, project, region, routerName)
+	router, err := Get(config, url, project)
+	if err != nil {
+		if isGoogleApiErrorWithCode(err, 404) {
+			log.Printf("[WARN] Removing router NAT %s because its router %s/%s is gone", natName, region, routerName)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading router %s/%s: %s", region, routerName, err)
+	}
+
+	nats, _ := router["nats"].([]interface{})
+	i := findComputeRouterNat(nats, natName)
+	if i < 0 {
+		log.Printf("[DEBUG] Router %s/%s had no NAT %s already", region, routerName, natName)
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[INFO] Removing NAT %s from router %s/%s", natName, region, routerName)
+	newNats := make([]interface{}, 0, len(nats)-1)
+	newNats = append(newNats, nats[:i]...)
+	newNats = append(newNats, nats[i+1:]...)
+	if err := patchComputeRouterNats(config, url, project, region, routerName, newNats); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceComputeRouterNatImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/routers/(?P<router>[^/]+)/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<router>[^/]+)/(?P<name>[^/]+)",
+		"(?P<region>[^/]+)/(?P<router>[^/]+)/(?P<name>[^/]+)",
+		"(?P<router>[^/]+)/(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", d.Get("region").(string), d.Get("router").(string), d.Get("name").(string)))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func computeRouterUrl(config *Config, project, region, router string) string {
+	return fmt.Sprintf("%sprojects/%s/regions/%s/routers/%s", config.basePath(ComputeBasePathKey), project, region, router)
+}
+
+// patchComputeRouterNats replaces the NATs of a router, which the API
+// updates as a whole. Callers hold the lock of the router.
+func patchComputeRouterNats(config *Config, url, project, region, router string, nats []interface{}) error {
+	log.Printf("[DEBUG] Updating router %s/%s with NATs: %+v", region, router, nats)
+	// The NATs are sent even if there are none left, to remove the last one.
+	res, err := sendRequestWithFields(config, "PATCH", url, project, map[string]interface{}{
+		"nats": nats,
+	}, []string{"nats"}, nil)
+	if err != nil {
+		return fmt.Errorf("Error patching router %s/%s: %s", region, router, err)
+	}
+
+	op := &compute.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+	if err := computeOperationWait(config, op, project, "Patching router"); err != nil {
+		return fmt.Errorf("Error waiting to patch router %s/%s: %s", region, router, err)
+	}
+	return nil
+}
+
+// findComputeRouterNat returns the index of the NAT with the given name, or
+// -1 if the router has none.
+func findComputeRouterNat(nats []interface{}, name string) int {
+	for i, raw := range nats {
+		if nat, ok := raw.(map[string]interface{}); ok && nat["name"] == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func expandComputeRouterNat(d *schema.ResourceData) map[string]interface{} {
+	nat := map[string]interface{}{
+		"name":                          d.Get("name").(string),
+		"natIpAllocateOption":           d.Get("nat_ip_allocate_option").(string),
+		"sourceSubnetworkIpRangesToNat": d.Get("source_subnetwork_ip_ranges_to_nat").(string),
+	}
+	if v := convertStringSet(d.Get("nat_ips").(*schema.Set)); len(v) > 0 {
+		nat["natIps"] = v
+	}
+	if v := expandComputeRouterNatSubnetworks(d.Get("subnetwork").(*schema.Set).List()); len(v) > 0 {
+		nat["subnetworks"] = v
+	}
+	if v, ok := d.GetOk("min_ports_per_vm"); ok {
+		nat["minPortsPerVm"] = v.(int)
+	}
+	for field, apiField := range computeRouterNatTimeoutFields {
+		nat[apiField] = d.Get(field).(int)
+	}
+	if v, ok := d.GetOk("log_config"); ok {
+		logConfig := v.([]interface{})[0].(map[string]interface{})
+		nat["logConfig"] = map[string]interface{}{
+			"enable": logConfig["enable"].(bool),
+			"filter": logConfig["filter"].(string),
+		}
+	}
+	return nat
+}
+
+func expandComputeRouterNatSubnetworks(subnetworks []interface{}) []interface{} {
+	result := make([]interface{}, 0, len(subnetworks))
+	for _, raw := range subnetworks {
+		s := raw.(map[string]interface{})
+		subnetwork := map[string]interface{}{
+			"name":                s["name"].(string),
+			"sourceIpRangesToNat": convertStringSet(s["source_ip_ranges_to_nat"].(*schema.Set)),
+		}
+		if v := convertStringSet(s["secondary_ip_range_names"].(*schema.Set)); len(v) > 0 {
+			subnetwork["secondaryIpRangeNames"] = v
+		}
+		result = append(result, subnetwork)
+	}
+	return result
+}
+
+func flattenComputeRouterNatSubnetworks(v interface{}) []map[string]interface{} {
+	subnetworks, _ := v.([]interface{})
+	result := make([]map[string]interface{}, 0, len(subnetworks))
+	for _, raw := range subnetworks {
+		s, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		ranges, _ := s["sourceIpRangesToNat"].([]interface{})
+		secondary, _ := s["secondaryIpRangeNames"].([]interface{})
+		result = append(result, map[string]interface{}{
+			"name":                     s["name"],
+			"source_ip_ranges_to_nat":  schema.NewSet(schema.HashString, ranges),
+			"secondary_ip_range_names": schema.NewSet(schema.HashString, secondary),
+		})
+	}
+	return result
+}
+
+func flattenComputeRouterNatLogConfig(v interface{}) []map[string]interface{} {
+	logConfig, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	enable, _ := logConfig["enable"].(bool)
+	return []map[string]interface{}{{
+		"enable": enable,
+		"filter": logConfig["filter"],
+	}}
+}
+
+// flattenComputeRouterNatInt reads a number of the API, which may be encoded
+// as a string, or returns def if it is missing.
+func flattenComputeRouterNatInt(v interface{}, def int) int {
+	switch n := v.(type) {
+	case float64:
+		return int(n)
+	case string:
+		var i int
+		if _, err := fmt.Sscan(n, &i); err == nil {
+			return i
+		}
+	}
+	return def
+}
+
+// NAT IPs are hashed by name, so that addresses referenced by name or by self
+// link are the same.
+func computeRouterNatIPsHash(v interface{}) int {
+	return hashcode.String(GetResourceNameFromSelfLink(v.(string)))
+}
+
+func computeRouterNatSubnetworkHash(v interface{}) int {
+	s := v.(map[string]interface{})
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("%s-", GetResourceNameFromSelfLink(s["name"].(string))))
+	for _, set := range []string{"source_ip_ranges_to_nat", "secondary_ip_range_names"} {
+		var values []string
+		if v, ok := s[set].(*schema.Set); ok {
+			values = convertStringSet(v)
+		}
+		sort.Strings(values)
+		buf.WriteString(fmt.Sprintf("%v-", values))
+	}
+	return hashcode.String(buf.String())
+}
@@ -0,0 +1,229 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestFakeComputeRouterNat_update(t *testing.T) {
+	t.Parallel()
+
+	s := newFakeGcpServer(t)
+	defer s.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testFakeGcpProviders(),
+		CheckDestroy: testFakeGcpCheckDestroy(s),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testFakeGcpProviderConfig(s) + testAccComputeRouterNatBasic("test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_router_nat.foobar", "id", fakeGcpRegion+"/router-nat-test-test/router-nat-test-test"),
+					resource.TestCheckResourceAttr("google_compute_router_nat.foobar", "nat_ip_allocate_option", "AUTO_ONLY"),
+					resource.TestCheckResourceAttr("google_compute_router_nat.foobar", "udp_idle_timeout_sec", "30"),
+					resource.TestCheckResourceAttr("google_compute_router_nat.foobar", "tcp_established_idle_timeout_sec", "1200"),
+				),
+			},
+			testFakeGcpImportStep(s, "google_compute_router_nat.foobar"),
+			resource.TestStep{
+				Config: testFakeGcpProviderConfig(s) + testAccComputeRouterNatManual("test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_router_nat.foobar", "nat_ips.#", "1"),
+					resource.TestCheckResourceAttr("google_compute_router_nat.foobar", "subnetwork.#", "1"),
+					resource.TestCheckResourceAttr("google_compute_router_nat.foobar", "min_ports_per_vm", "128"),
+					resource.TestCheckResourceAttr("google_compute_router_nat.foobar", "icmp_idle_timeout_sec", "60"),
+					resource.TestCheckResourceAttr("google_compute_router_nat.foobar", "log_config.0.filter", "ERRORS_ONLY"),
+				),
+			},
+			testFakeGcpImportStep(s, "google_compute_router_nat.foobar"),
+			resource.TestStep{
+				Config: testFakeGcpProviderConfig(s) + testAccComputeRouterNatKeepRouter("test"),
+				Check: func(state *terraform.State) error {
+					return checkComputeRouterNatDeleted(testFakeGcpConfig(t, s), state, "google_compute_router.foobar", "router-nat-test-test")
+				},
+			},
+		},
+	})
+}
+
+func TestFakeComputeRouterNat_invalidOptions(t *testing.T) {
+	t.Parallel()
+
+	s := newFakeGcpServer(t)
+	defer s.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testFakeGcpProviders(),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testFakeGcpProviderConfig(s) + testAccComputeRouterNatAutoWithIPs("test"),
+				ExpectError: regexp.MustCompile("nat_ips can only be set when nat_ip_allocate_option is MANUAL_ONLY"),
+			},
+		},
+	})
+}
+
+// Acceptance tests
+
+func TestAccComputeRouterNat_basic(t *testing.T) {
+	t.Parallel()
+
+	testId := randString(t, 10)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRouterPeerDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeRouterNatBasic(testId),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_router_nat.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			resource.TestStep{
+				Config: testAccComputeRouterNatManual(testId),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_router_nat.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			resource.TestStep{
+				Config: testAccComputeRouterNatKeepRouter(testId),
+				Check: testAccCheckComputeRouterNatDelete(
+					"google_compute_router.foobar", "router-nat-test-"+testId),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeRouterNatDelete(n, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		return checkComputeRouterNatDeleted(testAccProvider.Meta().(*Config), s, n, name)
+	}
+}
+
+// checkComputeRouterNatDeleted checks that the router n has no NAT named name
+// left.
+func checkComputeRouterNatDeleted(config *Config, s *terraform.State, n, name string) error {
+	rs, ok := s.RootModule().Resources[n]
+	if !ok {
+		return fmt.Errorf("Not found: %s", n)
+	}
+
+	project, err := getTestProject(rs.Primary, config)
+	if err != nil {
+		return err
+	}
+
+	region, err := getTestRegion(rs.Primary, config)
+	if err != nil {
+		return err
+	}
+
+	routerName := rs.Primary.Attributes["name"]
+	router, err := Get(config, computeRouterUrl(config, project, region, routerName), project)
+	if err != nil {
+		return fmt.Errorf("Error Reading Router %s: %s", routerName, err)
+	}
+
+	nats, _ := router["nats"].([]interface{})
+	if findComputeRouterNat(nats, name) >= 0 {
+		return fmt.Errorf("NAT %s still exists on router %s/%s", name, region, routerName)
+	}
+	return nil
+}
+
+func testAccComputeRouterNatKeepRouter(testId string) string {
+	return fmt.Sprintf(`
+resource "google_compute_network" "foobar" {
+	name                    = "router-nat-test-%s"
+	auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "foobar" {
+	name          = "router-nat-test-subnetwork-%s"
+	network       = "${google_compute_network.foobar.self_link}"
+	ip_cidr_range = "10.0.0.0/16"
+	region        = "us-central1"
+
+	secondary_ip_range {
+		range_name    = "pods"
+		ip_cidr_range = "10.1.0.0/16"
+	}
+}
+
+resource "google_compute_router" "foobar" {
+	name    = "router-nat-test-%s"
+	region  = "${google_compute_subnetwork.foobar.region}"
+	network = "${google_compute_network.foobar.self_link}"
+
+	bgp {
+		asn = 64514
+	}
+}
+
+resource "google_compute_address" "foobar" {
+	name   = "router-nat-test-%s"
+	region = "${google_compute_subnetwork.foobar.region}"
+}
+`, testId, testId, testId, testId)
+}
+
+func testAccComputeRouterNatBasic(testId string) string {
+	return testAccComputeRouterNatKeepRouter(testId) + fmt.Sprintf(`
+resource "google_compute_router_nat" "foobar" {
+	name                               = "router-nat-test-%s"
+	router                             = "${google_compute_router.foobar.name}"
+	region                             = "${google_compute_router.foobar.region}"
+	nat_ip_allocate_option             = "AUTO_ONLY"
+	source_subnetwork_ip_ranges_to_nat = "ALL_SUBNETWORKS_ALL_IP_RANGES"
+}
+`, testId)
+}
+
+func testAccComputeRouterNatManual(testId string) string {
+	return testAccComputeRouterNatKeepRouter(testId) + fmt.Sprintf(`
+resource "google_compute_router_nat" "foobar" {
+	name                               = "router-nat-test-%s"
+	router                             = "${google_compute_router.foobar.name}"
+	region                             = "${google_compute_router.foobar.region}"
+	nat_ip_allocate_option             = "MANUAL_ONLY"
+	nat_ips                            = ["${google_compute_address.foobar.self_link}"]
+	source_subnetwork_ip_ranges_to_nat = "LIST_OF_SUBNETWORKS"
+
+	subnetwork {
+		name                     = "${google_compute_subnetwork.foobar.self_link}"
+		source_ip_ranges_to_nat  = ["PRIMARY_IP_RANGE", "LIST_OF_SECONDARY_IP_RANGES"]
+		secondary_ip_range_names = ["pods"]
+	}
+
+	min_ports_per_vm      = 128
+	icmp_idle_timeout_sec = 60
+
+	log_config {
+		enable = true
+		filter = "ERRORS_ONLY"
+	}
+}
+`, testId)
+}
+
+func testAccComputeRouterNatAutoWithIPs(testId string) string {
+	return testAccComputeRouterNatKeepRouter(testId) + fmt.Sprintf(`
+resource "google_compute_router_nat" "foobar" {
+	name                               = "router-nat-test-%s"
+	router                             = "${google_compute_router.foobar.name}"
+	region                             = "${google_compute_router.foobar.region}"
+	nat_ip_allocate_option             = "AUTO_ONLY"
+	nat_ips                            = ["router-nat-test-%s"]
+	source_subnetwork_ip_ranges_to_nat = "ALL_SUBNETWORKS_ALL_IP_RANGES"
+}
+`, testId, testId)
+}
@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_router", testSweepComputeResources("regions/{{region}}/routers"), "gcp_compute_vpn_tunnel")
+}
+
 func TestAccComputeRouter_basic(t *testing.T) {
 	t.Parallel()
 
@@ -19,7 +22,7 @@ func TestAccComputeRouter_basic(t *testing.T) {
 		CheckDestroy: testAccCheckComputeRouterDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeRouterBasic(resourceRegion),
+				Config: testAccComputeRouterBasic(t, resourceRegion),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeRouterExists(
 						"google_compute_router.foobar"),
@@ -46,7 +49,7 @@ func TestAccComputeRouter_noRegion(t *testing.T) {
 		CheckDestroy: testAccCheckComputeRouterDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeRouterNoRegion(providerRegion),
+				Config: testAccComputeRouterNoRegion(t, providerRegion),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeRouterExists(
 						"google_compute_router.foobar"),
@@ -132,8 +135,8 @@ func testAccCheckComputeRouterExists(n string) resource.TestCheckFunc {
 	}
 }
 
-func testAccComputeRouterBasic(resourceRegion string) string {
-	testId := acctest.RandString(10)
+func testAccComputeRouterBasic(t *testing.T, resourceRegion string) string {
+	testId := randString(t, 10)
 	return fmt.Sprintf(`
 		resource "google_compute_network" "foobar" {
 			name = "router-test-%s"
@@ -155,8 +158,8 @@ func testAccComputeRouterBasic(resourceRegion string) string {
 	`, testId, testId, resourceRegion, testId)
 }
 
-func testAccComputeRouterNoRegion(providerRegion string) string {
-	testId := acctest.RandString(10)
+func testAccComputeRouterNoRegion(t *testing.T, providerRegion string) string {
+	testId := randString(t, 10)
 	return fmt.Sprintf(`
 		resource "google_compute_network" "foobar" {
 			name = "router-test-%s"
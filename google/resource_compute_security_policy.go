@@ -150,7 +150,7 @@ func resourceComputeSecurityPolicyCreate(d *schema.ResourceData, meta interface{
 
 	d.SetId(securityPolicy.Name)
 
-	err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), fmt.Sprintf("Creating SecurityPolicy %q", sp))
+	err = computeSharedOperationWaitTime(config, op, project, d.Timeout(schema.TimeoutCreate), fmt.Sprintf("Creating SecurityPolicy %q", sp))
 	if err != nil {
 		return err
 	}
@@ -205,7 +205,7 @@ func resourceComputeSecurityPolicyUpdate(d *schema.ResourceData, meta interface{
 			return errwrap.Wrapf(fmt.Sprintf("Error updating SecurityPolicy %q: {{err}}", sp), err)
 		}
 
-		err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), fmt.Sprintf("Updating SecurityPolicy %q", sp))
+		err = computeSharedOperationWaitTime(config, op, project, d.Timeout(schema.TimeoutCreate), fmt.Sprintf("Updating SecurityPolicy %q", sp))
 		if err != nil {
 			return err
 		}
@@ -233,7 +233,7 @@ func resourceComputeSecurityPolicyUpdate(d *schema.ResourceData, meta interface{
 					return errwrap.Wrapf(fmt.Sprintf("Error updating SecurityPolicy %q: {{err}}", sp), err)
 				}
 
-				err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), fmt.Sprintf("Updating SecurityPolicy %q", sp))
+				err = computeSharedOperationWaitTime(config, op, project, d.Timeout(schema.TimeoutCreate), fmt.Sprintf("Updating SecurityPolicy %q", sp))
 				if err != nil {
 					return err
 				}
@@ -245,7 +245,7 @@ func resourceComputeSecurityPolicyUpdate(d *schema.ResourceData, meta interface{
 					return errwrap.Wrapf(fmt.Sprintf("Error updating SecurityPolicy %q: {{err}}", sp), err)
 				}
 
-				err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), fmt.Sprintf("Updating SecurityPolicy %q", sp))
+				err = computeSharedOperationWaitTime(config, op, project, d.Timeout(schema.TimeoutCreate), fmt.Sprintf("Updating SecurityPolicy %q", sp))
 				if err != nil {
 					return err
 				}
@@ -262,7 +262,7 @@ func resourceComputeSecurityPolicyUpdate(d *schema.ResourceData, meta interface{
 					return errwrap.Wrapf(fmt.Sprintf("Error updating SecurityPolicy %q: {{err}}", sp), err)
 				}
 
-				err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), fmt.Sprintf("Updating SecurityPolicy %q", sp))
+				err = computeSharedOperationWaitTime(config, op, project, d.Timeout(schema.TimeoutCreate), fmt.Sprintf("Updating SecurityPolicy %q", sp))
 				if err != nil {
 					return err
 				}
@@ -287,7 +287,7 @@ func resourceComputeSecurityPolicyDelete(d *schema.ResourceData, meta interface{
 		return errwrap.Wrapf("Error deleting SecurityPolicy: {{err}}", err)
 	}
 
-	err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutDelete).Minutes()), "Deleting SecurityPolicy")
+	err = computeSharedOperationWaitTime(config, op, project, d.Timeout(schema.TimeoutDelete), "Deleting SecurityPolicy")
 	if err != nil {
 		return err
 	}
@@ -4,15 +4,19 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_security_policy", testSweepComputeResources("global/securityPolicies"),
+		"gcp_compute_backend_service")
+}
+
 func TestAccComputeSecurityPolicy_basic(t *testing.T) {
 	t.Parallel()
 
-	spName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	spName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -34,7 +38,7 @@ func TestAccComputeSecurityPolicy_basic(t *testing.T) {
 func TestAccComputeSecurityPolicy_withRule(t *testing.T) {
 	t.Parallel()
 
-	spName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	spName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -56,7 +60,7 @@ func TestAccComputeSecurityPolicy_withRule(t *testing.T) {
 func TestAccComputeSecurityPolicy_update(t *testing.T) {
 	t.Parallel()
 
-	spName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	spName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
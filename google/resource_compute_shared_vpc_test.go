@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -13,8 +12,8 @@ func TestAccComputeSharedVpc_basic(t *testing.T) {
 	org := getTestOrgFromEnv(t)
 	billingId := getTestBillingAccountFromEnv(t)
 
-	hostProject := "xpn-host-" + acctest.RandString(10)
-	serviceProject := "xpn-service-" + acctest.RandString(10)
+	hostProject := "xpn-host-" + randString(t, 10)
+	serviceProject := "xpn-service-" + randString(t, 10)
 
 	hostProjectResourceName := "google_compute_shared_vpc_host_project.host"
 	serviceProjectResourceName := "google_compute_shared_vpc_service_project.service"
@@ -16,6 +16,11 @@ func resourceComputeSnapshot() *schema.Resource {
 		Read:   resourceComputeSnapshotRead,
 		Delete: resourceComputeSnapshotDelete,
 		Update: resourceComputeSnapshotUpdate,
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeSnapshotImport,
+		},
+
+		CustomizeDiff: effectiveLabelsCustomizeDiff(false),
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -85,6 +90,8 @@ func resourceComputeSnapshot() *schema.Resource {
 				Set:      schema.HashString,
 			},
 
+			"effective_labels": effectiveLabelsSchema(),
+
 			"label_fingerprint": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -137,21 +144,21 @@ func resourceComputeSnapshotCreate(d *schema.ResourceData, meta interface{}) err
 	// It probably maybe worked, so store the ID now
 	d.SetId(snapshot.Name)
 
-	timeout := int(d.Timeout(schema.TimeoutCreate).Minutes())
-	err = computeOperationWaitTime(config.clientCompute, op, project, "Creating Snapshot", timeout)
+	timeout := d.Timeout(schema.TimeoutCreate)
+	err = computeOperationWaitTime(config, op, project, "Creating Snapshot", timeout)
 	if err != nil {
 		return err
 	}
 
 	// Now if labels are set, go ahead and apply them
-	if labels := expandLabels(d); len(labels) > 0 {
+	if labels := expandLabels(d, config); len(labels) > 0 {
 		// First, read the remote resource in order to find the fingerprint
 		apiSnapshot, err := config.clientCompute.Snapshots.Get(project, d.Id()).Do()
 		if err != nil {
 			return fmt.Errorf("Eror when reading snapshot for label update: %s", err)
 		}
 
-		err = updateLabels(config.clientCompute, project, d.Id(), labels, apiSnapshot.LabelFingerprint, timeout)
+		err = updateLabels(config, project, d.Id(), labels, apiSnapshot.LabelFingerprint, timeout)
 		if err != nil {
 			return err
 		}
@@ -190,7 +197,9 @@ func resourceComputeSnapshotRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("source_disk_encryption_key_sha256", snapshot.SourceDiskEncryptionKey.Sha256)
 	}
 
-	d.Set("labels", snapshot.Labels)
+	if err := setLabels(d, config, snapshot.Labels); err != nil {
+		return fmt.Errorf("Error setting labels: %s", err)
+	}
 	d.Set("label_fingerprint", snapshot.LabelFingerprint)
 	d.Set("project", project)
 	d.Set("zone", zone)
@@ -208,13 +217,14 @@ func resourceComputeSnapshotUpdate(d *schema.ResourceData, meta interface{}) err
 
 	d.Partial(true)
 
-	if d.HasChange("labels") {
-		err = updateLabels(config.clientCompute, project, d.Id(), expandLabels(d), d.Get("label_fingerprint").(string), int(d.Timeout(schema.TimeoutDelete).Minutes()))
+	if hasLabelsChange(d) {
+		err = updateLabels(config, project, d.Id(), expandLabels(d, config), d.Get("label_fingerprint").(string), d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			return err
 		}
 
 		d.SetPartial("labels")
+		d.SetPartial("effective_labels")
 	}
 
 	d.Partial(false)
@@ -243,7 +253,7 @@ func resourceComputeSnapshotDelete(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error deleting snapshot: %s", err)
 	}
 
-	err = computeOperationWaitTime(config.clientCompute, op, project, "Deleting Snapshot", int(d.Timeout(schema.TimeoutDelete).Minutes()))
+	err = computeOperationWaitTime(config, op, project, "Deleting Snapshot", d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -252,15 +262,44 @@ func resourceComputeSnapshotDelete(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func updateLabels(client *compute.Service, project string, resourceId string, labels map[string]string, labelFingerprint string, timeout int) error {
+func resourceComputeSnapshotImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/global/snapshots/(?P<name>[^/]+)", "(?P<project>[^/]+)/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	// The source disk and its zone aren't read by
+	// resourceComputeSnapshotRead, take them from the source disk link.
+	snapshot, err := config.clientCompute.Snapshots.Get(d.Get("project").(string), d.Id()).Do()
+	if err != nil {
+		return nil, fmt.Errorf("Error reading snapshot %q: %s", d.Id(), err)
+	}
+	sourceDisk, err := ParseDiskFieldValue(snapshot.SourceDisk, d, config)
+	if err != nil {
+		return nil, err
+	}
+	d.Set("source_disk", sourceDisk.Name)
+	d.Set("zone", sourceDisk.Zone)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func updateLabels(config *Config, project string, resourceId string, labels map[string]string, labelFingerprint string, timeout time.Duration) error {
 	setLabelsReq := compute.GlobalSetLabelsRequest{
 		Labels:           labels,
 		LabelFingerprint: labelFingerprint,
 	}
-	op, err := client.Snapshots.SetLabels(project, resourceId, &setLabelsReq).Do()
+	op, err := config.clientCompute.Snapshots.SetLabels(project, resourceId, &setLabelsReq).Do()
 	if err != nil {
 		return err
 	}
 
-	return computeOperationWaitTime(client, op, project, "Setting labels on snapshot", timeout)
+	return computeOperationWaitTime(config, op, project, "Setting labels on snapshot", timeout)
 }
@@ -7,19 +7,22 @@ import (
 	"reflect"
 	"strings"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/compute/v1"
 	"google.golang.org/api/googleapi"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_snapshot", testSweepComputeResources("global/snapshots"))
+}
+
 func TestAccComputeSnapshot_basic(t *testing.T) {
 	t.Parallel()
 
-	snapshotName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	snapshotName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 	var snapshot compute.Snapshot
-	diskName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	diskName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -33,6 +36,11 @@ func TestAccComputeSnapshot_basic(t *testing.T) {
 						"google_compute_snapshot.foobar", &snapshot),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "google_compute_snapshot.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -40,9 +48,9 @@ func TestAccComputeSnapshot_basic(t *testing.T) {
 func TestAccComputeSnapshot_update(t *testing.T) {
 	t.Parallel()
 
-	snapshotName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	snapshotName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 	var snapshot compute.Snapshot
-	diskName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	diskName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -70,8 +78,8 @@ func TestAccComputeSnapshot_update(t *testing.T) {
 func TestAccComputeSnapshot_encryption(t *testing.T) {
 	t.Parallel()
 
-	snapshotName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	diskName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	snapshotName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	diskName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 	var snapshot compute.Snapshot
 
 	resource.Test(t, resource.TestCase{
@@ -123,7 +123,7 @@ func resourceComputeSslCertificateCreate(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error creating ssl certificate: %s", err)
 	}
 
-	err = computeOperationWait(config.clientCompute, op, project, "Creating SslCertificate")
+	err = computeOperationWait(config, op, project, "Creating SslCertificate")
 	if err != nil {
 		return err
 	}
@@ -171,7 +171,7 @@ func resourceComputeSslCertificateDelete(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error deleting ssl certificate: %s", err)
 	}
 
-	err = computeOperationWait(config.clientCompute, op, project, "Deleting SslCertificate")
+	err = computeOperationWait(config, op, project, "Deleting SslCertificate")
 	if err != nil {
 		return err
 	}
@@ -4,11 +4,15 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_ssl_certificate", testSweepComputeResources("global/sslCertificates"),
+		"gcp_compute_target_https_proxy", "gcp_compute_target_ssl_proxy")
+}
+
 func TestAccComputeSslCertificate_basic(t *testing.T) {
 	t.Parallel()
 
@@ -18,7 +22,7 @@ func TestAccComputeSslCertificate_basic(t *testing.T) {
 		CheckDestroy: testAccCheckComputeSslCertificateDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeSslCertificate_basic(),
+				Config: testAccComputeSslCertificate_basic(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeSslCertificateExists(
 						"google_compute_ssl_certificate.foobar"),
@@ -62,7 +66,7 @@ func TestAccComputeSslCertificate_name_prefix(t *testing.T) {
 		CheckDestroy: testAccCheckComputeSslCertificateDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeSslCertificate_name_prefix(),
+				Config: testAccComputeSslCertificate_name_prefix(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeSslCertificateExists(
 						"google_compute_ssl_certificate.foobar"),
@@ -117,7 +121,7 @@ func testAccCheckComputeSslCertificateExists(n string) resource.TestCheckFunc {
 	}
 }
 
-func testAccComputeSslCertificate_basic() string {
+func testAccComputeSslCertificate_basic(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_ssl_certificate" "foobar" {
 	name = "sslcert-test-%s"
@@ -125,7 +129,7 @@ resource "google_compute_ssl_certificate" "foobar" {
 	private_key = "${file("test-fixtures/ssl_cert/test.key")}"
 	certificate = "${file("test-fixtures/ssl_cert/test.crt")}"
 }
-`, acctest.RandString(10))
+`, randString(t, 10))
 }
 
 func testAccComputeSslCertificate_no_name() string {
@@ -138,7 +142,7 @@ resource "google_compute_ssl_certificate" "foobar" {
 `)
 }
 
-func testAccComputeSslCertificate_name_prefix() string {
+func testAccComputeSslCertificate_name_prefix(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_ssl_certificate" "foobar" {
 	name_prefix = "sslcert-test-%s-"
@@ -146,5 +150,5 @@ resource "google_compute_ssl_certificate" "foobar" {
 	private_key = "${file("test-fixtures/ssl_cert/test.key")}"
 	certificate = "${file("test-fixtures/ssl_cert/test.crt")}"
 }
-`, acctest.RandString(10))
+`, randString(t, 10))
 }
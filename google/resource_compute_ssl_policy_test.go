@@ -4,17 +4,21 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	compute "google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_ssl_policy", testSweepComputeResources("global/sslPolicies"),
+		"gcp_compute_target_https_proxy", "gcp_compute_target_ssl_proxy")
+}
+
 func TestAccComputeSslPolicy_basic(t *testing.T) {
 	t.Parallel()
 
 	var sslPolicy compute.SslPolicy
-	sslPolicyName := fmt.Sprintf("test-ssl-policy-%s", acctest.RandString(10))
+	sslPolicyName := fmt.Sprintf("test-ssl-policy-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -46,7 +50,7 @@ func TestAccComputeSslPolicy_profile(t *testing.T) {
 	t.Parallel()
 
 	var sslPolicy compute.SslPolicy
-	sslPolicyName := fmt.Sprintf("test-ssl-policy-%s", acctest.RandString(10))
+	sslPolicyName := fmt.Sprintf("test-ssl-policy-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -75,7 +79,7 @@ func TestAccComputeSslPolicy_update(t *testing.T) {
 	t.Parallel()
 
 	var sslPolicy compute.SslPolicy
-	sslPolicyName := fmt.Sprintf("test-ssl-policy-%s", acctest.RandString(10))
+	sslPolicyName := fmt.Sprintf("test-ssl-policy-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -122,7 +126,7 @@ func TestAccComputeSslPolicy_tls_version(t *testing.T) {
 	t.Parallel()
 
 	var sslPolicy compute.SslPolicy
-	sslPolicyName := fmt.Sprintf("test-ssl-policy-%s", acctest.RandString(10))
+	sslPolicyName := fmt.Sprintf("test-ssl-policy-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -151,7 +155,7 @@ func TestAccComputeSslPolicy_custom(t *testing.T) {
 	t.Parallel()
 
 	var sslPolicy compute.SslPolicy
-	sslPolicyName := fmt.Sprintf("test-ssl-policy-%s", acctest.RandString(10))
+	sslPolicyName := fmt.Sprintf("test-ssl-policy-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -182,7 +186,7 @@ func TestAccComputeSslPolicy_update_to_custom(t *testing.T) {
 	t.Parallel()
 
 	var sslPolicy compute.SslPolicy
-	sslPolicyName := fmt.Sprintf("test-ssl-policy-%s", acctest.RandString(10))
+	sslPolicyName := fmt.Sprintf("test-ssl-policy-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -229,7 +233,7 @@ func TestAccComputeSslPolicy_update_from_custom(t *testing.T) {
 	t.Parallel()
 
 	var sslPolicy compute.SslPolicy
-	sslPolicyName := fmt.Sprintf("test-ssl-policy-%s", acctest.RandString(10))
+	sslPolicyName := fmt.Sprintf("test-ssl-policy-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
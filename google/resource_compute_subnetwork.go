@@ -164,7 +164,7 @@ func resourceComputeSubnetworkCreate(d *schema.ResourceData, meta interface{}) e
 	subnetwork.Region = region
 	d.SetId(createSubnetIDBeta(subnetwork))
 
-	err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), "Creating Subnetwork")
+	err = computeSharedOperationWaitTime(config, op, project, d.Timeout(schema.TimeoutCreate), "Creating Subnetwork")
 	if err != nil {
 		return err
 	}
@@ -237,7 +237,7 @@ func resourceComputeSubnetworkUpdate(d *schema.ResourceData, meta interface{}) e
 			return fmt.Errorf("Error updating subnetwork PrivateIpGoogleAccess: %s", err)
 		}
 
-		err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutUpdate).Minutes()), "Updating Subnetwork PrivateIpGoogleAccess")
+		err = computeSharedOperationWaitTime(config, op, project, d.Timeout(schema.TimeoutUpdate), "Updating Subnetwork PrivateIpGoogleAccess")
 		if err != nil {
 			return err
 		}
@@ -256,7 +256,7 @@ func resourceComputeSubnetworkUpdate(d *schema.ResourceData, meta interface{}) e
 			return fmt.Errorf("Error expanding the ip cidr range: %s", err)
 		}
 
-		err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutUpdate).Minutes()), "Expanding Subnetwork IP CIDR range")
+		err = computeSharedOperationWaitTime(config, op, project, d.Timeout(schema.TimeoutUpdate), "Expanding Subnetwork IP CIDR range")
 		if err != nil {
 			return err
 		}
@@ -282,7 +282,7 @@ func resourceComputeSubnetworkUpdate(d *schema.ResourceData, meta interface{}) e
 			return fmt.Errorf("Error updating subnetwork %q: %s", d.Id(), err)
 		}
 
-		err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutUpdate).Minutes()), "Updating Subnetwork")
+		err = computeSharedOperationWaitTime(config, op, project, d.Timeout(schema.TimeoutUpdate), "Updating Subnetwork")
 		if err != nil {
 			return err
 		}
@@ -316,7 +316,7 @@ func resourceComputeSubnetworkDelete(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error deleting subnetwork: %s", err)
 	}
 
-	err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutDelete).Minutes()), "Deleting Subnetwork")
+	err = computeSharedOperationWaitTime(config, op, project, d.Timeout(schema.TimeoutDelete), "Deleting Subnetwork")
 	if err != nil {
 		return err
 	}
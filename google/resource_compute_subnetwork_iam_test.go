@@ -4,17 +4,16 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccComputeSubnetworkIamBinding(t *testing.T) {
 	t.Parallel()
 
-	account := acctest.RandomWithPrefix("tf-test")
+	account := randomWithPrefix(t, "tf-test")
 	role := "roles/compute.networkUser"
 	region := getTestRegionFromEnv()
-	subnetwork := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	subnetwork := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -47,10 +46,10 @@ func TestAccComputeSubnetworkIamMember(t *testing.T) {
 	t.Parallel()
 
 	project := getTestProjectFromEnv()
-	account := acctest.RandomWithPrefix("tf-test")
+	account := randomWithPrefix(t, "tf-test")
 	role := "roles/compute.networkUser"
 	region := getTestRegionFromEnv()
-	subnetwork := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	subnetwork := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -74,10 +73,10 @@ func TestAccComputeSubnetworkIamPolicy(t *testing.T) {
 	t.Parallel()
 
 	project := getTestProjectFromEnv()
-	account := acctest.RandomWithPrefix("tf-test")
+	account := randomWithPrefix(t, "tf-test")
 	role := "roles/compute.networkUser"
 	region := getTestRegionFromEnv()
-	subnetwork := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	subnetwork := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
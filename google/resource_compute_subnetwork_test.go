@@ -4,12 +4,19 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-google/google/fakegcp"
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_subnetwork", testSweepComputeResources("regions/{{region}}/subnetworks"),
+		"gcp_compute_instance", "gcp_compute_instance_template", "gcp_compute_instance_group",
+		"gcp_compute_address", "gcp_compute_forwarding_rule", "gcp_compute_region_backend_service",
+		"gcp_compute_router", "gcp_compute_vpn_gateway", "gcp_container_cluster", "gcp_dataproc_cluster")
+}
+
 // Unit tests
 
 func TestIsShrinkageIpCidr(t *testing.T) {
@@ -46,6 +53,37 @@ func TestIsShrinkageIpCidr(t *testing.T) {
 	}
 }
 
+// The network is referenced by name, while the API returns its self link.
+func TestFakeComputeSubnetwork_networkName(t *testing.T) {
+	t.Parallel()
+
+	s := newFakeGcpServer(t)
+	defer s.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testFakeGcpProviders(),
+		CheckDestroy: testFakeGcpCheckDestroy(s),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testFakeComputeSubnetwork_networkName(s, "10.0.0.0/24", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"google_compute_subnetwork.foobar", "network", "google_compute_network.foobar", "self_link"),
+					resource.TestCheckResourceAttr("google_compute_subnetwork.foobar", "gateway_address", "10.0.0.1"),
+				),
+			},
+			testFakeGcpImportStep(s, "google_compute_subnetwork.foobar"),
+			resource.TestStep{
+				Config: testFakeComputeSubnetwork_networkName(s, "10.0.0.0/16", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_subnetwork.foobar", "ip_cidr_range", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr("google_compute_subnetwork.foobar", "private_ip_google_access", "true"),
+				),
+			},
+		},
+	})
+}
+
 // Acceptance tests
 
 func TestAccComputeSubnetwork_basic(t *testing.T) {
@@ -54,10 +92,10 @@ func TestAccComputeSubnetwork_basic(t *testing.T) {
 	var subnetwork1 compute.Subnetwork
 	var subnetwork2 compute.Subnetwork
 
-	cnName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	subnetwork1Name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	subnetwork2Name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	subnetwork3Name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	cnName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	subnetwork1Name := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	subnetwork2Name := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	subnetwork3Name := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -92,8 +130,8 @@ func TestAccComputeSubnetwork_update(t *testing.T) {
 
 	var subnetwork compute.Subnetwork
 
-	cnName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	subnetworkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	cnName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	subnetworkName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -136,8 +174,8 @@ func TestAccComputeSubnetwork_secondaryIpRanges(t *testing.T) {
 
 	var subnetwork compute.Subnetwork
 
-	cnName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	subnetworkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	cnName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	subnetworkName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -176,8 +214,8 @@ func TestAccComputeSubnetwork_flowLogs(t *testing.T) {
 
 	var subnetwork compute.Subnetwork
 
-	cnName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	subnetworkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	cnName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	subnetworkName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -419,3 +457,18 @@ resource "google_compute_subnetwork" "network-with-flow-logs" {
 }
 `, cnName, subnetworkName, enableLogs)
 }
+
+func testFakeComputeSubnetwork_networkName(s *fakegcp.Server, cidrRange string, privateIpGoogleAccess bool) string {
+	return testFakeGcpProviderConfig(s) + fmt.Sprintf(`
+resource "google_compute_network" "foobar" {
+	name                    = "network-test"
+	auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "foobar" {
+	name                     = "subnetwork-test"
+	ip_cidr_range            = "%s"
+	network                  = "${google_compute_network.foobar.name}"
+	private_ip_google_access = %t
+}`, cidrRange, privateIpGoogleAccess)
+}
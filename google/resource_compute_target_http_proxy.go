@@ -106,13 +106,13 @@ func resourceComputeTargetHttpProxyCreate(d *schema.ResourceData, meta interface
 		"urlMap":      urlMapProp,
 	}
 
-	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/global/targetHttpProxies")
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/targetHttpProxies")
 	if err != nil {
 		return err
 	}
 
 	log.Printf("[DEBUG] Creating new TargetHttpProxy: %#v", obj)
-	res, err := Post(config, url, obj)
+	res, err := Post(config, url, project, obj)
 	if err != nil {
 		return fmt.Errorf("Error creating TargetHttpProxy: %s", err)
 	}
@@ -131,12 +131,11 @@ func resourceComputeTargetHttpProxyCreate(d *schema.ResourceData, meta interface
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating TargetHttpProxy",
-		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+		config, op, project, "Creating TargetHttpProxy",
+		d.Timeout(schema.TimeoutCreate))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		clearIdUnlessStopped(d, config)
 		return fmt.Errorf("Error waiting to create TargetHttpProxy: %s", waitErr)
 	}
 
@@ -153,12 +152,12 @@ func resourceComputeTargetHttpProxyRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/global/targetHttpProxies/{{name}}")
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/targetHttpProxies/{{name}}")
 	if err != nil {
 		return err
 	}
 
-	res, err := Get(config, url)
+	res, err := Get(config, url, project)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("ComputeTargetHttpProxy %q", d.Id()))
 	}
@@ -212,11 +211,11 @@ func resourceComputeTargetHttpProxyUpdate(d *schema.ResourceData, meta interface
 		obj = map[string]interface{}{
 			"urlMap": urlMapProp,
 		}
-		url, err = replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/targetHttpProxies/{{name}}/setUrlMap")
+		url, err = replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/targetHttpProxies/{{name}}/setUrlMap")
 		if err != nil {
 			return err
 		}
-		res, err = sendRequest(config, "POST", url, obj)
+		res, err = sendRequest(config, "POST", url, project, obj)
 		if err != nil {
 			return fmt.Errorf("Error updating TargetHttpProxy %q: %s", d.Id(), err)
 		}
@@ -227,8 +226,8 @@ func resourceComputeTargetHttpProxyUpdate(d *schema.ResourceData, meta interface
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating TargetHttpProxy",
-			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+			config, op, project, "Updating TargetHttpProxy",
+			d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
 			return err
@@ -250,13 +249,13 @@ func resourceComputeTargetHttpProxyDelete(d *schema.ResourceData, meta interface
 		return err
 	}
 
-	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/global/targetHttpProxies/{{name}}")
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/targetHttpProxies/{{name}}")
 	if err != nil {
 		return err
 	}
 
 	log.Printf("[DEBUG] Deleting TargetHttpProxy %q", d.Id())
-	res, err := Delete(config, url)
+	res, err := Delete(config, url, project)
 	if err != nil {
 		return handleNotFoundError(err, d, "TargetHttpProxy")
 	}
@@ -268,8 +267,8 @@ func resourceComputeTargetHttpProxyDelete(d *schema.ResourceData, meta interface
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting TargetHttpProxy",
-		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+		config, op, project, "Deleting TargetHttpProxy",
+		d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
 		return err
@@ -4,19 +4,23 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_target_http_proxy", testSweepComputeResources("global/targetHttpProxies"),
+		"gcp_compute_global_forwarding_rule")
+}
+
 func TestAccComputeTargetHttpProxy_basic(t *testing.T) {
 	t.Parallel()
 
-	target := fmt.Sprintf("thttp-test-%s", acctest.RandString(10))
-	backend := fmt.Sprintf("thttp-test-%s", acctest.RandString(10))
-	hc := fmt.Sprintf("thttp-test-%s", acctest.RandString(10))
-	urlmap1 := fmt.Sprintf("thttp-test-%s", acctest.RandString(10))
-	urlmap2 := fmt.Sprintf("thttp-test-%s", acctest.RandString(10))
+	target := fmt.Sprintf("thttp-test-%s", randString(t, 10))
+	backend := fmt.Sprintf("thttp-test-%s", randString(t, 10))
+	hc := fmt.Sprintf("thttp-test-%s", randString(t, 10))
+	urlmap1 := fmt.Sprintf("thttp-test-%s", randString(t, 10))
+	urlmap2 := fmt.Sprintf("thttp-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -42,11 +46,11 @@ func TestAccComputeTargetHttpProxy_basic(t *testing.T) {
 func TestAccComputeTargetHttpProxy_update(t *testing.T) {
 	t.Parallel()
 
-	target := fmt.Sprintf("thttp-test-%s", acctest.RandString(10))
-	backend := fmt.Sprintf("thttp-test-%s", acctest.RandString(10))
-	hc := fmt.Sprintf("thttp-test-%s", acctest.RandString(10))
-	urlmap1 := fmt.Sprintf("thttp-test-%s", acctest.RandString(10))
-	urlmap2 := fmt.Sprintf("thttp-test-%s", acctest.RandString(10))
+	target := fmt.Sprintf("thttp-test-%s", randString(t, 10))
+	backend := fmt.Sprintf("thttp-test-%s", randString(t, 10))
+	hc := fmt.Sprintf("thttp-test-%s", randString(t, 10))
+	urlmap1 := fmt.Sprintf("thttp-test-%s", randString(t, 10))
+	urlmap2 := fmt.Sprintf("thttp-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -4,11 +4,16 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_target_pool", testSweepComputeResources("regions/{{region}}/targetPools"),
+		"gcp_compute_forwarding_rule", "gcp_compute_instance_group_manager",
+		"gcp_compute_region_instance_group_manager")
+}
+
 func TestAccComputeTargetPool_basic(t *testing.T) {
 	t.Parallel()
 
@@ -18,7 +23,7 @@ func TestAccComputeTargetPool_basic(t *testing.T) {
 		CheckDestroy: testAccCheckComputeTargetPoolDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeTargetPool_basic(),
+				Config: testAccComputeTargetPool_basic(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeTargetPoolExists(
 						"google_compute_target_pool.foo"),
@@ -103,7 +108,7 @@ func testAccCheckComputeTargetPoolHealthCheck(targetPool, healthCheck string) re
 	}
 }
 
-func testAccComputeTargetPool_basic() string {
+func testAccComputeTargetPool_basic(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_http_health_check" "foobar" {
 	name = "healthcheck-test-%s"
@@ -142,5 +147,5 @@ resource "google_compute_target_pool" "bar" {
 	health_checks = [
 		"${google_compute_http_health_check.foobar.self_link}"
 	]
-}`, acctest.RandString(10), acctest.RandString(10), acctest.RandString(10), acctest.RandString(10))
+}`, randString(t, 10), randString(t, 10), randString(t, 10), randString(t, 10))
 }
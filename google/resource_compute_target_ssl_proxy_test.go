@@ -4,17 +4,21 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_target_ssl_proxy", testSweepComputeResources("global/targetSslProxies"),
+		"gcp_compute_global_forwarding_rule")
+}
+
 func TestAccComputeTargetSslProxy_basic(t *testing.T) {
-	target := fmt.Sprintf("tssl-test-%s", acctest.RandString(10))
-	sslPolicy := fmt.Sprintf("tssl-test-%s", acctest.RandString(10))
-	cert := fmt.Sprintf("tssl-test-%s", acctest.RandString(10))
-	backend := fmt.Sprintf("tssl-test-%s", acctest.RandString(10))
-	hc := fmt.Sprintf("tssl-test-%s", acctest.RandString(10))
+	target := fmt.Sprintf("tssl-test-%s", randString(t, 10))
+	sslPolicy := fmt.Sprintf("tssl-test-%s", randString(t, 10))
+	cert := fmt.Sprintf("tssl-test-%s", randString(t, 10))
+	backend := fmt.Sprintf("tssl-test-%s", randString(t, 10))
+	hc := fmt.Sprintf("tssl-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -38,13 +42,13 @@ func TestAccComputeTargetSslProxy_basic(t *testing.T) {
 }
 
 func TestAccComputeTargetSslProxy_update(t *testing.T) {
-	target := fmt.Sprintf("tssl-test-%s", acctest.RandString(10))
-	sslPolicy := fmt.Sprintf("tssl-test-%s", acctest.RandString(10))
-	cert1 := fmt.Sprintf("tssl-test-%s", acctest.RandString(10))
-	cert2 := fmt.Sprintf("tssl-test-%s", acctest.RandString(10))
-	backend1 := fmt.Sprintf("tssl-test-%s", acctest.RandString(10))
-	backend2 := fmt.Sprintf("tssl-test-%s", acctest.RandString(10))
-	hc := fmt.Sprintf("tssl-test-%s", acctest.RandString(10))
+	target := fmt.Sprintf("tssl-test-%s", randString(t, 10))
+	sslPolicy := fmt.Sprintf("tssl-test-%s", randString(t, 10))
+	cert1 := fmt.Sprintf("tssl-test-%s", randString(t, 10))
+	cert2 := fmt.Sprintf("tssl-test-%s", randString(t, 10))
+	backend1 := fmt.Sprintf("tssl-test-%s", randString(t, 10))
+	backend2 := fmt.Sprintf("tssl-test-%s", randString(t, 10))
+	hc := fmt.Sprintf("tssl-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
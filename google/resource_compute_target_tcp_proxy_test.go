@@ -4,17 +4,21 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_target_tcp_proxy", testSweepComputeResources("global/targetTcpProxies"),
+		"gcp_compute_global_forwarding_rule")
+}
+
 func TestAccComputeTargetTcpProxy_basic(t *testing.T) {
 	t.Parallel()
 
-	target := fmt.Sprintf("ttcp-test-%s", acctest.RandString(10))
-	backend := fmt.Sprintf("ttcp-test-%s", acctest.RandString(10))
-	hc := fmt.Sprintf("ttcp-test-%s", acctest.RandString(10))
+	target := fmt.Sprintf("ttcp-test-%s", randString(t, 10))
+	backend := fmt.Sprintf("ttcp-test-%s", randString(t, 10))
+	hc := fmt.Sprintf("ttcp-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -40,9 +44,9 @@ func TestAccComputeTargetTcpProxy_basic(t *testing.T) {
 func TestAccComputeTargetTcpProxy_update(t *testing.T) {
 	t.Parallel()
 
-	target := fmt.Sprintf("ttcp-test-%s", acctest.RandString(10))
-	backend := fmt.Sprintf("ttcp-test-%s", acctest.RandString(10))
-	hc := fmt.Sprintf("ttcp-test-%s", acctest.RandString(10))
+	target := fmt.Sprintf("ttcp-test-%s", randString(t, 10))
+	backend := fmt.Sprintf("ttcp-test-%s", randString(t, 10))
+	hc := fmt.Sprintf("ttcp-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
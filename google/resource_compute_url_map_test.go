@@ -4,17 +4,21 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_url_map", testSweepComputeResources("global/urlMaps"),
+		"gcp_compute_target_http_proxy", "gcp_compute_target_https_proxy")
+}
+
 func TestAccComputeUrlMap_basic(t *testing.T) {
 	t.Parallel()
 
-	bsName := fmt.Sprintf("urlmap-test-%s", acctest.RandString(10))
-	hcName := fmt.Sprintf("urlmap-test-%s", acctest.RandString(10))
-	umName := fmt.Sprintf("urlmap-test-%s", acctest.RandString(10))
+	bsName := fmt.Sprintf("urlmap-test-%s", randString(t, 10))
+	hcName := fmt.Sprintf("urlmap-test-%s", randString(t, 10))
+	umName := fmt.Sprintf("urlmap-test-%s", randString(t, 10))
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -40,9 +44,9 @@ func TestAccComputeUrlMap_basic(t *testing.T) {
 func TestAccComputeUrlMap_update_path_matcher(t *testing.T) {
 	t.Parallel()
 
-	bsName := fmt.Sprintf("urlmap-test-%s", acctest.RandString(10))
-	hcName := fmt.Sprintf("urlmap-test-%s", acctest.RandString(10))
-	umName := fmt.Sprintf("urlmap-test-%s", acctest.RandString(10))
+	bsName := fmt.Sprintf("urlmap-test-%s", randString(t, 10))
+	hcName := fmt.Sprintf("urlmap-test-%s", randString(t, 10))
+	umName := fmt.Sprintf("urlmap-test-%s", randString(t, 10))
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -76,7 +80,7 @@ func TestAccComputeUrlMap_advanced(t *testing.T) {
 		CheckDestroy: testAccCheckComputeUrlMapDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeUrlMap_advanced1(),
+				Config: testAccComputeUrlMap_advanced1(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeUrlMapExists(
 						"google_compute_url_map.foobar"),
@@ -84,7 +88,7 @@ func TestAccComputeUrlMap_advanced(t *testing.T) {
 			},
 
 			resource.TestStep{
-				Config: testAccComputeUrlMap_advanced2(),
+				Config: testAccComputeUrlMap_advanced2(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeUrlMapExists(
 						"google_compute_url_map.foobar"),
@@ -97,9 +101,9 @@ func TestAccComputeUrlMap_advanced(t *testing.T) {
 func TestAccComputeUrlMap_noPathRulesWithUpdate(t *testing.T) {
 	t.Parallel()
 
-	bsName := fmt.Sprintf("urlmap-test-%s", acctest.RandString(10))
-	hcName := fmt.Sprintf("urlmap-test-%s", acctest.RandString(10))
-	umName := fmt.Sprintf("urlmap-test-%s", acctest.RandString(10))
+	bsName := fmt.Sprintf("urlmap-test-%s", randString(t, 10))
+	hcName := fmt.Sprintf("urlmap-test-%s", randString(t, 10))
+	umName := fmt.Sprintf("urlmap-test-%s", randString(t, 10))
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -251,7 +255,7 @@ resource "google_compute_url_map" "foobar" {
 `, bsName, hcName, umName)
 }
 
-func testAccComputeUrlMap_advanced1() string {
+func testAccComputeUrlMap_advanced1(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_backend_service" "foobar" {
 	name          = "urlmap-test-%s"
@@ -299,10 +303,10 @@ resource "google_compute_url_map" "foobar" {
 		}
 	}
 }
-`, acctest.RandString(10), acctest.RandString(10), acctest.RandString(10))
+`, randString(t, 10), randString(t, 10), randString(t, 10))
 }
 
-func testAccComputeUrlMap_advanced2() string {
+func testAccComputeUrlMap_advanced2(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_backend_service" "foobar" {
 	name          = "urlmap-test-%s"
@@ -370,7 +374,7 @@ resource "google_compute_url_map" "foobar" {
 		}
 	}
 }
-`, acctest.RandString(10), acctest.RandString(10), acctest.RandString(10))
+`, randString(t, 10), randString(t, 10), randString(t, 10))
 }
 
 func testAccComputeUrlMap_noPathRules(bsName, hcName, umName string) string {
@@ -4,13 +4,17 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_vpn_gateway", testSweepComputeResources("regions/{{region}}/targetVpnGateways"),
+		"gcp_compute_vpn_tunnel", "gcp_compute_forwarding_rule")
+}
+
 func TestAccComputeVpnGateway_basic(t *testing.T) {
 	t.Parallel()
 
@@ -20,7 +24,7 @@ func TestAccComputeVpnGateway_basic(t *testing.T) {
 		CheckDestroy: testAccCheckComputeVpnGatewayDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeVpnGateway_basic(),
+				Config: testAccComputeVpnGateway_basic(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeVpnGatewayExists(
 						"google_compute_vpn_gateway.foobar"),
@@ -84,7 +88,7 @@ func testAccCheckComputeVpnGatewayExists(n string) resource.TestCheckFunc {
 	}
 }
 
-func testAccComputeVpnGateway_basic() string {
+func testAccComputeVpnGateway_basic(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_network" "foobar" {
 	name = "gateway-test-%s"
@@ -101,5 +105,5 @@ resource "google_compute_vpn_gateway" "baz" {
 	name = "gateway-test-%s"
 	network = "${google_compute_network.foobar.name}"
 	region = "us-central1"
-}`, acctest.RandString(10), acctest.RandString(10), acctest.RandString(10))
+}`, randString(t, 10), randString(t, 10), randString(t, 10))
 }
@@ -18,6 +18,9 @@ func resourceComputeVpnTunnel() *schema.Resource {
 		Create: resourceComputeVpnTunnelCreate,
 		Read:   resourceComputeVpnTunnelRead,
 		Delete: resourceComputeVpnTunnelDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeVpnTunnelImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -41,9 +44,10 @@ func resourceComputeVpnTunnel() *schema.Resource {
 			},
 
 			"target_vpn_gateway": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
 			},
 
 			"description": &schema.Schema{
@@ -97,9 +101,10 @@ func resourceComputeVpnTunnel() *schema.Resource {
 			},
 
 			"router": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
 			},
 
 			"self_link": &schema.Schema{
@@ -179,7 +184,7 @@ func resourceComputeVpnTunnelCreate(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error Inserting VPN Tunnel %s : %s", name, err)
 	}
 
-	err = computeOperationWait(config.clientCompute, op, project, "Inserting VPN Tunnel")
+	err = computeOperationWait(config, op, project, "Inserting VPN Tunnel")
 	if err != nil {
 		return fmt.Errorf("Error Waiting to Insert VPN Tunnel %s: %s", name, err)
 	}
@@ -253,7 +258,7 @@ func resourceComputeVpnTunnelDelete(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error Reading VPN Tunnel %s: %s", name, err)
 	}
 
-	err = computeOperationWait(config.clientCompute, op, project, "Deleting VPN Tunnel")
+	err = computeOperationWait(config, op, project, "Deleting VPN Tunnel")
 	if err != nil {
 		return fmt.Errorf("Error Waiting to Delete VPN Tunnel %s: %s", name, err)
 	}
@@ -261,6 +266,35 @@ func resourceComputeVpnTunnelDelete(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
+func resourceComputeVpnTunnelImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/vpnTunnels/(?P<name>[^/]+)", "(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	// Arguments which can't be updated aren't read by
+	// resourceComputeVpnTunnelRead, so read them once here. The shared secret
+	// can't be read back at all.
+	vpnTunnel, err := config.clientCompute.VpnTunnels.Get(d.Get("project").(string), d.Get("region").(string), d.Id()).Do()
+	if err != nil {
+		return nil, fmt.Errorf("Error reading VPN Tunnel %q: %s", d.Id(), err)
+	}
+	d.Set("peer_ip", vpnTunnel.PeerIp)
+	d.Set("target_vpn_gateway", vpnTunnel.TargetVpnGateway)
+	d.Set("description", vpnTunnel.Description)
+	d.Set("ike_version", vpnTunnel.IkeVersion)
+	d.Set("router", vpnTunnel.Router)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 // validatePeerAddr returns false if a tunnel's peer_ip property
 // is invalid. Currently, only addresses that collide with RFC
 // 5735 (https://tools.ietf.org/html/rfc5735) fail validation.
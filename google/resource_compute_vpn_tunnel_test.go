@@ -4,13 +4,16 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 
 	"google.golang.org/api/compute/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_compute_vpn_tunnel", testSweepComputeResources("regions/{{region}}/vpnTunnels"))
+}
+
 func TestAccComputeVpnTunnel_basic(t *testing.T) {
 	t.Parallel()
 
@@ -20,7 +23,7 @@ func TestAccComputeVpnTunnel_basic(t *testing.T) {
 		CheckDestroy: testAccCheckComputeVpnTunnelDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeVpnTunnel_basic(),
+				Config: testAccComputeVpnTunnel_basic(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeVpnTunnelExists(
 						"google_compute_vpn_tunnel.foobar"),
@@ -30,6 +33,12 @@ func TestAccComputeVpnTunnel_basic(t *testing.T) {
 						"google_compute_vpn_tunnel.foobar", "remote_traffic_selector.#", "2"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:            "google_compute_vpn_tunnel.foobar",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"shared_secret"},
+			},
 		},
 	})
 }
@@ -37,14 +46,14 @@ func TestAccComputeVpnTunnel_basic(t *testing.T) {
 func TestAccComputeVpnTunnel_router(t *testing.T) {
 	t.Parallel()
 
-	router := fmt.Sprintf("tunnel-test-router-%s", acctest.RandString(10))
+	router := fmt.Sprintf("tunnel-test-router-%s", randString(t, 10))
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckComputeVpnTunnelDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeVpnTunnelRouter(router),
+				Config: testAccComputeVpnTunnelRouter(t, router),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeVpnTunnelExists(
 						"google_compute_vpn_tunnel.foobar"),
@@ -65,7 +74,7 @@ func TestAccComputeVpnTunnel_defaultTrafficSelectors(t *testing.T) {
 		CheckDestroy: testAccCheckComputeVpnTunnelDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccComputeVpnTunnelDefaultTrafficSelectors(),
+				Config: testAccComputeVpnTunnelDefaultTrafficSelectors(t),
 				Check: testAccCheckComputeVpnTunnelExists(
 					"google_compute_vpn_tunnel.foobar"),
 			},
@@ -125,7 +134,7 @@ func testAccCheckComputeVpnTunnelExists(n string) resource.TestCheckFunc {
 	}
 }
 
-func testAccComputeVpnTunnel_basic() string {
+func testAccComputeVpnTunnel_basic(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_network" "foobar" {
 	name = "tunnel-test-%s"
@@ -176,13 +185,13 @@ resource "google_compute_vpn_tunnel" "foobar" {
 	peer_ip = "8.8.8.8"
 	local_traffic_selector = ["${google_compute_subnetwork.foobar.ip_cidr_range}"]
 	remote_traffic_selector = ["192.168.0.0/24", "192.168.1.0/24"]
-}`, acctest.RandString(10), acctest.RandString(10), acctest.RandString(10),
-		acctest.RandString(10), acctest.RandString(10), acctest.RandString(10),
-		acctest.RandString(10), acctest.RandString(10))
+}`, randString(t, 10), randString(t, 10), randString(t, 10),
+		randString(t, 10), randString(t, 10), randString(t, 10),
+		randString(t, 10), randString(t, 10))
 }
 
-func testAccComputeVpnTunnelRouter(router string) string {
-	testId := acctest.RandString(10)
+func testAccComputeVpnTunnelRouter(t *testing.T, router string) string {
+	testId := randString(t, 10)
 	return fmt.Sprintf(`
 		resource "google_compute_network" "foobar" {
 			name = "tunnel-test-%s"
@@ -244,7 +253,7 @@ func testAccComputeVpnTunnelRouter(router string) string {
 	`, testId, testId, testId, testId, testId, testId, testId, router, testId)
 }
 
-func testAccComputeVpnTunnelDefaultTrafficSelectors() string {
+func testAccComputeVpnTunnelDefaultTrafficSelectors(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_network" "foobar" {
 	name = "tunnel-test-%s"
@@ -288,7 +297,7 @@ resource "google_compute_vpn_tunnel" "foobar" {
 	target_vpn_gateway = "${google_compute_vpn_gateway.foobar.self_link}"
 	shared_secret = "unguessable"
 	peer_ip = "8.8.8.8"
-}`, acctest.RandString(10), acctest.RandString(10), acctest.RandString(10),
-		acctest.RandString(10), acctest.RandString(10), acctest.RandString(10),
-		acctest.RandString(10))
+}`, randString(t, 10), randString(t, 10), randString(t, 10),
+		randString(t, 10), randString(t, 10), randString(t, 10),
+		randString(t, 10))
 }
@@ -3,21 +3,62 @@ package google
 import (
 	"bytes"
 	"fmt"
+	"log"
+	"strings"
 	"testing"
+	"time"
 
 	"strconv"
 
 	"regexp"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func init() {
+	// Node pools are deleted along with their cluster.
+	addTestSweeper("gcp_container_cluster", testSweepContainerClusters)
+}
+
+func testSweepContainerClusters(region string) error {
+	config, err := sweeperConfig(region)
+	if err != nil {
+		return err
+	}
+
+	// Clusters of every zone and region are listed at once.
+	res, err := config.clientContainerBeta.Projects.Locations.Clusters.List(fmt.Sprintf("projects/%s/locations/-", config.Project)).Do()
+	if err != nil {
+		return fmt.Errorf("error listing clusters: %s", err)
+	}
+
+	for _, cluster := range res.Clusters {
+		if !isSweepableTestResource(cluster.Name) {
+			continue
+		}
+		if cluster.Location != region && !strings.HasPrefix(cluster.Location, region+"-") {
+			continue
+		}
+
+		log.Printf("[INFO] Sweeping GKE cluster %s in %s", cluster.Name, cluster.Location)
+		op, err := config.clientContainerBeta.Projects.Locations.Clusters.Delete(containerClusterFullName(config.Project, cluster.Location, cluster.Name)).Do()
+		if err != nil {
+			log.Printf("[INFO] Error deleting GKE cluster %s: %s", cluster.Name, err)
+			continue
+		}
+		if err := containerBetaOperationWait(config, op, config.Project, cluster.Location, "Sweeping GKE cluster", 30*time.Minute); err != nil {
+			log.Printf("[INFO] Error deleting GKE cluster %s: %s", cluster.Name, err)
+		}
+	}
+
+	return nil
+}
+
 func TestAccContainerCluster_basic(t *testing.T) {
 	t.Parallel()
 
-	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+	clusterName := fmt.Sprintf("tf-test-cluster-%s", randString(t, 10))
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -51,7 +92,7 @@ func TestAccContainerCluster_withTimeout(t *testing.T) {
 		CheckDestroy: testAccCheckContainerClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccContainerCluster_withTimeout(),
+				Config: testAccContainerCluster_withTimeout(t),
 			},
 			{
 				ResourceName:        "google_container_cluster.primary",
@@ -66,7 +107,7 @@ func TestAccContainerCluster_withTimeout(t *testing.T) {
 func TestAccContainerCluster_withAddons(t *testing.T) {
 	t.Parallel()
 
-	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+	clusterName := fmt.Sprintf("tf-test-cluster-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -113,7 +154,7 @@ func TestAccContainerCluster_withMasterAuthConfig(t *testing.T) {
 		CheckDestroy: testAccCheckContainerClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccContainerCluster_withMasterAuth(),
+				Config: testAccContainerCluster_withMasterAuth(t),
 			},
 			{
 				ResourceName:        "google_container_cluster.with_master_auth",
@@ -134,7 +175,7 @@ func TestAccContainerCluster_withMasterAuthConfig_NoCert(t *testing.T) {
 		CheckDestroy: testAccCheckContainerClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccContainerCluster_withMasterAuthNoCert(),
+				Config: testAccContainerCluster_withMasterAuthNoCert(t),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("google_container_cluster.with_master_auth_no_cert", "master_auth.0.client_certificate", ""),
 				),
@@ -152,7 +193,7 @@ func TestAccContainerCluster_withMasterAuthConfig_NoCert(t *testing.T) {
 func TestAccContainerCluster_withNetworkPolicyEnabled(t *testing.T) {
 	t.Parallel()
 
-	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+	clusterName := fmt.Sprintf("tf-test-cluster-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -227,7 +268,7 @@ func TestAccContainerCluster_withNetworkPolicyEnabled(t *testing.T) {
 func TestAccContainerCluster_withMasterAuthorizedNetworksConfig(t *testing.T) {
 	t.Parallel()
 
-	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+	clusterName := fmt.Sprintf("tf-test-cluster-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -276,7 +317,7 @@ func TestAccContainerCluster_withMasterAuthorizedNetworksConfig(t *testing.T) {
 func TestAccContainerCluster_regional(t *testing.T) {
 	t.Parallel()
 
-	clusterName := fmt.Sprintf("cluster-test-regional-%s", acctest.RandString(10))
+	clusterName := fmt.Sprintf("tf-test-cluster-regional-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -299,8 +340,8 @@ func TestAccContainerCluster_regional(t *testing.T) {
 func TestAccContainerCluster_regionalWithNodePool(t *testing.T) {
 	t.Parallel()
 
-	clusterName := fmt.Sprintf("cluster-test-regional-%s", acctest.RandString(10))
-	npName := fmt.Sprintf("tf-cluster-nodepool-test-%s", acctest.RandString(10))
+	clusterName := fmt.Sprintf("tf-test-cluster-regional-%s", randString(t, 10))
+	npName := fmt.Sprintf("tf-test-cluster-nodepool-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -323,7 +364,7 @@ func TestAccContainerCluster_regionalWithNodePool(t *testing.T) {
 func TestAccContainerCluster_withAdditionalZones(t *testing.T) {
 	t.Parallel()
 
-	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+	clusterName := fmt.Sprintf("tf-test-cluster-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -355,7 +396,7 @@ func TestAccContainerCluster_withAdditionalZones(t *testing.T) {
 func TestAccContainerCluster_regionalWithAdditionalZones(t *testing.T) {
 	t.Parallel()
 
-	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+	clusterName := fmt.Sprintf("tf-test-cluster-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -387,7 +428,7 @@ func TestAccContainerCluster_regionalWithAdditionalZones(t *testing.T) {
 func TestAccContainerCluster_withKubernetesAlpha(t *testing.T) {
 	t.Parallel()
 
-	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+	clusterName := fmt.Sprintf("tf-test-cluster-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -413,7 +454,7 @@ func TestAccContainerCluster_withKubernetesAlpha(t *testing.T) {
 func TestAccContainerCluster_withPrivateCluster(t *testing.T) {
 	t.Parallel()
 
-	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+	clusterName := fmt.Sprintf("tf-test-cluster-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -439,7 +480,7 @@ func TestAccContainerCluster_withPrivateCluster(t *testing.T) {
 func TestAccContainerCluster_withLegacyAbac(t *testing.T) {
 	t.Parallel()
 
-	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+	clusterName := fmt.Sprintf("tf-test-cluster-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -488,7 +529,7 @@ func TestAccContainerCluster_withDefaultLegacyAbac(t *testing.T) {
 		CheckDestroy: testAccCheckContainerClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccContainerCluster_defaultLegacyAbac(acctest.RandString(10)),
+				Config: testAccContainerCluster_defaultLegacyAbac(randString(t, 10)),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("google_container_cluster.default_legacy_abac", "enable_legacy_abac", "false"),
 				),
@@ -506,7 +547,7 @@ func TestAccContainerCluster_withDefaultLegacyAbac(t *testing.T) {
 func TestAccContainerCluster_withVersion(t *testing.T) {
 	t.Parallel()
 
-	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+	clusterName := fmt.Sprintf("tf-test-cluster-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -530,7 +571,7 @@ func TestAccContainerCluster_withVersion(t *testing.T) {
 func TestAccContainerCluster_updateVersion(t *testing.T) {
 	t.Parallel()
 
-	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+	clusterName := fmt.Sprintf("tf-test-cluster-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -570,7 +611,7 @@ func TestAccContainerCluster_withNodeConfig(t *testing.T) {
 		CheckDestroy: testAccCheckContainerClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccContainerCluster_withNodeConfig(),
+				Config: testAccContainerCluster_withNodeConfig(t),
 			},
 			{
 				ResourceName:        "google_container_cluster.with_node_config",
@@ -591,7 +632,7 @@ func TestAccContainerCluster_withNodeConfigScopeAlias(t *testing.T) {
 		CheckDestroy: testAccCheckContainerClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccContainerCluster_withNodeConfigScopeAlias(),
+				Config: testAccContainerCluster_withNodeConfigScopeAlias(t),
 			},
 			{
 				ResourceName:        "google_container_cluster.with_node_config_scope_alias",
@@ -612,7 +653,7 @@ func TestAccContainerCluster_withNodeConfigTaints(t *testing.T) {
 		CheckDestroy: testAccCheckContainerClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccContainerCluster_withNodeConfigTaints(),
+				Config: testAccContainerCluster_withNodeConfigTaints(t),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("google_container_cluster.with_node_config", "node_config.0.taint.#", "2"),
 				),
@@ -632,7 +673,7 @@ func TestAccContainerCluster_withWorkloadMetadataConfig(t *testing.T) {
 		CheckDestroy: testAccCheckContainerClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccContainerCluster_withWorkloadMetadataConfig(),
+				Config: testAccContainerCluster_withWorkloadMetadataConfig(t),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("google_container_cluster.with_workload_metadata_config",
 						"node_config.0.workload_metadata_config.0.node_metadata", "SECURE"),
@@ -658,7 +699,7 @@ func TestAccContainerCluster_network(t *testing.T) {
 		CheckDestroy: testAccCheckContainerClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccContainerCluster_networkRef(),
+				Config: testAccContainerCluster_networkRef(t),
 			},
 			{
 				ResourceName:        "google_container_cluster.with_net_ref_by_url",
@@ -685,7 +726,7 @@ func TestAccContainerCluster_backend(t *testing.T) {
 		CheckDestroy: testAccCheckContainerClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccContainerCluster_backendRef(),
+				Config: testAccContainerCluster_backendRef(t),
 			},
 			{
 				ResourceName:        "google_container_cluster.primary",
@@ -700,7 +741,7 @@ func TestAccContainerCluster_backend(t *testing.T) {
 func TestAccContainerCluster_withLogging(t *testing.T) {
 	t.Parallel()
 
-	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+	clusterName := fmt.Sprintf("tf-test-cluster-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -738,7 +779,7 @@ func TestAccContainerCluster_withLogging(t *testing.T) {
 func TestAccContainerCluster_withMonitoring(t *testing.T) {
 	t.Parallel()
 
-	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+	clusterName := fmt.Sprintf("tf-test-cluster-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -776,8 +817,8 @@ func TestAccContainerCluster_withMonitoring(t *testing.T) {
 func TestAccContainerCluster_withNodePoolBasic(t *testing.T) {
 	t.Parallel()
 
-	clusterName := fmt.Sprintf("tf-cluster-nodepool-test-%s", acctest.RandString(10))
-	npName := fmt.Sprintf("tf-cluster-nodepool-test-%s", acctest.RandString(10))
+	clusterName := fmt.Sprintf("tf-test-cluster-nodepool-%s", randString(t, 10))
+	npName := fmt.Sprintf("tf-test-cluster-nodepool-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -800,8 +841,8 @@ func TestAccContainerCluster_withNodePoolBasic(t *testing.T) {
 func TestAccContainerCluster_withNodePoolResize(t *testing.T) {
 	t.Parallel()
 
-	clusterName := fmt.Sprintf("tf-cluster-nodepool-test-%s", acctest.RandString(10))
-	npName := fmt.Sprintf("tf-cluster-nodepool-test-%s", acctest.RandString(10))
+	clusterName := fmt.Sprintf("tf-test-cluster-nodepool-%s", randString(t, 10))
+	npName := fmt.Sprintf("tf-test-cluster-nodepool-%s", randString(t, 10))
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -838,8 +879,8 @@ func TestAccContainerCluster_withNodePoolResize(t *testing.T) {
 func TestAccContainerCluster_withNodePoolAutoscaling(t *testing.T) {
 	t.Parallel()
 
-	clusterName := fmt.Sprintf("tf-cluster-nodepool-test-%s", acctest.RandString(10))
-	npName := fmt.Sprintf("tf-cluster-nodepool-test-%s", acctest.RandString(10))
+	clusterName := fmt.Sprintf("tf-test-cluster-nodepool-%s", randString(t, 10))
+	npName := fmt.Sprintf("tf-test-cluster-nodepool-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -898,7 +939,7 @@ func TestAccContainerCluster_withNodePoolNamePrefix(t *testing.T) {
 		CheckDestroy: testAccCheckContainerClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccContainerCluster_withNodePoolNamePrefix(),
+				Config: testAccContainerCluster_withNodePoolNamePrefix(t),
 			},
 			{
 				ResourceName:            "google_container_cluster.with_node_pool_name_prefix",
@@ -920,7 +961,7 @@ func TestAccContainerCluster_withNodePoolMultiple(t *testing.T) {
 		CheckDestroy: testAccCheckContainerClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccContainerCluster_withNodePoolMultiple(),
+				Config: testAccContainerCluster_withNodePoolMultiple(t),
 			},
 			{
 				ResourceName:        "google_container_cluster.with_node_pool_multiple",
@@ -941,7 +982,7 @@ func TestAccContainerCluster_withNodePoolConflictingNameFields(t *testing.T) {
 		CheckDestroy: testAccCheckContainerClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config:      testAccContainerCluster_withNodePoolConflictingNameFields(),
+				Config:      testAccContainerCluster_withNodePoolConflictingNameFields(t),
 				ExpectError: regexp.MustCompile("Cannot specify both name and name_prefix for a node_pool"),
 			},
 		},
@@ -957,7 +998,7 @@ func TestAccContainerCluster_withNodePoolNodeConfig(t *testing.T) {
 		CheckDestroy: testAccCheckContainerClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccContainerCluster_withNodePoolNodeConfig(),
+				Config: testAccContainerCluster_withNodePoolNodeConfig(t),
 			},
 			{
 				ResourceName:        "google_container_cluster.with_node_pool_node_config",
@@ -978,7 +1019,7 @@ func TestAccContainerCluster_withDefaultNodePoolRemoved(t *testing.T) {
 		CheckDestroy: testAccCheckContainerClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccContainerCluster_withDefaultNodePoolRemoved(),
+				Config: testAccContainerCluster_withDefaultNodePoolRemoved(t),
 				Check: resource.TestCheckResourceAttr(
 					"google_container_cluster.with_default_node_pool_removed", "node_pool.#", "0"),
 			},
@@ -995,7 +1036,7 @@ func TestAccContainerCluster_withDefaultNodePoolRemoved(t *testing.T) {
 
 func TestAccContainerCluster_withMaintenanceWindow(t *testing.T) {
 	t.Parallel()
-	clusterName := acctest.RandString(10)
+	clusterName := randString(t, 10)
 	resourceName := "google_container_cluster.with_maintenance_window"
 
 	resource.Test(t, resource.TestCase{
@@ -1035,14 +1076,14 @@ func TestAccContainerCluster_withMaintenanceWindow(t *testing.T) {
 func TestAccContainerCluster_withIPAllocationPolicy(t *testing.T) {
 	t.Parallel()
 
-	cluster := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+	cluster := fmt.Sprintf("tf-test-cluster-%s", randString(t, 10))
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckContainerClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccContainerCluster_withIPAllocationPolicy(
+				Config: testAccContainerCluster_withIPAllocationPolicy(t,
 					cluster,
 					map[string]string{
 						"pods":     "10.1.0.0/16",
@@ -1067,7 +1108,7 @@ func TestAccContainerCluster_withIPAllocationPolicy(t *testing.T) {
 				ImportStateVerify:   true,
 			},
 			{
-				Config: testAccContainerCluster_withIPAllocationPolicy(
+				Config: testAccContainerCluster_withIPAllocationPolicy(t,
 					cluster,
 					map[string]string{
 						"pods":     "10.1.0.0/16",
@@ -1078,7 +1119,7 @@ func TestAccContainerCluster_withIPAllocationPolicy(t *testing.T) {
 				ExpectError: regexp.MustCompile("clusters using IP aliases must specify secondary ranges"),
 			},
 			{
-				Config: testAccContainerCluster_withIPAllocationPolicy(
+				Config: testAccContainerCluster_withIPAllocationPolicy(t,
 					cluster,
 					map[string]string{
 						"pods": "10.1.0.0/16",
@@ -1097,7 +1138,7 @@ func TestAccContainerCluster_withIPAllocationPolicy(t *testing.T) {
 func TestAccContainerCluster_withPodSecurityPolicy(t *testing.T) {
 	t.Parallel()
 
-	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+	clusterName := fmt.Sprintf("tf-test-cluster-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -1137,10 +1178,10 @@ func TestAccContainerCluster_withPodSecurityPolicy(t *testing.T) {
 func TestAccContainerCluster_sharedVpc(t *testing.T) {
 	t.Parallel()
 
-	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+	clusterName := fmt.Sprintf("tf-test-cluster-%s", randString(t, 10))
 	org := getTestOrgFromEnv(t)
 	billingId := getTestBillingAccountFromEnv(t)
-	projectName := fmt.Sprintf("tf-xpntest-%s", acctest.RandString(10))
+	projectName := fmt.Sprintf("tf-test-xpn-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -1148,7 +1189,7 @@ func TestAccContainerCluster_sharedVpc(t *testing.T) {
 		CheckDestroy: testAccCheckContainerClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccContainerCluster_sharedVpc(org, billingId, projectName, clusterName),
+				Config: testAccContainerCluster_sharedVpc(t, org, billingId, projectName, clusterName),
 			},
 			{
 				ResourceName:        "google_container_cluster.shared_vpc_cluster",
@@ -1279,10 +1320,10 @@ resource "google_container_cluster" "primary" {
 }`, name)
 }
 
-func testAccContainerCluster_withTimeout() string {
+func testAccContainerCluster_withTimeout(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "primary" {
-	name = "cluster-test-%s"
+	name = "tf-test-cluster-%s"
 	zone = "us-central1-a"
 	initial_node_count = 3
 
@@ -1291,7 +1332,7 @@ resource "google_container_cluster" "primary" {
 		delete = "30m"
 		update = "30m"
 	}
-}`, acctest.RandString(10))
+}`, randString(t, 10))
 }
 
 func testAccContainerCluster_withAddons(clusterName string) string {
@@ -1323,10 +1364,10 @@ resource "google_container_cluster" "primary" {
 }`, clusterName)
 }
 
-func testAccContainerCluster_withMasterAuth() string {
+func testAccContainerCluster_withMasterAuth(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "with_master_auth" {
-	name = "cluster-test-%s"
+	name = "tf-test-cluster-%s"
 	zone = "us-central1-a"
 	initial_node_count = 3
 
@@ -1334,13 +1375,13 @@ resource "google_container_cluster" "with_master_auth" {
 		username = "mr.yoda"
 		password = "adoy.rm.123456789"
 	}
-}`, acctest.RandString(10))
+}`, randString(t, 10))
 }
 
-func testAccContainerCluster_updateMasterAuthNoCert() string {
+func testAccContainerCluster_updateMasterAuthNoCert(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "with_master_auth" {
-	name = "cluster-test-%s"
+	name = "tf-test-cluster-%s"
 	zone = "us-central1-a"
 	initial_node_count = 3
 
@@ -1351,13 +1392,13 @@ resource "google_container_cluster" "with_master_auth" {
 			issue_client_certificate = false
 		}
 	}
-}`, acctest.RandString(10))
+}`, randString(t, 10))
 }
 
-func testAccContainerCluster_withMasterAuthNoCert() string {
+func testAccContainerCluster_withMasterAuthNoCert(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "with_master_auth_no_cert" {
-	name = "cluster-test-%s"
+	name = "tf-test-cluster-%s"
 	zone = "us-central1-a"
 	initial_node_count = 3
 
@@ -1368,7 +1409,7 @@ resource "google_container_cluster" "with_master_auth_no_cert" {
 			issue_client_certificate = false
 		}
 	}
-}`, acctest.RandString(10))
+}`, randString(t, 10))
 }
 
 func testAccContainerCluster_withNetworkPolicyEnabled(clusterName string) string {
@@ -1539,7 +1580,7 @@ resource "google_container_cluster" "with_additional_zones" {
 func testAccContainerCluster_withKubernetesAlpha(clusterName string) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "with_kubernetes_alpha" {
-	name = "cluster-test-%s"
+	name = "tf-test-cluster-%s"
 	zone = "us-central1-a"
 	initial_node_count = 1
 
@@ -1550,7 +1591,7 @@ resource "google_container_cluster" "with_kubernetes_alpha" {
 func testAccContainerCluster_defaultLegacyAbac(clusterName string) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "default_legacy_abac" {
-	name = "cluster-test-%s"
+	name = "tf-test-cluster-%s"
 	zone = "us-central1-a"
 	initial_node_count = 1
 }`, clusterName)
@@ -1559,7 +1600,7 @@ resource "google_container_cluster" "default_legacy_abac" {
 func testAccContainerCluster_withLegacyAbac(clusterName string) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "with_legacy_abac" {
-	name = "cluster-test-%s"
+	name = "tf-test-cluster-%s"
 	zone = "us-central1-a"
 	initial_node_count = 1
 
@@ -1570,7 +1611,7 @@ resource "google_container_cluster" "with_legacy_abac" {
 func testAccContainerCluster_updateLegacyAbac(clusterName string) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "with_legacy_abac" {
-	name = "cluster-test-%s"
+	name = "tf-test-cluster-%s"
 	zone = "us-central1-a"
 	initial_node_count = 1
 
@@ -1585,7 +1626,7 @@ data "google_container_engine_versions" "central1a" {
 }
 
 resource "google_container_cluster" "with_version" {
-	name = "cluster-test-%s"
+	name = "tf-test-cluster-%s"
 	zone = "us-central1-a"
 	min_master_version = "${data.google_container_engine_versions.central1a.latest_master_version}"
 	initial_node_count = 1
@@ -1599,7 +1640,7 @@ data "google_container_engine_versions" "central1a" {
 }
 
 resource "google_container_cluster" "with_version" {
-	name = "cluster-test-%s"
+	name = "tf-test-cluster-%s"
 	zone = "us-central1-a"
 	min_master_version = "${data.google_container_engine_versions.central1a.valid_master_versions.2}"
 	initial_node_count = 1
@@ -1613,7 +1654,7 @@ data "google_container_engine_versions" "central1a" {
 }
 
 resource "google_container_cluster" "with_version" {
-	name = "cluster-test-%s"
+	name = "tf-test-cluster-%s"
 	zone = "us-central1-a"
 	min_master_version = "${data.google_container_engine_versions.central1a.valid_master_versions.1}"
 	node_version = "${data.google_container_engine_versions.central1a.valid_node_versions.1}"
@@ -1621,10 +1662,10 @@ resource "google_container_cluster" "with_version" {
 }`, clusterName)
 }
 
-func testAccContainerCluster_withNodeConfig() string {
+func testAccContainerCluster_withNodeConfig(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "with_node_config" {
-	name = "cluster-test-%s"
+	name = "tf-test-cluster-%s"
 	zone = "us-central1-f"
 	initial_node_count = 1
 
@@ -1650,13 +1691,13 @@ resource "google_container_cluster" "with_node_config" {
 		preemptible = true
 		min_cpu_platform = "Intel Broadwell"
 	}
-}`, acctest.RandString(10))
+}`, randString(t, 10))
 }
 
-func testAccContainerCluster_withNodeConfigScopeAlias() string {
+func testAccContainerCluster_withNodeConfigScopeAlias(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "with_node_config_scope_alias" {
-	name = "cluster-test-%s"
+	name = "tf-test-cluster-%s"
 	zone = "us-central1-f"
 	initial_node_count = 1
 
@@ -1665,13 +1706,13 @@ resource "google_container_cluster" "with_node_config_scope_alias" {
 		disk_size_gb = 15
 		oauth_scopes = [ "compute-rw", "storage-ro", "logging-write", "monitoring" ]
 	}
-}`, acctest.RandString(10))
+}`, randString(t, 10))
 }
 
-func testAccContainerCluster_withNodeConfigTaints() string {
+func testAccContainerCluster_withNodeConfigTaints(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "with_node_config" {
-	name = "cluster-test-%s"
+	name = "tf-test-cluster-%s"
 	zone = "us-central1-f"
 	initial_node_count = 1
 
@@ -1687,17 +1728,17 @@ resource "google_container_cluster" "with_node_config" {
 			effect = "NO_EXECUTE"
 		}
 	}
-}`, acctest.RandString(10))
+}`, randString(t, 10))
 }
 
-func testAccContainerCluster_withWorkloadMetadataConfig() string {
+func testAccContainerCluster_withWorkloadMetadataConfig(t *testing.T) string {
 	return fmt.Sprintf(`
 data "google_container_engine_versions" "central1a" {
   zone = "us-central1-a"
 }
 
 resource "google_container_cluster" "with_workload_metadata_config" {
-  name               = "cluster-test-%s"
+  name               = "tf-test-cluster-%s"
   zone               = "us-central1-a"
   initial_node_count = 1
   min_master_version = "${data.google_container_engine_versions.central1a.latest_master_version}"
@@ -1714,18 +1755,18 @@ resource "google_container_cluster" "with_workload_metadata_config" {
     }
   }
 }
-`, acctest.RandString(10))
+`, randString(t, 10))
 }
 
-func testAccContainerCluster_networkRef() string {
+func testAccContainerCluster_networkRef(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_network" "container_network" {
-	name = "container-net-%s"
+	name = "tf-test-container-net-%s"
 	auto_create_subnetworks = true
 }
 
 resource "google_container_cluster" "with_net_ref_by_url" {
-	name = "cluster-test-%s"
+	name = "tf-test-cluster-%s"
 	zone = "us-central1-a"
 	initial_node_count = 1
 
@@ -1733,18 +1774,18 @@ resource "google_container_cluster" "with_net_ref_by_url" {
 }
 
 resource "google_container_cluster" "with_net_ref_by_name" {
-	name = "cluster-test-%s"
+	name = "tf-test-cluster-%s"
 	zone = "us-central1-a"
 	initial_node_count = 1
 
 	network = "${google_compute_network.container_network.name}"
-}`, acctest.RandString(10), acctest.RandString(10), acctest.RandString(10))
+}`, randString(t, 10), randString(t, 10), randString(t, 10))
 }
 
-func testAccContainerCluster_backendRef() string {
+func testAccContainerCluster_backendRef(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_compute_backend_service" "my-backend-service" {
-  name      = "terraform-test-%s"
+  name      = "tf-test-%s"
   port_name = "http"
   protocol  = "HTTP"
 
@@ -1756,14 +1797,14 @@ resource "google_compute_backend_service" "my-backend-service" {
 }
 
 resource "google_compute_http_health_check" "default" {
-  name               = "terraform-test-%s"
+  name               = "tf-test-%s"
   request_path       = "/"
   check_interval_sec = 1
   timeout_sec        = 1
 }
 
 resource "google_container_cluster" "primary" {
-  name               = "terraform-test-%s"
+  name               = "tf-test-%s"
   zone               = "us-central1-a"
   initial_node_count = 3
 
@@ -1781,13 +1822,13 @@ resource "google_container_cluster" "primary" {
     ]
   }
 }
-`, acctest.RandString(10), acctest.RandString(10), acctest.RandString(10))
+`, randString(t, 10), randString(t, 10), randString(t, 10))
 }
 
 func testAccContainerCluster_withLogging(clusterName string) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "with_logging" {
-	name               = "cluster-test-%s"
+	name               = "tf-test-cluster-%s"
 	zone               = "us-central1-a"
 	initial_node_count = 1
 
@@ -1798,7 +1839,7 @@ resource "google_container_cluster" "with_logging" {
 func testAccContainerCluster_updateLogging(clusterName string) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "with_logging" {
-	name               = "cluster-test-%s"
+	name               = "tf-test-cluster-%s"
 	zone               = "us-central1-a"
 	initial_node_count = 1
 
@@ -1809,7 +1850,7 @@ resource "google_container_cluster" "with_logging" {
 func testAccContainerCluster_withMonitoring(clusterName string) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "with_monitoring" {
-	name               = "cluster-test-%s"
+	name               = "tf-test-cluster-%s"
 	zone               = "us-central1-a"
 	initial_node_count = 1
 
@@ -1820,7 +1861,7 @@ resource "google_container_cluster" "with_monitoring" {
 func testAccContainerCluster_updateMonitoring(clusterName string) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "with_monitoring" {
-	name               = "cluster-test-%s"
+	name               = "tf-test-cluster-%s"
 	zone               = "us-central1-a"
 	initial_node_count = 1
 
@@ -1911,60 +1952,60 @@ resource "google_container_cluster" "with_node_pool" {
 }`, cluster, np)
 }
 
-func testAccContainerCluster_withNodePoolNamePrefix() string {
+func testAccContainerCluster_withNodePoolNamePrefix(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "with_node_pool_name_prefix" {
-	name = "tf-cluster-nodepool-test-%s"
+	name = "tf-test-cluster-nodepool-%s"
 	zone = "us-central1-a"
 
 	node_pool {
 		name_prefix = "tf-np-test"
 		node_count  = 2
 	}
-}`, acctest.RandString(10))
+}`, randString(t, 10))
 }
 
-func testAccContainerCluster_withNodePoolMultiple() string {
+func testAccContainerCluster_withNodePoolMultiple(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "with_node_pool_multiple" {
-	name = "tf-cluster-nodepool-test-%s"
+	name = "tf-test-cluster-nodepool-%s"
 	zone = "us-central1-a"
 
 	node_pool {
-		name       = "tf-cluster-nodepool-test-%s"
+		name       = "tf-test-cluster-nodepool-%s"
 		node_count = 2
 	}
 
 	node_pool {
-		name       = "tf-cluster-nodepool-test-%s"
+		name       = "tf-test-cluster-nodepool-%s"
 		node_count = 3
 	}
-}`, acctest.RandString(10), acctest.RandString(10), acctest.RandString(10))
+}`, randString(t, 10), randString(t, 10), randString(t, 10))
 }
 
-func testAccContainerCluster_withNodePoolConflictingNameFields() string {
+func testAccContainerCluster_withNodePoolConflictingNameFields(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "with_node_pool_multiple" {
-	name = "tf-cluster-nodepool-test-%s"
+	name = "tf-test-cluster-nodepool-%s"
 	zone = "us-central1-a"
 
 	node_pool {
 		# ERROR: name and name_prefix cannot be both specified
-		name        = "tf-cluster-nodepool-test-%s"
+		name        = "tf-test-cluster-nodepool-%s"
 		name_prefix = "tf-cluster-nodepool-test-"
 		node_count  = 1
 	}
-}`, acctest.RandString(10), acctest.RandString(10))
+}`, randString(t, 10), randString(t, 10))
 }
 
-func testAccContainerCluster_withNodePoolNodeConfig() string {
-	testId := acctest.RandString(10)
+func testAccContainerCluster_withNodePoolNodeConfig(t *testing.T) string {
+	testId := randString(t, 10)
 	return fmt.Sprintf(`
 resource "google_container_cluster" "with_node_pool_node_config" {
-	name = "tf-cluster-nodepool-test-%s"
+	name = "tf-test-cluster-nodepool-%s"
 	zone = "us-central1-a"
 	node_pool {
-		name = "tf-cluster-nodepool-test-%s"
+		name = "tf-test-cluster-nodepool-%s"
 		node_count = 2
 		node_config {
 			machine_type = "n1-standard-1"
@@ -1992,16 +2033,16 @@ resource "google_container_cluster" "with_node_pool_node_config" {
 `, testId, testId)
 }
 
-func testAccContainerCluster_withDefaultNodePoolRemoved() string {
+func testAccContainerCluster_withDefaultNodePoolRemoved(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "with_default_node_pool_removed" {
-	name               = "cluster-test-%s"
+	name               = "tf-test-cluster-%s"
 	zone               = "us-central1-a"
 	initial_node_count = 1
 
 	remove_default_node_pool = true
 }
-`, acctest.RandString(10))
+`, randString(t, 10))
 }
 
 func testAccContainerCluster_withMaintenanceWindow(clusterName string, startTime string) string {
@@ -2017,7 +2058,7 @@ func testAccContainerCluster_withMaintenanceWindow(clusterName string, startTime
 
 	return fmt.Sprintf(`
 resource "google_container_cluster" "with_maintenance_window" {
-	name = "cluster-test-%s"
+	name = "tf-test-cluster-%s"
 	zone = "us-central1-a"
 	initial_node_count = 1
 
@@ -2025,7 +2066,7 @@ resource "google_container_cluster" "with_maintenance_window" {
 }`, clusterName, maintenancePolicy)
 }
 
-func testAccContainerCluster_withIPAllocationPolicy(cluster string, ranges, policy map[string]string) string {
+func testAccContainerCluster_withIPAllocationPolicy(t *testing.T, cluster string, ranges, policy map[string]string) string {
 
 	var secondaryRanges bytes.Buffer
 	for rangeName, cidr := range ranges {
@@ -2044,7 +2085,7 @@ func testAccContainerCluster_withIPAllocationPolicy(cluster string, ranges, poli
 
 	return fmt.Sprintf(`
 resource "google_compute_network" "container_network" {
-	name = "container-net-%s"
+	name = "tf-test-container-net-%s"
 	auto_create_subnetworks = false
 }
 
@@ -2068,13 +2109,13 @@ resource "google_container_cluster" "with_ip_allocation_policy" {
 	ip_allocation_policy {
 	    %s
 	}
-}`, acctest.RandString(10), secondaryRanges.String(), cluster, ipAllocationPolicy.String())
+}`, randString(t, 10), secondaryRanges.String(), cluster, ipAllocationPolicy.String())
 }
 
 func testAccContainerCluster_withPodSecurityPolicy(clusterName string, enabled bool) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "with_pod_security_policy" {
-	name = "cluster-test-%s"
+	name = "tf-test-cluster-%s"
 	zone = "us-central1-a"
 	initial_node_count = 1
 
@@ -2087,7 +2128,7 @@ resource "google_container_cluster" "with_pod_security_policy" {
 func testAccContainerCluster_withPrivateCluster(clusterName string) string {
 	return fmt.Sprintf(`
 resource "google_compute_network" "container_network" {
-	name = "container-net-%s"
+	name = "tf-test-container-net-%s"
 	auto_create_subnetworks = false
 }
 
@@ -2110,7 +2151,7 @@ resource "google_compute_subnetwork" "container_subnetwork" {
 }
 
 resource "google_container_cluster" "with_private_cluster" {
-	name = "cluster-test-%s"
+	name = "tf-test-cluster-%s"
 	zone = "us-central1-a"
 	initial_node_count = 1
 
@@ -2126,7 +2167,7 @@ resource "google_container_cluster" "with_private_cluster" {
 }`, clusterName, clusterName)
 }
 
-func testAccContainerCluster_sharedVpc(org, billingId, projectName, name string) string {
+func testAccContainerCluster_sharedVpc(t *testing.T, org, billingId, projectName, name string) string {
 	return fmt.Sprintf(`
 resource "google_project" "host_project" {
 	name            = "Test Project XPN Host"
@@ -2227,5 +2268,5 @@ resource "google_container_cluster" "shared_vpc_cluster" {
 		"google_compute_subnetwork_iam_member.service_network_cloud_services",
 		"google_compute_subnetwork_iam_member.service_network_gke_user"
 	]
-}`, projectName, org, billingId, projectName, org, billingId, acctest.RandString(10), acctest.RandString(10), name)
+}`, projectName, org, billingId, projectName, org, billingId, randString(t, 10), randString(t, 10), name)
 }
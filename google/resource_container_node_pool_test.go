@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -12,8 +11,8 @@ import (
 func TestAccContainerNodePool_basic(t *testing.T) {
 	t.Parallel()
 
-	cluster := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
-	np := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+	cluster := fmt.Sprintf("tf-test-nodepool-%s", randString(t, 10))
+	np := fmt.Sprintf("tf-test-nodepool-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -35,7 +34,7 @@ func TestAccContainerNodePool_basic(t *testing.T) {
 func TestAccContainerNodePool_namePrefix(t *testing.T) {
 	t.Parallel()
 
-	cluster := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+	cluster := fmt.Sprintf("tf-test-nodepool-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -58,7 +57,7 @@ func TestAccContainerNodePool_namePrefix(t *testing.T) {
 func TestAccContainerNodePool_noName(t *testing.T) {
 	t.Parallel()
 
-	cluster := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+	cluster := fmt.Sprintf("tf-test-nodepool-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -86,7 +85,7 @@ func TestAccContainerNodePool_withNodeConfig(t *testing.T) {
 		CheckDestroy: testAccCheckContainerNodePoolDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccContainerNodePool_withNodeConfig(),
+				Config: testAccContainerNodePool_withNodeConfig(t),
 			},
 			resource.TestStep{
 				ResourceName:      "google_container_node_pool.np_with_node_config",
@@ -109,7 +108,7 @@ func TestAccContainerNodePool_withNodeConfigTaints(t *testing.T) {
 		CheckDestroy: testAccCheckContainerNodePoolDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccContainerNodePool_withNodeConfigTaints(),
+				Config: testAccContainerNodePool_withNodeConfigTaints(t),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("google_container_node_pool.np_with_node_config", "node_config.0.taint.#", "2"),
 				),
@@ -129,7 +128,7 @@ func TestAccContainerNodePool_withWorkloadMetadataConfig(t *testing.T) {
 		CheckDestroy: testAccCheckContainerClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccContainerNodePool_withWorkloadMetadataConfig(),
+				Config: testAccContainerNodePool_withWorkloadMetadataConfig(t),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("google_container_node_pool.with_workload_metadata_config",
 						"node_config.0.workload_metadata_config.0.node_metadata", "SECURE"),
@@ -158,7 +157,7 @@ func TestAccContainerNodePool_withGPU(t *testing.T) {
 		CheckDestroy: testAccCheckContainerNodePoolDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccContainerNodePool_withGPU(),
+				Config: testAccContainerNodePool_withGPU(t),
 			},
 			resource.TestStep{
 				ResourceName:      "google_container_node_pool.np_with_gpu",
@@ -172,8 +171,8 @@ func TestAccContainerNodePool_withGPU(t *testing.T) {
 func TestAccContainerNodePool_withManagement(t *testing.T) {
 	t.Parallel()
 
-	cluster := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
-	nodePool := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+	cluster := fmt.Sprintf("tf-test-nodepool-%s", randString(t, 10))
+	nodePool := fmt.Sprintf("tf-test-nodepool-%s", randString(t, 10))
 	management := `
 	management {
 		auto_repair = "true"
@@ -230,7 +229,7 @@ func TestAccContainerNodePool_withNodeConfigScopeAlias(t *testing.T) {
 		CheckDestroy: testAccCheckContainerNodePoolDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccContainerNodePool_withNodeConfigScopeAlias(),
+				Config: testAccContainerNodePool_withNodeConfigScopeAlias(t),
 			},
 			resource.TestStep{
 				ResourceName:      "google_container_node_pool.np_with_node_config_scope_alias",
@@ -245,8 +244,8 @@ func TestAccContainerNodePool_withNodeConfigScopeAlias(t *testing.T) {
 func TestAccContainerNodePool_regionalAutoscaling(t *testing.T) {
 	t.Parallel()
 
-	cluster := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
-	np := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+	cluster := fmt.Sprintf("tf-test-nodepool-%s", randString(t, 10))
+	np := fmt.Sprintf("tf-test-nodepool-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -299,8 +298,8 @@ func TestAccContainerNodePool_regionalAutoscaling(t *testing.T) {
 func TestAccContainerNodePool_autoscaling(t *testing.T) {
 	t.Parallel()
 
-	cluster := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
-	np := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+	cluster := fmt.Sprintf("tf-test-nodepool-%s", randString(t, 10))
+	np := fmt.Sprintf("tf-test-nodepool-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -353,8 +352,8 @@ func TestAccContainerNodePool_autoscaling(t *testing.T) {
 func TestAccContainerNodePool_resize(t *testing.T) {
 	t.Parallel()
 
-	cluster := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
-	np := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+	cluster := fmt.Sprintf("tf-test-nodepool-%s", randString(t, 10))
+	np := fmt.Sprintf("tf-test-nodepool-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -390,8 +389,8 @@ func TestAccContainerNodePool_resize(t *testing.T) {
 func TestAccContainerNodePool_version(t *testing.T) {
 	t.Parallel()
 
-	cluster := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
-	np := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+	cluster := fmt.Sprintf("tf-test-nodepool-%s", randString(t, 10))
+	np := fmt.Sprintf("tf-test-nodepool-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -463,8 +462,8 @@ func testAccCheckContainerNodePoolDestroy(s *terraform.State) error {
 func TestAccContainerNodePool_regionalClusters(t *testing.T) {
 	t.Parallel()
 
-	cluster := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
-	np := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+	cluster := fmt.Sprintf("tf-test-nodepool-%s", randString(t, 10))
+	np := fmt.Sprintf("tf-test-nodepool-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -672,15 +671,15 @@ resource "google_container_node_pool" "np_with_management" {
 }`, cluster, nodePool, management)
 }
 
-func testAccContainerNodePool_withNodeConfig() string {
+func testAccContainerNodePool_withNodeConfig(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "cluster" {
-	name = "tf-cluster-nodepool-test-%s"
+	name = "tf-test-cluster-nodepool-%s"
 	zone = "us-central1-a"
 	initial_node_count = 1
 }
 resource "google_container_node_pool" "np_with_node_config" {
-	name = "tf-nodepool-test-%s"
+	name = "tf-test-nodepool-%s"
 	zone = "us-central1-a"
 	cluster = "${google_container_cluster.cluster.name}"
 	initial_node_count = 1
@@ -696,18 +695,18 @@ resource "google_container_node_pool" "np_with_node_config" {
 		preemptible = true
 		min_cpu_platform = "Intel Broadwell"
 	}
-}`, acctest.RandString(10), acctest.RandString(10))
+}`, randString(t, 10), randString(t, 10))
 }
 
-func testAccContainerNodePool_withNodeConfigTaints() string {
+func testAccContainerNodePool_withNodeConfigTaints(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "cluster" {
-	name = "tf-cluster-nodepool-test-%s"
+	name = "tf-test-cluster-nodepool-%s"
 	zone = "us-central1-a"
 	initial_node_count = 1
 }
 resource "google_container_node_pool" "np_with_node_config" {
-	name = "tf-nodepool-test-%s"
+	name = "tf-test-nodepool-%s"
 	zone = "us-central1-a"
 	cluster = "${google_container_cluster.cluster.name}"
 	initial_node_count = 1
@@ -723,24 +722,24 @@ resource "google_container_node_pool" "np_with_node_config" {
 			effect = "NO_EXECUTE"
 		}
 	}
-}`, acctest.RandString(10), acctest.RandString(10))
+}`, randString(t, 10), randString(t, 10))
 }
 
-func testAccContainerNodePool_withWorkloadMetadataConfig() string {
+func testAccContainerNodePool_withWorkloadMetadataConfig(t *testing.T) string {
 	return fmt.Sprintf(`
 data "google_container_engine_versions" "central1a" {
   zone = "us-central1-a"
 }
 
 resource "google_container_cluster" "cluster" {
-  name               = "tf-cluster-nodepool-test-%s"
+  name               = "tf-test-cluster-nodepool-%s"
   zone               = "us-central1-a"
   initial_node_count = 1
   min_master_version = "${data.google_container_engine_versions.central1a.latest_master_version}"
 }
 
 resource "google_container_node_pool" "with_workload_metadata_config" {
-  name = "tf-nodepool-test-%s"
+  name = "tf-test-nodepool-%s"
   zone = "us-central1-a"
   cluster = "${google_container_cluster.cluster.name}"
   initial_node_count = 1
@@ -755,20 +754,20 @@ resource "google_container_node_pool" "with_workload_metadata_config" {
     }
   }
 }
-`, acctest.RandString(10), acctest.RandString(10))
+`, randString(t, 10), randString(t, 10))
 }
 
-func testAccContainerNodePool_withGPU() string {
+func testAccContainerNodePool_withGPU(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "cluster" {
-	name = "tf-cluster-nodepool-test-%s"
+	name = "tf-test-cluster-nodepool-%s"
 	zone = "us-central1-c"
 	initial_node_count = 1
   node_version = "1.9.6-gke.1"
   min_master_version = "1.9.6-gke.1"
 }
 resource "google_container_node_pool" "np_with_gpu" {
-	name = "tf-nodepool-test-%s"
+	name = "tf-test-nodepool-%s"
 	zone = "us-central1-c"
 	cluster = "${google_container_cluster.cluster.name}"
 	initial_node_count = 1
@@ -793,18 +792,18 @@ resource "google_container_node_pool" "np_with_gpu" {
 			}
 		]
 	}
-}`, acctest.RandString(10), acctest.RandString(10))
+}`, randString(t, 10), randString(t, 10))
 }
 
-func testAccContainerNodePool_withNodeConfigScopeAlias() string {
+func testAccContainerNodePool_withNodeConfigScopeAlias(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "cluster" {
-	name = "tf-cluster-nodepool-test-%s"
+	name = "tf-test-cluster-nodepool-%s"
 	zone = "us-central1-a"
 	initial_node_count = 1
 }
 resource "google_container_node_pool" "np_with_node_config_scope_alias" {
-	name = "tf-nodepool-test-%s"
+	name = "tf-test-nodepool-%s"
 	zone = "us-central1-a"
 	cluster = "${google_container_cluster.cluster.name}"
 	initial_node_count = 1
@@ -813,7 +812,7 @@ resource "google_container_node_pool" "np_with_node_config_scope_alias" {
 		disk_size_gb = 10
 		oauth_scopes = ["compute-rw", "storage-ro", "logging-write", "monitoring"]
 	}
-}`, acctest.RandString(10), acctest.RandString(10))
+}`, randString(t, 10), randString(t, 10))
 }
 
 func testAccContainerNodePool_version(cluster, np string) string {
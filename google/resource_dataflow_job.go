@@ -28,6 +28,9 @@ func resourceDataflowJob() *schema.Resource {
 		Create: resourceDataflowJobCreate,
 		Read:   resourceDataflowJobRead,
 		Delete: resourceDataflowJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDataflowJobImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -206,6 +209,21 @@ func resourceDataflowJobDelete(d *schema.ResourceData, meta interface{}) error {
 
 }
 
+func resourceDataflowJobImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	fields, err := parseImportIdFields([]string{"projects/(?P<project>[^/]+)/jobs/(?P<job_id>[^/]+)", "(?P<project>[^/]+)/(?P<job_id>[^/]+)", "(?P<job_id>[^/]+)"}, []string{"job_id"}, d, config)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(fields["job_id"])
+
+	// The API doesn't return how the job was launched, only its default
+	// deletion policy can be set.
+	d.Set("on_delete", "drain")
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func mapOnDelete(policy string) (string, error) {
 	switch policy {
 	case "cancel":
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -17,12 +16,18 @@ func TestAccDataflowJobCreate(t *testing.T) {
 		CheckDestroy: testAccCheckDataflowJobDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccDataflowJob,
+				Config: testAccDataflowJob(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccDataflowJobExists(
 						"google_dataflow_job.big_data"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:            "google_dataflow_job.big_data",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_gcs_path", "temp_gcs_location", "zone", "max_workers", "parameters"},
+			},
 		},
 	})
 }
@@ -67,7 +72,8 @@ func testAccDataflowJobExists(n string) resource.TestCheckFunc {
 	}
 }
 
-var testAccDataflowJob = fmt.Sprintf(`
+func testAccDataflowJob(t *testing.T) string {
+	return fmt.Sprintf(`
 resource "google_storage_bucket" "temp" {
 	name = "dfjob-test-%s-temp"
 
@@ -88,4 +94,5 @@ resource "google_dataflow_job" "big_data" {
 	project = "%s"
 
 	on_delete = "cancel"
-}`, acctest.RandString(10), acctest.RandString(10), getTestProjectFromEnv())
+}`, randString(t, 10), randString(t, 10), getTestProjectFromEnv())
+}
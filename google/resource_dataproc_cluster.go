@@ -25,6 +25,11 @@ func resourceDataprocCluster() *schema.Resource {
 		Read:   resourceDataprocClusterRead,
 		Update: resourceDataprocClusterUpdate,
 		Delete: resourceDataprocClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDataprocClusterImport,
+		},
+
+		CustomizeDiff: effectiveLabelsCustomizeDiff(false),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(15 * time.Minute),
@@ -84,6 +89,8 @@ func resourceDataprocCluster() *schema.Resource {
 				Computed: true,
 			},
 
+			"effective_labels": effectiveLabelsSchema(),
+
 			"cluster_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -389,9 +396,7 @@ func resourceDataprocClusterCreate(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
-	if _, ok := d.GetOk("labels"); ok {
-		cluster.Labels = expandLabels(d)
-	}
+	cluster.Labels = expandLabels(d, config)
 
 	// Checking here caters for the case where the user does not specify cluster_config
 	// at all, as well where it is simply missing from the gce_cluster_config
@@ -409,11 +414,10 @@ func resourceDataprocClusterCreate(d *schema.ResourceData, meta interface{}) err
 	d.SetId(cluster.ClusterName)
 
 	// Wait until it's created
-	timeoutInMinutes := int(d.Timeout(schema.TimeoutCreate).Minutes())
-	waitErr := dataprocClusterOperationWait(config, op, "creating Dataproc cluster", timeoutInMinutes, 3)
+	timeout := d.Timeout(schema.TimeoutCreate)
+	waitErr := dataprocClusterOperationWait(config, op, "creating Dataproc cluster", timeout)
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		clearIdUnlessStopped(d, config)
 		return waitErr
 	}
 
@@ -615,7 +619,7 @@ func resourceDataprocClusterUpdate(d *schema.ResourceData, meta interface{}) err
 
 	region := d.Get("region").(string)
 	clusterName := d.Get("name").(string)
-	timeoutInMinutes := int(d.Timeout(schema.TimeoutUpdate).Minutes())
+	timeout := d.Timeout(schema.TimeoutUpdate)
 
 	cluster := &dataproc.Cluster{
 		ClusterName: clusterName,
@@ -625,13 +629,8 @@ func resourceDataprocClusterUpdate(d *schema.ResourceData, meta interface{}) err
 
 	updMask := []string{}
 
-	if d.HasChange("labels") {
-		v := d.Get("labels")
-		m := make(map[string]string)
-		for k, val := range v.(map[string]interface{}) {
-			m[k] = val.(string)
-		}
-		cluster.Labels = m
+	if hasLabelsChange(d) {
+		cluster.Labels = expandLabels(d, config)
 
 		updMask = append(updMask, "labels")
 	}
@@ -663,7 +662,7 @@ func resourceDataprocClusterUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 
 		// Wait until it's updated
-		waitErr := dataprocClusterOperationWait(config, op, "updating Dataproc cluster ", timeoutInMinutes, 2)
+		waitErr := dataprocClusterOperationWait(config, op, "updating Dataproc cluster ", timeout)
 		if waitErr != nil {
 			return waitErr
 		}
@@ -694,7 +693,9 @@ func resourceDataprocClusterRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("name", cluster.ClusterName)
 	d.Set("project", project)
 	d.Set("region", region)
-	d.Set("labels", cluster.Labels)
+	if err := setLabels(d, config, cluster.Labels); err != nil {
+		return fmt.Errorf("Error setting labels: %s", err)
+	}
 
 	cfg, err := flattenClusterConfig(d, cluster.Config)
 	if err != nil {
@@ -708,6 +709,24 @@ func resourceDataprocClusterRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+func resourceDataprocClusterImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	// Clusters default to the global region rather than the provider's.
+	d.Set("region", "global")
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/clusters/(?P<name>[^/]+)", "(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func flattenClusterConfig(d *schema.ResourceData, cfg *dataproc.ClusterConfig) ([]map[string]interface{}, error) {
 
 	data := map[string]interface{}{
@@ -838,7 +857,7 @@ func resourceDataprocClusterDelete(d *schema.ResourceData, meta interface{}) err
 
 	region := d.Get("region").(string)
 	clusterName := d.Get("name").(string)
-	timeoutInMinutes := int(d.Timeout(schema.TimeoutDelete).Minutes())
+	timeout := d.Timeout(schema.TimeoutDelete)
 
 	log.Printf("[DEBUG] Deleting Dataproc cluster %s", clusterName)
 	op, err := config.clientDataproc.Projects.Regions.Clusters.Delete(
@@ -848,7 +867,7 @@ func resourceDataprocClusterDelete(d *schema.ResourceData, meta interface{}) err
 	}
 
 	// Wait until it's deleted
-	waitErr := dataprocClusterOperationWait(config, op, "deleting Dataproc cluster", timeoutInMinutes, 3)
+	waitErr := dataprocClusterOperationWait(config, op, "deleting Dataproc cluster", timeout)
 	if waitErr != nil {
 		return waitErr
 	}
@@ -964,7 +983,7 @@ func deleteStorageBucketContents(config *Config, bucket string) error {
 			Timeout:                   5 * time.Minute,
 			ContinuousTargetOccurence: 3,
 		}
-		_, err := conf.WaitForState()
+		_, err := waitForStateContext(config.stopContext(), conf)
 		if err != nil {
 			return errwrap.Wrapf(fmt.Sprintf("Error waiting for all items to be deleted from bucket %q: {{err}}", bucket), err)
 		}
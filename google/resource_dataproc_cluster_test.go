@@ -1,14 +1,16 @@
 package google
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"net/http"
 	"reflect"
 	"regexp"
 	"strconv"
 	"testing"
+	"time"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 
@@ -16,6 +18,39 @@ import (
 	"google.golang.org/api/googleapi"
 )
 
+func init() {
+	addTestSweeper("gcp_dataproc_cluster", testSweepDataprocClusters)
+}
+
+func testSweepDataprocClusters(region string) error {
+	config, err := sweeperConfig(region)
+	if err != nil {
+		return err
+	}
+
+	err = config.clientDataproc.Projects.Regions.Clusters.List(config.Project, region).Pages(context.Background(), func(res *dataproc.ListClustersResponse) error {
+		for _, cluster := range res.Clusters {
+			if !isSweepableTestResource(cluster.ClusterName) {
+				continue
+			}
+			log.Printf("[INFO] Sweeping Dataproc cluster %s", cluster.ClusterName)
+			op, err := config.clientDataproc.Projects.Regions.Clusters.Delete(config.Project, region, cluster.ClusterName).Do()
+			if err != nil {
+				log.Printf("[INFO] Error deleting Dataproc cluster %s: %s", cluster.ClusterName, err)
+				continue
+			}
+			if err := dataprocClusterOperationWait(config, op, "Sweeping Dataproc cluster", 20*time.Minute); err != nil {
+				log.Printf("[INFO] Error deleting Dataproc cluster %s: %s", cluster.ClusterName, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error listing Dataproc clusters: %s", err)
+	}
+	return nil
+}
+
 func TestExtractInitTimeout(t *testing.T) {
 	t.Parallel()
 
@@ -56,7 +91,7 @@ func TestExtractInitTimeout_empty(t *testing.T) {
 func TestAccDataprocCluster_missingZoneGlobalRegion1(t *testing.T) {
 	t.Parallel()
 
-	rnd := acctest.RandString(10)
+	rnd := randString(t, 10)
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
@@ -72,7 +107,7 @@ func TestAccDataprocCluster_missingZoneGlobalRegion1(t *testing.T) {
 func TestAccDataprocCluster_missingZoneGlobalRegion2(t *testing.T) {
 	t.Parallel()
 
-	rnd := acctest.RandString(10)
+	rnd := randString(t, 10)
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
@@ -89,7 +124,7 @@ func TestAccDataprocCluster_basic(t *testing.T) {
 	t.Parallel()
 
 	var cluster dataproc.Cluster
-	rnd := acctest.RandString(10)
+	rnd := randString(t, 10)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -128,6 +163,12 @@ func TestAccDataprocCluster_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("google_dataproc_cluster.basic", "cluster_config.0.preemptible_worker_config.0.instance_names.#", "0"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:            "google_dataproc_cluster.basic",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cluster_config.0.delete_autogen_bucket"},
+			},
 		},
 	})
 }
@@ -136,7 +177,7 @@ func TestAccDataprocCluster_basicWithInternalIpOnlyTrue(t *testing.T) {
 	t.Parallel()
 
 	var cluster dataproc.Cluster
-	rnd := acctest.RandString(10)
+	rnd := randString(t, 10)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -159,7 +200,7 @@ func TestAccDataprocCluster_basicWithMetadata(t *testing.T) {
 	t.Parallel()
 
 	var cluster dataproc.Cluster
-	rnd := acctest.RandString(10)
+	rnd := randString(t, 10)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -181,7 +222,7 @@ func TestAccDataprocCluster_basicWithMetadata(t *testing.T) {
 func TestAccDataprocCluster_singleNodeCluster(t *testing.T) {
 	t.Parallel()
 
-	rnd := acctest.RandString(10)
+	rnd := randString(t, 10)
 	var cluster dataproc.Cluster
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -207,7 +248,7 @@ func TestAccDataprocCluster_singleNodeCluster(t *testing.T) {
 func TestAccDataprocCluster_updatable(t *testing.T) {
 	t.Parallel()
 
-	rnd := acctest.RandString(10)
+	rnd := randString(t, 10)
 	var cluster dataproc.Cluster
 
 	resource.Test(t, resource.TestCase{
@@ -237,9 +278,9 @@ func TestAccDataprocCluster_updatable(t *testing.T) {
 func TestAccDataprocCluster_withStagingBucket(t *testing.T) {
 	t.Parallel()
 
-	rnd := acctest.RandString(10)
+	rnd := randString(t, 10)
 	var cluster dataproc.Cluster
-	clusterName := fmt.Sprintf("dproc-cluster-test-%s", rnd)
+	clusterName := fmt.Sprintf("tf-test-dproc-cluster-%s", rnd)
 	bucketName := fmt.Sprintf("%s-bucket", clusterName)
 
 	resource.Test(t, resource.TestCase{
@@ -269,9 +310,9 @@ func TestAccDataprocCluster_withStagingBucket(t *testing.T) {
 func TestAccDataprocCluster_withInitAction(t *testing.T) {
 	t.Parallel()
 
-	rnd := acctest.RandString(10)
+	rnd := randString(t, 10)
 	var cluster dataproc.Cluster
-	bucketName := fmt.Sprintf("dproc-cluster-test-%s-init-bucket", rnd)
+	bucketName := fmt.Sprintf("tf-test-dproc-cluster-%s-init-bucket", rnd)
 	objectName := "msg.txt"
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -294,7 +335,7 @@ func TestAccDataprocCluster_withInitAction(t *testing.T) {
 func TestAccDataprocCluster_withConfigOverrides(t *testing.T) {
 	t.Parallel()
 
-	rnd := acctest.RandString(10)
+	rnd := randString(t, 10)
 	var cluster dataproc.Cluster
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -315,9 +356,9 @@ func TestAccDataprocCluster_withConfigOverrides(t *testing.T) {
 func TestAccDataprocCluster_withServiceAcc(t *testing.T) {
 	t.Parallel()
 
-	sa := "a" + acctest.RandString(10)
+	sa := "tf-test-" + randString(t, 10)
 	saEmail := fmt.Sprintf("%s@%s.iam.gserviceaccount.com", sa, getTestProjectFromEnv())
-	rnd := acctest.RandString(10)
+	rnd := randString(t, 10)
 
 	var cluster dataproc.Cluster
 
@@ -347,7 +388,7 @@ func TestAccDataprocCluster_withServiceAcc(t *testing.T) {
 func TestAccDataprocCluster_withImageVersion(t *testing.T) {
 	t.Parallel()
 
-	rnd := acctest.RandString(10)
+	rnd := randString(t, 10)
 	var cluster dataproc.Cluster
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -368,7 +409,7 @@ func TestAccDataprocCluster_withImageVersion(t *testing.T) {
 func TestAccDataprocCluster_withLabels(t *testing.T) {
 	t.Parallel()
 
-	rnd := acctest.RandString(10)
+	rnd := randString(t, 10)
 	var cluster dataproc.Cluster
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -399,8 +440,8 @@ func TestAccDataprocCluster_withNetworkRefs(t *testing.T) {
 	t.Parallel()
 
 	var c1, c2 dataproc.Cluster
-	rnd := acctest.RandString(10)
-	netName := fmt.Sprintf(`dproc-cluster-test-%s-net`, rnd)
+	rnd := randString(t, 10)
+	netName := fmt.Sprintf(`tf-test-dproc-cluster-%s-net`, rnd)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -598,7 +639,7 @@ func testAccCheckDataprocClusterExists(n string, cluster *dataproc.Cluster) reso
 func testAccCheckDataproc_missingZoneGlobalRegion1(rnd string) string {
 	return fmt.Sprintf(`
 resource "google_dataproc_cluster" "basic" {
-	name                  = "dproc-cluster-test-%s"
+	name                  = "tf-test-dproc-cluster-%s"
 	region                = "global"
 }
 `, rnd)
@@ -607,7 +648,7 @@ resource "google_dataproc_cluster" "basic" {
 func testAccCheckDataproc_missingZoneGlobalRegion2(rnd string) string {
 	return fmt.Sprintf(`
 resource "google_dataproc_cluster" "basic" {
-	name                  = "dproc-cluster-test-%s"
+	name                  = "tf-test-dproc-cluster-%s"
 	region                = "global"
 
 	cluster_config {
@@ -620,7 +661,7 @@ resource "google_dataproc_cluster" "basic" {
 func testAccDataprocCluster_basic(rnd string) string {
 	return fmt.Sprintf(`
 resource "google_dataproc_cluster" "basic" {
-	name                  = "dproc-cluster-test-%s"
+	name                  = "tf-test-dproc-cluster-%s"
 	region                = "us-central1"
 }
 `, rnd)
@@ -678,7 +719,7 @@ resource "google_compute_firewall" "dataproc_network_firewall" {
 	source_ranges = ["${var.subnetwork_cidr}"]
 }
 resource "google_dataproc_cluster" "basic" {
-	name                  = "dproc-cluster-test-%s"
+	name                  = "tf-test-dproc-cluster-%s"
 	region                = "us-central1"
 	depends_on            = ["google_compute_firewall.dataproc_network_firewall"]
 	
@@ -695,7 +736,7 @@ resource "google_dataproc_cluster" "basic" {
 func testAccDataprocCluster_basicWithMetadata(rnd string) string {
 	return fmt.Sprintf(`
 resource "google_dataproc_cluster" "basic" {
-	name   = "dproc-cluster-test-%s"
+	name   = "tf-test-dproc-cluster-%s"
 	region = "us-central1"
 
 	cluster_config {
@@ -713,7 +754,7 @@ resource "google_dataproc_cluster" "basic" {
 func testAccDataprocCluster_singleNodeCluster(rnd string) string {
 	return fmt.Sprintf(`
 resource "google_dataproc_cluster" "single_node_cluster" {
-	name   = "dproc-cluster-test-%s"
+	name   = "tf-test-dproc-cluster-%s"
 	region = "us-central1"
 
 	cluster_config {
@@ -731,7 +772,7 @@ resource "google_dataproc_cluster" "single_node_cluster" {
 func testAccDataprocCluster_withConfigOverrides(rnd string) string {
 	return fmt.Sprintf(`
 resource "google_dataproc_cluster" "with_config_overrides" {
-	name   = "dproc-cluster-test-%s"
+	name   = "tf-test-dproc-cluster-%s"
 	region = "us-central1"
 
 	cluster_config {
@@ -771,7 +812,7 @@ resource "google_storage_bucket" "init_bucket" {
 }
 
 resource "google_storage_bucket_object" "init_script" {
-	name           = "dproc-cluster-test-%s-init-script.sh"
+	name           = "tf-test-dproc-cluster-%s-init-script.sh"
 	bucket         = "${google_storage_bucket.init_bucket.name}"
 	content        = <<EOL
 #!/bin/bash
@@ -782,7 +823,7 @@ EOL
 }
 
 resource "google_dataproc_cluster" "with_init_action" {
-	name   = "dproc-cluster-test-%s"
+	name   = "tf-test-dproc-cluster-%s"
 	region = "us-central1"
 
 	cluster_config {
@@ -814,7 +855,7 @@ resource "google_dataproc_cluster" "with_init_action" {
 func testAccDataprocCluster_updatable(rnd string, w, p int) string {
 	return fmt.Sprintf(`
 resource "google_dataproc_cluster" "updatable" {
-	name   = "dproc-cluster-test-%s"
+	name   = "tf-test-dproc-cluster-%s"
 	region = "us-central1"
 
 	cluster_config {
@@ -885,7 +926,7 @@ resource "google_dataproc_cluster" "with_bucket" {
 func testAccDataprocCluster_withLabels(rnd string) string {
 	return fmt.Sprintf(`
 resource "google_dataproc_cluster" "with_labels" {
-	name   = "dproc-cluster-test-%s"
+	name   = "tf-test-dproc-cluster-%s"
 	region = "us-central1"
 
 	labels {
@@ -904,7 +945,7 @@ resource "google_dataproc_cluster" "with_labels" {
 func testAccDataprocCluster_withImageVersion(rnd string) string {
 	return fmt.Sprintf(`
 resource "google_dataproc_cluster" "with_image_version" {
-	name   = "dproc-cluster-test-%s"
+	name   = "tf-test-dproc-cluster-%s"
 	region = "us-central1"
 
 	cluster_config {
@@ -927,7 +968,7 @@ resource "google_project_iam_member" "service_account" {
 }
 
 resource "google_dataproc_cluster" "with_service_account" {
-	name   = "dproc-cluster-test-%s"
+	name   = "tf-test-dproc-cluster-%s"
 	region = "us-central1"
 
 	cluster_config {
@@ -981,7 +1022,7 @@ resource "google_compute_network" "dataproc_network" {
 # internally as part of their configuration or this will just hang.
 #
 resource "google_compute_firewall" "dataproc_network_firewall" {
-	name = "dproc-cluster-test-%s-allow-internal"
+	name = "tf-test-dproc-cluster-%s-allow-internal"
 	description = "Firewall rules for dataproc Terraform acceptance testing"
 	network = "${google_compute_network.dataproc_network.name}"
 
@@ -1001,7 +1042,7 @@ resource "google_compute_firewall" "dataproc_network_firewall" {
 }
 
 resource "google_dataproc_cluster" "with_net_ref_by_name" {
-	name   = "dproc-cluster-test-%s-name"
+	name   = "tf-test-dproc-cluster-%s-name"
 	region = "us-central1"
 	depends_on = ["google_compute_firewall.dataproc_network_firewall"]
 
@@ -1027,7 +1068,7 @@ resource "google_dataproc_cluster" "with_net_ref_by_name" {
 }
 
 resource "google_dataproc_cluster" "with_net_ref_by_url" {
-	name   = "dproc-cluster-test-%s-url"
+	name   = "tf-test-dproc-cluster-%s-url"
 	region = "us-central1"
 	depends_on = ["google_compute_firewall.dataproc_network_firewall"]
 
@@ -16,12 +16,17 @@ func resourceDataprocJob() *schema.Resource {
 		Update: resourceDataprocJobUpdate,
 		Read:   resourceDataprocJobRead,
 		Delete: resourceDataprocJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDataprocJobImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: effectiveLabelsCustomizeDiff(true),
+
 		Schema: map[string]*schema.Schema{
 			"project": {
 				Type:     schema.TypeString,
@@ -137,6 +142,8 @@ func resourceDataprocJob() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 
+			"effective_labels": effectiveLabelsSchema(),
+
 			"scheduling": {
 				Type:        schema.TypeList,
 				Description: "Optional. Job scheduling configuration.",
@@ -199,9 +206,7 @@ func resourceDataprocJobCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("reference.0.job_id"); ok {
 		submitReq.Job.Reference.JobId = v.(string)
 	}
-	if _, ok := d.GetOk("labels"); ok {
-		submitReq.Job.Labels = expandLabels(d)
-	}
+	submitReq.Job.Labels = expandLabels(d, config)
 
 	if v, ok := d.GetOk("pyspark_config"); ok {
 		jobConfCount++
@@ -271,7 +276,9 @@ func resourceDataprocJobRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("force_delete", d.Get("force_delete"))
-	d.Set("labels", job.Labels)
+	if err := setLabels(d, config, job.Labels); err != nil {
+		return fmt.Errorf("Error setting labels: %s", err)
+	}
 	d.Set("driver_output_resource_uri", job.DriverOutputResourceUri)
 	d.Set("driver_controls_files_uri", job.DriverControlFilesUri)
 
@@ -301,6 +308,19 @@ func resourceDataprocJobRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceDataprocJobImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	// Jobs default to the global region rather than the provider's.
+	d.Set("region", "global")
+	fields, err := parseImportIdFields([]string{"projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/jobs/(?P<job_id>[^/]+)", "(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<job_id>[^/]+)", "(?P<job_id>[^/]+)"}, []string{"job_id"}, d, config)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(fields["job_id"])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceDataprocJobDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -311,7 +331,7 @@ func resourceDataprocJobDelete(d *schema.ResourceData, meta interface{}) error {
 
 	region := d.Get("region").(string)
 	forceDelete := d.Get("force_delete").(bool)
-	timeoutInMinutes := int(d.Timeout(schema.TimeoutDelete).Minutes())
+	timeout := d.Timeout(schema.TimeoutDelete)
 
 	if forceDelete {
 		log.Printf("[DEBUG] Attempting to first cancel Dataproc job %s if it's still running ...", d.Id())
@@ -322,7 +342,7 @@ func resourceDataprocJobDelete(d *schema.ResourceData, meta interface{}) error {
 		// be cancelled. We do however wait for the state to be one that is
 		// at least not active
 		waitErr := dataprocJobOperationWait(config, region, project, d.Id(),
-			"Cancelling Dataproc job", timeoutInMinutes, 1)
+			"Cancelling Dataproc job", timeout, 1)
 		if waitErr != nil {
 			return waitErr
 		}
@@ -337,7 +357,7 @@ func resourceDataprocJobDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	waitErr := dataprocDeleteOperationWait(config, region, project, d.Id(),
-		"Deleting Dataproc job", timeoutInMinutes, 1)
+		"Deleting Dataproc job", timeout, 1)
 	if waitErr != nil {
 		return waitErr
 	}
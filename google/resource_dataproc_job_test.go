@@ -3,10 +3,10 @@ package google
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"regexp"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/dataproc/v1"
@@ -38,7 +38,7 @@ func TestAccDataprocJob_updatable(t *testing.T) {
 	t.Parallel()
 
 	var job dataproc.Job
-	rnd := acctest.RandString(10)
+	rnd := randString(t, 10)
 	jobId := fmt.Sprintf("dproc-update-job-id-%s", rnd)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -67,7 +67,7 @@ func TestAccDataprocJob_PySpark(t *testing.T) {
 	t.Parallel()
 
 	var job dataproc.Job
-	rnd := acctest.RandString(10)
+	rnd := randString(t, 10)
 	jobId := fmt.Sprintf("dproc-custom-job-id-%s", rnd)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -97,6 +97,12 @@ func TestAccDataprocJob_PySpark(t *testing.T) {
 					testAccCheckDataprocJobCompletesSuccessfully("google_dataproc_job.pyspark", &job),
 				),
 			},
+			resource.TestStep{
+				ResourceName:            "google_dataproc_job.pyspark",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete"},
+			},
 		},
 	})
 }
@@ -105,7 +111,7 @@ func TestAccDataprocJob_Spark(t *testing.T) {
 	t.Parallel()
 
 	var job dataproc.Job
-	rnd := acctest.RandString(10)
+	rnd := randString(t, 10)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -137,7 +143,7 @@ func TestAccDataprocJob_Hadoop(t *testing.T) {
 	t.Parallel()
 
 	var job dataproc.Job
-	rnd := acctest.RandString(10)
+	rnd := randString(t, 10)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -169,7 +175,7 @@ func TestAccDataprocJob_Hive(t *testing.T) {
 	t.Parallel()
 
 	var job dataproc.Job
-	rnd := acctest.RandString(10)
+	rnd := randString(t, 10)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -201,7 +207,7 @@ func TestAccDataprocJob_Pig(t *testing.T) {
 	t.Parallel()
 
 	var job dataproc.Job
-	rnd := acctest.RandString(10)
+	rnd := randString(t, 10)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -233,7 +239,7 @@ func TestAccDataprocJob_SparkSql(t *testing.T) {
 	t.Parallel()
 
 	var job dataproc.Job
-	rnd := acctest.RandString(10)
+	rnd := randString(t, 10)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -306,9 +312,9 @@ func testAccCheckDataprocJobCompletesSuccessfully(n string, job *dataproc.Job) r
 			return err
 		}
 
-		jobCompleteTimeoutMins := 5
+		jobCompleteTimeout := 5 * time.Minute
 		waitErr := dataprocJobOperationWait(config, region, project, job.Reference.JobId,
-			"Awaiting Dataproc job completion", jobCompleteTimeoutMins, 1)
+			"Awaiting Dataproc job completion", jobCompleteTimeout, 1)
 		if waitErr != nil {
 			return waitErr
 		}
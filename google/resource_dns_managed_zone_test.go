@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/dns/v1"
@@ -21,7 +20,7 @@ func TestAccDnsManagedZone_basic(t *testing.T) {
 		CheckDestroy: testAccCheckDnsManagedZoneDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccDnsManagedZone_basic(),
+				Config: testAccDnsManagedZone_basic(t),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDnsManagedZoneExists(
 						"google_dns_managed_zone.foobar", &zone),
@@ -78,10 +77,10 @@ func testAccCheckDnsManagedZoneExists(n string, zone *dns.ManagedZone) resource.
 	}
 }
 
-func testAccDnsManagedZone_basic() string {
+func testAccDnsManagedZone_basic(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_dns_managed_zone" "foobar" {
 	name = "mzone-test-%s"
 	dns_name = "tf-acctest-%s.hashicorptest.com."
-}`, acctest.RandString(10), acctest.RandString(10))
+}`, randString(t, 10), randString(t, 10))
 }
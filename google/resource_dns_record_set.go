@@ -136,7 +136,7 @@ func resourceDnsRecordSetCreate(d *schema.ResourceData, meta interface{}) error
 		Project:     project,
 		ManagedZone: zone,
 	}
-	_, err = w.Conf().WaitForState()
+	_, err = waitForStateContext(config.stopContext(), w.Conf())
 	if err != nil {
 		return fmt.Errorf("Error waiting for Google DNS change: %s", err)
 	}
@@ -235,7 +235,7 @@ func resourceDnsRecordSetDelete(d *schema.ResourceData, meta interface{}) error
 		Project:     project,
 		ManagedZone: zone,
 	}
-	_, err = w.Conf().WaitForState()
+	_, err = waitForStateContext(config.stopContext(), w.Conf())
 	if err != nil {
 		return fmt.Errorf("Error waiting for Google DNS change: %s", err)
 	}
@@ -297,7 +297,7 @@ func resourceDnsRecordSetUpdate(d *schema.ResourceData, meta interface{}) error
 		Project:     project,
 		ManagedZone: zone,
 	}
-	if _, err = w.Conf().WaitForState(); err != nil {
+	if _, err = waitForStateContext(config.stopContext(), w.Conf()); err != nil {
 		return fmt.Errorf("Error waiting for Google DNS change: %s", err)
 	}
 
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -37,7 +36,7 @@ func TestIpv6AddressDiffSuppress(t *testing.T) {
 func TestAccDnsRecordSet_basic(t *testing.T) {
 	t.Parallel()
 
-	zoneName := fmt.Sprintf("dnszone-test-%s", acctest.RandString(10))
+	zoneName := fmt.Sprintf("dnszone-test-%s", randString(t, 10))
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -63,7 +62,7 @@ func TestAccDnsRecordSet_basic(t *testing.T) {
 func TestAccDnsRecordSet_modify(t *testing.T) {
 	t.Parallel()
 
-	zoneName := fmt.Sprintf("dnszone-test-%s", acctest.RandString(10))
+	zoneName := fmt.Sprintf("dnszone-test-%s", randString(t, 10))
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -97,7 +96,7 @@ func TestAccDnsRecordSet_modify(t *testing.T) {
 func TestAccDnsRecordSet_changeType(t *testing.T) {
 	t.Parallel()
 
-	zoneName := fmt.Sprintf("dnszone-test-%s", acctest.RandString(10))
+	zoneName := fmt.Sprintf("dnszone-test-%s", randString(t, 10))
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -124,7 +123,7 @@ func TestAccDnsRecordSet_changeType(t *testing.T) {
 func TestAccDnsRecordSet_ns(t *testing.T) {
 	t.Parallel()
 
-	zoneName := fmt.Sprintf("dnszone-test-ns-%s", acctest.RandString(10))
+	zoneName := fmt.Sprintf("dnszone-test-ns-%s", randString(t, 10))
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -150,7 +149,7 @@ func TestAccDnsRecordSet_ns(t *testing.T) {
 func TestAccDnsRecordSet_nestedNS(t *testing.T) {
 	t.Parallel()
 
-	zoneName := fmt.Sprintf("dnszone-test-ns-%s", acctest.RandString(10))
+	zoneName := fmt.Sprintf("dnszone-test-ns-%s", randString(t, 10))
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -170,7 +169,7 @@ func TestAccDnsRecordSet_nestedNS(t *testing.T) {
 func TestAccDnsRecordSet_quotedTXT(t *testing.T) {
 	t.Parallel()
 
-	zoneName := fmt.Sprintf("dnszone-test-txt-%s", acctest.RandString(10))
+	zoneName := fmt.Sprintf("dnszone-test-txt-%s", randString(t, 10))
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -190,7 +189,7 @@ func TestAccDnsRecordSet_quotedTXT(t *testing.T) {
 func TestAccDnsRecordSet_uppercaseMX(t *testing.T) {
 	t.Parallel()
 
-	zoneName := fmt.Sprintf("dnszone-test-txt-%s", acctest.RandString(10))
+	zoneName := fmt.Sprintf("dnszone-test-txt-%s", randString(t, 10))
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -4,6 +4,8 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"fmt"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	"google.golang.org/api/servicemanagement/v1"
 )
@@ -14,6 +16,9 @@ func resourceEndpointsService() *schema.Resource {
 		Read:   resourceEndpointsServiceRead,
 		Delete: resourceEndpointsServiceDelete,
 		Update: resourceEndpointsServiceUpdate,
+		Importer: &schema.ResourceImporter{
+			State: resourceEndpointsServiceImport,
+		},
 
 		// Migrates protoc_output -> protoc_output_base64.
 		SchemaVersion: 1,
@@ -283,6 +288,29 @@ func resourceEndpointsServiceRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+func resourceEndpointsServiceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"services/(?P<service_name>[^/]+)", "(?P<service_name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{service_name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	// The service's project isn't part of its name.
+	service, err := config.clientServiceMan.Services.Get(d.Id()).Do()
+	if err != nil {
+		return nil, fmt.Errorf("Error reading service %q: %s", d.Id(), err)
+	}
+	d.Set("project", service.ProducerProjectId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func flattenServiceManagementAPIs(apis []*servicemanagement.Api) []map[string]interface{} {
 	flattened := make([]map[string]interface{}, len(apis))
 	for i, a := range apis {
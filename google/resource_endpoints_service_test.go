@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"fmt"
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/servicemanagement/v1"
@@ -13,7 +12,7 @@ import (
 
 func TestAccEndpointsService_basic(t *testing.T) {
 	t.Parallel()
-	random_name := "t-" + acctest.RandString(10)
+	random_name := "t-" + randString(t, 10)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -23,13 +22,19 @@ func TestAccEndpointsService_basic(t *testing.T) {
 				Config: testAccEndpointsService_basic(random_name),
 				Check:  testAccCheckEndpointExistsByName(random_name),
 			},
+			resource.TestStep{
+				ResourceName:            "google_endpoints_service.endpoints_service",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"openapi_config"},
+			},
 		},
 	})
 }
 
 func TestAccEndpointsService_grpc(t *testing.T) {
 	t.Parallel()
-	random_name := "t-" + acctest.RandString(10)
+	random_name := "t-" + randString(t, 10)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
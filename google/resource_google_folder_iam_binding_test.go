@@ -5,10 +5,8 @@ import (
 	"sort"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
-	"google.golang.org/api/cloudresourcemanager/v1"
 )
 
 // Test that an IAM binding can be applied to a folder
@@ -16,7 +14,7 @@ func TestAccFolderIamBinding_basic(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	fname := "terraform-" + acctest.RandString(10)
+	fname := "terraform-" + randString(t, 10)
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
@@ -32,7 +30,7 @@ func TestAccFolderIamBinding_basic(t *testing.T) {
 			{
 				Config: testAccFolderAssociateBindingBasic(org, fname),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGoogleFolderIamBindingExists("google_folder_iam_binding.acceptance", &cloudresourcemanager.Binding{
+					testAccCheckGoogleFolderIamBindingExists("google_folder_iam_binding.acceptance", &IamBinding{
 						Role:    "roles/compute.instanceAdmin",
 						Members: []string{"user:admin@hashicorptest.com"},
 					}, org, fname),
@@ -47,7 +45,7 @@ func TestAccFolderIamBinding_multiple(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	fname := "terraform-" + acctest.RandString(10)
+	fname := "terraform-" + randString(t, 10)
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
@@ -63,7 +61,7 @@ func TestAccFolderIamBinding_multiple(t *testing.T) {
 			{
 				Config: testAccFolderAssociateBindingBasic(org, fname),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGoogleFolderIamBindingExists("google_folder_iam_binding.acceptance", &cloudresourcemanager.Binding{
+					testAccCheckGoogleFolderIamBindingExists("google_folder_iam_binding.acceptance", &IamBinding{
 						Role:    "roles/compute.instanceAdmin",
 						Members: []string{"user:admin@hashicorptest.com"},
 					}, org, fname),
@@ -73,11 +71,11 @@ func TestAccFolderIamBinding_multiple(t *testing.T) {
 			{
 				Config: testAccFolderAssociateBindingMultiple(org, fname),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGoogleFolderIamBindingExists("google_folder_iam_binding.multiple", &cloudresourcemanager.Binding{
+					testAccCheckGoogleFolderIamBindingExists("google_folder_iam_binding.multiple", &IamBinding{
 						Role:    "roles/viewer",
 						Members: []string{"user:paddy@hashicorp.com"},
 					}, org, fname),
-					testAccCheckGoogleFolderIamBindingExists("google_folder_iam_binding.multiple", &cloudresourcemanager.Binding{
+					testAccCheckGoogleFolderIamBindingExists("google_folder_iam_binding.multiple", &IamBinding{
 						Role:    "roles/compute.instanceAdmin",
 						Members: []string{"user:admin@hashicorptest.com"},
 					}, org, fname),
@@ -92,7 +90,7 @@ func TestAccFolderIamBinding_multipleAtOnce(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	fname := "terraform-" + acctest.RandString(10)
+	fname := "terraform-" + randString(t, 10)
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
@@ -108,11 +106,11 @@ func TestAccFolderIamBinding_multipleAtOnce(t *testing.T) {
 			{
 				Config: testAccFolderAssociateBindingMultiple(org, fname),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGoogleFolderIamBindingExists("google_folder_iam_binding.acceptance", &cloudresourcemanager.Binding{
+					testAccCheckGoogleFolderIamBindingExists("google_folder_iam_binding.acceptance", &IamBinding{
 						Role:    "roles/compute.instanceAdmin",
 						Members: []string{"user:admin@hashicorptest.com"},
 					}, org, fname),
-					testAccCheckGoogleFolderIamBindingExists("google_folder_iam_binding.multiple", &cloudresourcemanager.Binding{
+					testAccCheckGoogleFolderIamBindingExists("google_folder_iam_binding.multiple", &IamBinding{
 						Role:    "roles/compute.instanceAdmin",
 						Members: []string{"user:admin@hashicorptest.com"},
 					}, org, fname),
@@ -127,7 +125,7 @@ func TestAccFolderIamBinding_update(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	fname := "terraform-" + acctest.RandString(10)
+	fname := "terraform-" + randString(t, 10)
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
@@ -143,7 +141,7 @@ func TestAccFolderIamBinding_update(t *testing.T) {
 			{
 				Config: testAccFolderAssociateBindingBasic(org, fname),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGoogleFolderIamBindingExists("google_folder_iam_binding.acceptance", &cloudresourcemanager.Binding{
+					testAccCheckGoogleFolderIamBindingExists("google_folder_iam_binding.acceptance", &IamBinding{
 						Role:    "roles/compute.instanceAdmin",
 						Members: []string{"user:admin@hashicorptest.com"},
 					}, org, fname),
@@ -153,7 +151,7 @@ func TestAccFolderIamBinding_update(t *testing.T) {
 			{
 				Config: testAccFolderAssociateBindingUpdated(org, fname),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGoogleFolderIamBindingExists("google_folder_iam_binding.updated", &cloudresourcemanager.Binding{
+					testAccCheckGoogleFolderIamBindingExists("google_folder_iam_binding.updated", &IamBinding{
 						Role:    "roles/compute.instanceAdmin",
 						Members: []string{"user:admin@hashicorptest.com", "user:paddy@hashicorp.com"},
 					}, org, fname),
@@ -163,7 +161,7 @@ func TestAccFolderIamBinding_update(t *testing.T) {
 			{
 				Config: testAccFolderAssociateBindingDropMemberFromBasic(org, fname),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGoogleFolderIamBindingExists("google_folder_iam_binding.dropped", &cloudresourcemanager.Binding{
+					testAccCheckGoogleFolderIamBindingExists("google_folder_iam_binding.dropped", &IamBinding{
 						Role:    "roles/compute.instanceAdmin",
 						Members: []string{"user:paddy@hashicorp.com"},
 					}, org, fname),
@@ -178,7 +176,7 @@ func TestAccFolderIamBinding_remove(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	fname := "terraform-" + acctest.RandString(10)
+	fname := "terraform-" + randString(t, 10)
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
@@ -194,11 +192,11 @@ func TestAccFolderIamBinding_remove(t *testing.T) {
 			{
 				Config: testAccFolderAssociateBindingMultiple(org, fname),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGoogleFolderIamBindingExists("google_folder_iam_binding.multiple", &cloudresourcemanager.Binding{
+					testAccCheckGoogleFolderIamBindingExists("google_folder_iam_binding.multiple", &IamBinding{
 						Role:    "roles/viewer",
 						Members: []string{"user:paddy@hashicorp.com"},
 					}, org, fname),
-					testAccCheckGoogleFolderIamBindingExists("google_folder_iam_binding.acceptance", &cloudresourcemanager.Binding{
+					testAccCheckGoogleFolderIamBindingExists("google_folder_iam_binding.acceptance", &IamBinding{
 						Role:    "roles/compute.instanceAdmin",
 						Members: []string{"user:admin@hashicorptest.com"},
 					}, org, fname),
@@ -215,7 +213,7 @@ func TestAccFolderIamBinding_remove(t *testing.T) {
 	})
 }
 
-func testAccCheckGoogleFolderIamBindingExists(key string, expected *cloudresourcemanager.Binding, org, fname string) resource.TestCheckFunc {
+func testAccCheckGoogleFolderIamBindingExists(key string, expected *IamBinding, org, fname string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := testAccProvider.Meta().(*Config)
 		folderPolicy, err := getFolderIamPolicyByParentAndDisplayName("organizations/"+org, fname, config)
@@ -223,7 +221,7 @@ func testAccCheckGoogleFolderIamBindingExists(key string, expected *cloudresourc
 			return fmt.Errorf("Failed to retrieve IAM policy for folder %q: %s", fname, err)
 		}
 
-		var result *cloudresourcemanager.Binding
+		var result *IamBinding
 		for _, binding := range folderPolicy.Bindings {
 			if binding.Role == expected.Role {
 				result = binding
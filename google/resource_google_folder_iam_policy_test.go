@@ -6,7 +6,6 @@ import (
 	"reflect"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	resourceManagerV2Beta1 "google.golang.org/api/cloudresourcemanager/v2beta1"
@@ -15,7 +14,7 @@ import (
 func TestAccFolderIamPolicy_basic(t *testing.T) {
 	t.Parallel()
 
-	folderDisplayName := "tf-test-" + acctest.RandString(10)
+	folderDisplayName := "tf-test-" + randString(t, 10)
 	org := getTestOrgFromEnv(t)
 	parent := "organizations/" + org
 
@@ -46,7 +45,7 @@ func TestAccFolderIamPolicy_basic(t *testing.T) {
 func TestAccFolderIamPolicy_update(t *testing.T) {
 	t.Parallel()
 
-	folderDisplayName := "tf-test-" + acctest.RandString(10)
+	folderDisplayName := "tf-test-" + randString(t, 10)
 	org := getTestOrgFromEnv(t)
 	parent := "organizations/" + org
 
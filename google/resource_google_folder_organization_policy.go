@@ -13,14 +13,18 @@ func resourceGoogleFolderOrganizationPolicy() *schema.Resource {
 		Read:   resourceGoogleFolderOrganizationPolicyRead,
 		Update: resourceGoogleFolderOrganizationPolicyUpdate,
 		Delete: resourceGoogleFolderOrganizationPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGoogleFolderOrganizationPolicyImportState,
+		},
 
 		Schema: mergeSchemas(
 			schemaOrganizationPolicy,
 			map[string]*schema.Schema{
 				"folder": {
-					Type:     schema.TypeString,
-					Required: true,
-					ForceNew: true,
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					DiffSuppressFunc: optionalPrefixSuppress("folders/"),
 				},
 			},
 		),
@@ -83,6 +87,22 @@ func resourceGoogleFolderOrganizationPolicyDelete(d *schema.ResourceData, meta i
 	return nil
 }
 
+func resourceGoogleFolderOrganizationPolicyImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"folders/(?P<folder>[^/]+)/constraints/(?P<constraint>[^/]+)", "(?:folders/)?(?P<folder>[^/:]+):(?:constraints/)?(?P<constraint>[^/:]+)", "(?P<folder>[^/]+)/(?P<constraint>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{folder}}:{{constraint}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func setFolderOrganizationPolicy(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	folder := canonicalFolderId(d.Get("folder").(string))
@@ -6,7 +6,6 @@ import (
 	"sort"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/cloudresourcemanager/v1"
@@ -15,7 +14,7 @@ import (
 func TestAccFolderOrganizationPolicy_boolean(t *testing.T) {
 	t.Parallel()
 
-	folder := acctest.RandomWithPrefix("tf-test")
+	folder := randomWithPrefix(t, "tf-test")
 
 	org := getTestOrgFromEnv(t)
 	resource.Test(t, resource.TestCase{
@@ -47,6 +46,11 @@ func TestAccFolderOrganizationPolicy_boolean(t *testing.T) {
 				Config: testAccFolderOrganizationPolicy_boolean(org, folder, true),
 				Check:  testAccCheckGoogleFolderOrganizationBooleanPolicy("bool", true),
 			},
+			resource.TestStep{
+				ResourceName:      "google_folder_organization_policy.bool",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -54,7 +58,7 @@ func TestAccFolderOrganizationPolicy_boolean(t *testing.T) {
 func TestAccFolderOrganizationPolicy_list_allowAll(t *testing.T) {
 	t.Parallel()
 
-	folder := acctest.RandomWithPrefix("tf-test")
+	folder := randomWithPrefix(t, "tf-test")
 
 	org := getTestOrgFromEnv(t)
 	resource.Test(t, resource.TestCase{
@@ -73,7 +77,7 @@ func TestAccFolderOrganizationPolicy_list_allowAll(t *testing.T) {
 func TestAccFolderOrganizationPolicy_list_allowSome(t *testing.T) {
 	t.Parallel()
 
-	folder := acctest.RandomWithPrefix("tf-test")
+	folder := randomWithPrefix(t, "tf-test")
 	org := getTestOrgFromEnv(t)
 	project := getTestProjectFromEnv()
 	resource.Test(t, resource.TestCase{
@@ -92,7 +96,7 @@ func TestAccFolderOrganizationPolicy_list_allowSome(t *testing.T) {
 func TestAccFolderOrganizationPolicy_list_denySome(t *testing.T) {
 	t.Parallel()
 
-	folder := acctest.RandomWithPrefix("tf-test")
+	folder := randomWithPrefix(t, "tf-test")
 	org := getTestOrgFromEnv(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -110,7 +114,7 @@ func TestAccFolderOrganizationPolicy_list_denySome(t *testing.T) {
 func TestAccFolderOrganizationPolicy_list_update(t *testing.T) {
 	t.Parallel()
 
-	folder := acctest.RandomWithPrefix("tf-test")
+	folder := randomWithPrefix(t, "tf-test")
 	org := getTestOrgFromEnv(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -132,7 +136,7 @@ func TestAccFolderOrganizationPolicy_list_update(t *testing.T) {
 func TestAccFolderOrganizationPolicy_restore_defaultTrue(t *testing.T) {
 	t.Parallel()
 
-	folder := acctest.RandomWithPrefix("tf-test")
+	folder := randomWithPrefix(t, "tf-test")
 	org := getTestOrgFromEnv(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 
@@ -14,8 +13,8 @@ import (
 func TestAccFolder_rename(t *testing.T) {
 	t.Parallel()
 
-	folderDisplayName := "tf-test-" + acctest.RandString(10)
-	newFolderDisplayName := "tf-test-renamed-" + acctest.RandString(10)
+	folderDisplayName := "tf-test-" + randString(t, 10)
+	newFolderDisplayName := "tf-test-renamed-" + randString(t, 10)
 	org := getTestOrgFromEnv(t)
 	parent := "organizations/" + org
 	folder := resourceManagerV2Beta1.Folder{}
@@ -52,8 +51,8 @@ func TestAccFolder_rename(t *testing.T) {
 func TestAccFolder_moveParent(t *testing.T) {
 	t.Parallel()
 
-	folder1DisplayName := "tf-test-" + acctest.RandString(10)
-	folder2DisplayName := "tf-test-" + acctest.RandString(10)
+	folder1DisplayName := "tf-test-" + randString(t, 10)
+	folder2DisplayName := "tf-test-" + randString(t, 10)
 	org := getTestOrgFromEnv(t)
 	parent := "organizations/" + org
 	folder1 := resourceManagerV2Beta1.Folder{}
@@ -18,6 +18,8 @@ func resourceGoogleOrganizationIamCustomRole() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: iamCustomRolePermissionsCustomizeDiff(organizationIamCustomRoleFullResourceName),
+
 		Schema: map[string]*schema.Schema{
 			"role_id": {
 				Type:     schema.TypeString,
@@ -65,6 +67,28 @@ func resourceGoogleOrganizationIamCustomRoleCreate(d *schema.ResourceData, meta
 		return fmt.Errorf("Cannot create a custom organization role with a deleted state. `deleted` field should be false.")
 	}
 
+	roleName := fmt.Sprintf("organizations/%s/roles/%s", d.Get("org_id").(string), d.Get("role_id").(string))
+	// A deleted role keeps its ID until it is purged, and is undeleted rather
+	// than created again.
+	r, err := config.clientIAM.Organizations.Roles.Get(roleName).Do()
+	if err == nil {
+		if !r.Deleted {
+			return fmt.Errorf("Custom organization role %s already exists and must be imported", roleName)
+		}
+
+		d.SetId(r.Name)
+		if err := resourceGoogleOrganizationIamCustomRoleUndelete(d, meta); err != nil {
+			return err
+		}
+		if err := resourceGoogleOrganizationIamCustomRolePatch(d, config); err != nil {
+			return err
+		}
+		return resourceGoogleOrganizationIamCustomRoleRead(d, meta)
+	}
+	if !isGoogleApiErrorWithCode(err, 404) {
+		return fmt.Errorf("Error reading the custom organization role %s: %s", roleName, err)
+	}
+
 	role, err := config.clientIAM.Organizations.Roles.Create("organizations/"+d.Get("org_id").(string), &iam.CreateRoleRequest{
 		RoleId: d.Get("role_id").(string),
 		Role: &iam.Role{
@@ -127,15 +151,8 @@ func resourceGoogleOrganizationIamCustomRoleUpdate(d *schema.ResourceData, meta
 	}
 
 	if d.HasChange("title") || d.HasChange("description") || d.HasChange("stage") || d.HasChange("permissions") {
-		_, err := config.clientIAM.Organizations.Roles.Patch(d.Id(), &iam.Role{
-			Title:               d.Get("title").(string),
-			Description:         d.Get("description").(string),
-			Stage:               d.Get("stage").(string),
-			IncludedPermissions: convertStringSet(d.Get("permissions").(*schema.Set)),
-		}).Do()
-
-		if err != nil {
-			return fmt.Errorf("Error updating the custom organization role %s: %s", d.Get("title").(string), err)
+		if err := resourceGoogleOrganizationIamCustomRolePatch(d, config); err != nil {
+			return err
 		}
 		d.SetPartial("title")
 		d.SetPartial("description")
@@ -169,3 +186,27 @@ func resourceGoogleOrganizationIamCustomRoleUndelete(d *schema.ResourceData, met
 
 	return nil
 }
+
+func resourceGoogleOrganizationIamCustomRolePatch(d *schema.ResourceData, config *Config) error {
+	_, err := config.clientIAM.Organizations.Roles.Patch(d.Id(), &iam.Role{
+		Title:               d.Get("title").(string),
+		Description:         d.Get("description").(string),
+		Stage:               d.Get("stage").(string),
+		IncludedPermissions: convertStringSet(d.Get("permissions").(*schema.Set)),
+	}).Do()
+
+	if err != nil {
+		return fmt.Errorf("Error updating the custom organization role %s: %s", d.Get("title").(string), err)
+	}
+
+	return nil
+}
+
+func organizationIamCustomRoleFullResourceName(d *schema.ResourceDiff, config *Config) (string, error) {
+	// org_id is only empty when it isn't known yet.
+	orgId := d.Get("org_id").(string)
+	if orgId == "" {
+		return "", nil
+	}
+	return "//cloudresourcemanager.googleapis.com/organizations/" + orgId, nil
+}
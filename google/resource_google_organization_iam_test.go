@@ -6,7 +6,6 @@ import (
 	"sort"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/cloudresourcemanager/v1"
@@ -22,8 +21,8 @@ func TestAccOrganizationIam(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	account := acctest.RandomWithPrefix("tf-test")
-	roleId := "tfIamTest" + acctest.RandString(10)
+	account := randomWithPrefix(t, "tf-test")
+	roleId := "tfIamTest" + randString(t, 10)
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
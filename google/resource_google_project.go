@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	appengine "google.golang.org/api/appengine/v1"
@@ -30,8 +31,11 @@ func resourceGoogleProject() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceProjectImportState,
 		},
-		MigrateState:  resourceGoogleProjectMigrateState,
-		CustomizeDiff: resourceGoogleProjectCustomizeDiff,
+		MigrateState: resourceGoogleProjectMigrateState,
+		CustomizeDiff: customdiff.All(
+			resourceGoogleProjectCustomizeDiff,
+			effectiveLabelsCustomizeDiff(false),
+		),
 
 		Schema: map[string]*schema.Schema{
 			"project_id": &schema.Schema{
@@ -91,6 +95,7 @@ func resourceGoogleProject() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+			"effective_labels": effectiveLabelsSchema(),
 			"app_engine": &schema.Schema{
 				Type:     schema.TypeList,
 				Optional: true,
@@ -237,9 +242,7 @@ func resourceGoogleProjectCreate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	if _, ok := d.GetOk("labels"); ok {
-		project.Labels = expandLabels(d)
-	}
+	project.Labels = expandLabels(d, config)
 
 	op, err := config.clientResourceManager.Projects.Create(project).Do()
 	if err != nil {
@@ -249,7 +252,7 @@ func resourceGoogleProjectCreate(d *schema.ResourceData, meta interface{}) error
 	d.SetId(pid)
 
 	// Wait for the operation to complete
-	waitErr := resourceManagerOperationWait(config.clientResourceManager, op, "project to create")
+	waitErr := resourceManagerOperationWait(config, op, "project to create")
 	if waitErr != nil {
 		// The resource wasn't actually created
 		d.SetId("")
@@ -284,7 +287,7 @@ func resourceGoogleProjectCreate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		// Wait for the operation to complete
-		waitErr := appEngineOperationWait(config.clientAppEngine, op, pid, "App Engine app to create")
+		waitErr := appEngineOperationWait(config, op, pid, "App Engine app to create")
 		if waitErr != nil {
 			return waitErr
 		}
@@ -333,7 +336,9 @@ func resourceGoogleProjectRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("project_id", pid)
 	d.Set("number", strconv.FormatInt(int64(p.ProjectNumber), 10))
 	d.Set("name", p.Name)
-	d.Set("labels", p.Labels)
+	if err := setLabels(d, config, p.Labels); err != nil {
+		return fmt.Errorf("Error setting labels: %s", err)
+	}
 
 	if p.Parent != nil {
 		switch p.Parent.Type {
@@ -481,8 +486,8 @@ func resourceGoogleProjectUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	// Project Labels have changed
-	if ok := d.HasChange("labels"); ok {
-		p.Labels = expandLabels(d)
+	if ok := hasLabelsChange(d); ok {
+		p.Labels = expandLabels(d, config)
 
 		// Do Update on project
 		p, err = config.clientResourceManager.Projects.Update(p.ProjectId, p).Do()
@@ -490,6 +495,7 @@ func resourceGoogleProjectUpdate(d *schema.ResourceData, meta interface{}) error
 			return fmt.Errorf("Error updating project %q: %s", project_name, err)
 		}
 		d.SetPartial("labels")
+		d.SetPartial("effective_labels")
 	}
 
 	// ignore app_engine changes, they don't work anyways.
@@ -538,7 +544,7 @@ func forceDeleteComputeNetwork(projectId, networkName string, config *Config) er
 			if err != nil {
 				return fmt.Errorf("Error deleting firewall: %s", err)
 			}
-			err = computeSharedOperationWait(config.clientCompute, op, projectId, "Deleting Firewall")
+			err = computeSharedOperationWait(config, op, projectId, "Deleting Firewall")
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,96 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-google/google/fakegcp"
+)
+
+// Test that the audit config of a service is set, updated and removed
+// without affecting the bindings of the policy.
+func TestFakeProjectIamAuditConfig_basic(t *testing.T) {
+	t.Parallel()
+
+	s := newFakeGcpServer(t)
+	defer s.Close()
+	service := "storage.googleapis.com"
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testFakeGcpProviders(),
+		CheckDestroy: func(*terraform.State) error {
+			if auditConfigs := s.IamPolicy("projects/" + fakeGcpProject)["auditConfigs"]; auditConfigs != nil && len(auditConfigs.([]interface{})) > 0 {
+				return fmt.Errorf("Policy still has audit configs: %v", auditConfigs)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeProjectIamAuditConfig(s, service, "DATA_READ", "DATA_WRITE"),
+				Check: resource.ComposeTestCheckFunc(
+					testFakeProjectIamAuditLogConfigs(s, service, 2),
+					testFakeProjectIamMembers(s, "roles/viewer", 1),
+					resource.TestCheckResourceAttr("google_project_iam_audit_config.audit", "id", fmt.Sprintf("%s/audit_config/%s", fakeGcpProject, service)),
+				),
+			},
+			resource.TestStep{
+				Config:            testFakeGcpProviderConfig(s),
+				ResourceName:      "google_project_iam_audit_config.audit",
+				ImportStateId:     fmt.Sprintf("%s %s", fakeGcpProject, service),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testFakeProjectIamAuditConfig(s, service, "DATA_WRITE"),
+				Check: resource.ComposeTestCheckFunc(
+					testFakeProjectIamAuditLogConfigs(s, service, 1),
+					testFakeProjectIamMembers(s, "roles/viewer", 1),
+				),
+			},
+		},
+	})
+}
+
+// testFakeProjectIamAuditLogConfigs checks that the audit config of service
+// in the policy of the project has count audit log configs.
+func testFakeProjectIamAuditLogConfigs(s *fakegcp.Server, service string, count int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		auditConfigs, _ := s.IamPolicy("projects/" + fakeGcpProject)["auditConfigs"].([]interface{})
+		for _, raw := range auditConfigs {
+			auditConfig := raw.(map[string]interface{})
+			if auditConfig["service"] != service {
+				continue
+			}
+			if logConfigs := auditConfig["auditLogConfigs"].([]interface{}); len(logConfigs) != count {
+				return fmt.Errorf("Expected %d audit log configs for %s, got %v", count, service, logConfigs)
+			}
+			return nil
+		}
+		return fmt.Errorf("No audit config found for %s", service)
+	}
+}
+
+func testFakeProjectIamAuditConfig(s *fakegcp.Server, service string, logTypes ...string) string {
+	config := testFakeGcpProviderConfig(s) + fmt.Sprintf(`
+resource "google_project_iam_member" "viewer" {
+	project = "%s"
+	role    = "roles/viewer"
+	member  = "user:jane@example.com"
+}
+
+resource "google_project_iam_audit_config" "audit" {
+	project = "%s"
+	service = "%s"
+`, fakeGcpProject, fakeGcpProject, service)
+	for _, logType := range logTypes {
+		config += fmt.Sprintf(`
+	audit_log_config {
+		log_type         = "%s"
+		exempted_members = ["user:jane@example.com"]
+	}
+`, logType)
+	}
+	return config + "}\n"
+}
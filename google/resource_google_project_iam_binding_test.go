@@ -2,10 +2,11 @@ package google
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-google/google/fakegcp"
 )
 
 func projectIamBindingImportStep(resourceName, pid, role string) resource.TestStep {
@@ -17,12 +18,49 @@ func projectIamBindingImportStep(resourceName, pid, role string) resource.TestSt
 	}
 }
 
+// Test that conditional and unconditional bindings of the same role are
+// managed separately.
+func TestFakeProjectIamBinding_condition(t *testing.T) {
+	t.Parallel()
+
+	s := newFakeGcpServer(t)
+	defer s.Close()
+	role := "roles/compute.instanceAdmin"
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testFakeGcpProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeProjectIamBinding_condition(s, role, "user:admin@example.com"),
+				Check:  testFakeProjectIamBindings(s, role, 2),
+			},
+			resource.TestStep{
+				Config:            testFakeGcpProviderConfig(s),
+				ResourceName:      "google_project_iam_binding.conditional",
+				ImportStateId:     fmt.Sprintf("%s %s expires after 2019", fakeGcpProject, role),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Updating the members of the conditional binding leaves the
+			// other binding alone.
+			{
+				Config: testFakeProjectIamBinding_condition(s, role, "user:admin@example.com", "user:other@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testFakeProjectIamBindings(s, role, 2),
+					resource.TestCheckResourceAttr("google_project_iam_binding.unconditional", "members.#", "1"),
+					resource.TestCheckResourceAttr("google_project_iam_binding.conditional", "members.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 // Test that an IAM binding can be applied to a project
 func TestAccProjectIamBinding_basic(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	pid := "terraform-" + acctest.RandString(10)
+	pid := "terraform-" + randString(t, 10)
 	role := "roles/compute.instanceAdmin"
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -49,7 +87,7 @@ func TestAccProjectIamBinding_multiple(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	pid := "terraform-" + acctest.RandString(10)
+	pid := "terraform-" + randString(t, 10)
 	role := "roles/compute.instanceAdmin"
 	role2 := "roles/viewer"
 
@@ -83,7 +121,7 @@ func TestAccProjectIamBinding_multipleAtOnce(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	pid := "terraform-" + acctest.RandString(10)
+	pid := "terraform-" + randString(t, 10)
 	role := "roles/compute.instanceAdmin"
 	role2 := "roles/viewer"
 
@@ -113,7 +151,7 @@ func TestAccProjectIamBinding_update(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	pid := "terraform-" + acctest.RandString(10)
+	pid := "terraform-" + randString(t, 10)
 	role := "roles/compute.instanceAdmin"
 
 	resource.Test(t, resource.TestCase{
@@ -153,7 +191,7 @@ func TestAccProjectIamBinding_remove(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	pid := "terraform-" + acctest.RandString(10)
+	pid := "terraform-" + randString(t, 10)
 	role := "roles/compute.instanceAdmin"
 	role2 := "roles/viewer"
 
@@ -255,3 +293,24 @@ resource "google_project_iam_binding" "acceptance" {
 }
 `, pid, name, org, role)
 }
+
+func testFakeProjectIamBinding_condition(s *fakegcp.Server, role string, members ...string) string {
+	return testFakeGcpProviderConfig(s) + fmt.Sprintf(`
+resource "google_project_iam_binding" "unconditional" {
+	project = "%s"
+	role    = "%s"
+	members = ["user:admin@example.com"]
+}
+
+resource "google_project_iam_binding" "conditional" {
+	project = "%s"
+	role    = "%s"
+	members = ["%s"]
+
+	condition {
+		title      = "expires after 2019"
+		expression = "request.time < timestamp(\"2020-01-01T00:00:00Z\")"
+	}
+}
+`, fakeGcpProject, role, fakeGcpProject, role, strings.Join(members, `", "`))
+}
@@ -18,6 +18,8 @@ func resourceGoogleProjectIamCustomRole() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: iamCustomRolePermissionsCustomizeDiff(projectIamCustomRoleFullResourceName),
+
 		Schema: map[string]*schema.Schema{
 			"role_id": {
 				Type:     schema.TypeString,
@@ -71,6 +73,28 @@ func resourceGoogleProjectIamCustomRoleCreate(d *schema.ResourceData, meta inter
 		return fmt.Errorf("Cannot create a custom project role with a deleted state. `deleted` field should be false.")
 	}
 
+	roleName := fmt.Sprintf("projects/%s/roles/%s", project, d.Get("role_id").(string))
+	// A deleted role keeps its ID until it is purged, and is undeleted rather
+	// than created again.
+	r, err := config.clientIAM.Projects.Roles.Get(roleName).Do()
+	if err == nil {
+		if !r.Deleted {
+			return fmt.Errorf("Custom project role %s already exists and must be imported", roleName)
+		}
+
+		d.SetId(r.Name)
+		if err := resourceGoogleProjectIamCustomRoleUndelete(d, meta); err != nil {
+			return err
+		}
+		if err := resourceGoogleProjectIamCustomRolePatch(d, config); err != nil {
+			return err
+		}
+		return resourceGoogleProjectIamCustomRoleRead(d, meta)
+	}
+	if !isGoogleApiErrorWithCode(err, 404) {
+		return fmt.Errorf("Error reading the custom project role %s: %s", roleName, err)
+	}
+
 	role, err := config.clientIAM.Projects.Roles.Create("projects/"+project, &iam.CreateRoleRequest{
 		RoleId: d.Get("role_id").(string),
 		Role: &iam.Role{
@@ -133,15 +157,8 @@ func resourceGoogleProjectIamCustomRoleUpdate(d *schema.ResourceData, meta inter
 	}
 
 	if d.HasChange("title") || d.HasChange("description") || d.HasChange("stage") || d.HasChange("permissions") {
-		_, err := config.clientIAM.Projects.Roles.Patch(d.Id(), &iam.Role{
-			Title:               d.Get("title").(string),
-			Description:         d.Get("description").(string),
-			Stage:               d.Get("stage").(string),
-			IncludedPermissions: convertStringSet(d.Get("permissions").(*schema.Set)),
-		}).Do()
-
-		if err != nil {
-			return fmt.Errorf("Error updating the custom project role %s: %s", d.Get("title").(string), err)
+		if err := resourceGoogleProjectIamCustomRolePatch(d, config); err != nil {
+			return err
 		}
 		d.SetPartial("title")
 		d.SetPartial("description")
@@ -175,3 +192,30 @@ func resourceGoogleProjectIamCustomRoleUndelete(d *schema.ResourceData, meta int
 
 	return nil
 }
+
+func resourceGoogleProjectIamCustomRolePatch(d *schema.ResourceData, config *Config) error {
+	_, err := config.clientIAM.Projects.Roles.Patch(d.Id(), &iam.Role{
+		Title:               d.Get("title").(string),
+		Description:         d.Get("description").(string),
+		Stage:               d.Get("stage").(string),
+		IncludedPermissions: convertStringSet(d.Get("permissions").(*schema.Set)),
+	}).Do()
+
+	if err != nil {
+		return fmt.Errorf("Error updating the custom project role %s: %s", d.Get("title").(string), err)
+	}
+
+	return nil
+}
+
+func projectIamCustomRoleFullResourceName(d *schema.ResourceDiff, config *Config) (string, error) {
+	// The project of a new role reads as empty both when it isn't set, and is
+	// the provider project, and when it isn't known yet, e.g. when it is
+	// created in the same run. As they can't be told apart, the permissions
+	// are only checked once the project is in the state of the role.
+	project := d.Get("project").(string)
+	if project == "" {
+		return "", nil
+	}
+	return "//cloudresourcemanager.googleapis.com/projects/" + project, nil
+}
@@ -3,10 +3,10 @@ package google
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"sort"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -14,7 +14,7 @@ import (
 func TestAccProjectIamCustomRole_basic(t *testing.T) {
 	t.Parallel()
 
-	roleId := "tfIamCustomRole" + acctest.RandString(10)
+	roleId := "tfIamCustomRole" + randString(t, 10)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -46,7 +46,7 @@ func TestAccProjectIamCustomRole_basic(t *testing.T) {
 func TestAccProjectIamCustomRole_undelete(t *testing.T) {
 	t.Parallel()
 
-	roleId := "tfIamCustomRole" + acctest.RandString(10)
+	roleId := "tfIamCustomRole" + randString(t, 10)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -71,6 +71,63 @@ func TestAccProjectIamCustomRole_undelete(t *testing.T) {
 	})
 }
 
+func TestAccProjectIamCustomRole_createAfterDestroy(t *testing.T) {
+	t.Parallel()
+
+	roleId := "tfIamCustomRole" + randString(t, 10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGoogleProjectIamCustomRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGoogleProjectIamCustomRole_basic(roleId),
+				Check:  testAccCheckGoogleProjectIamCustomRoleDeletionStatus("google_project_iam_custom_role.foo", false),
+			},
+			// Destroying the role soft-deletes it.
+			{
+				Config:  " ",
+				Destroy: true,
+			},
+			// The deleted role is undeleted rather than created again.
+			{
+				Config: testAccCheckGoogleProjectIamCustomRole_update(roleId),
+				Check: testAccCheckGoogleProjectIamCustomRole(
+					"google_project_iam_custom_role.foo",
+					"My Custom Role Updated",
+					"bar",
+					"BETA",
+					[]string{"iam.roles.list", "iam.roles.create", "iam.roles.delete"}),
+			},
+		},
+	})
+}
+
+func TestAccProjectIamCustomRole_invalidPermissions(t *testing.T) {
+	t.Parallel()
+
+	roleId := "tfIamCustomRole" + randString(t, 10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGoogleProjectIamCustomRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "google_project_iam_custom_role" "foo" {
+  role_id     = "%s"
+  title       = "My Custom Role"
+  permissions = ["iam.roles.list", "resourcemanager.projects.list"]
+}
+`, roleId),
+				ExpectError: regexp.MustCompile("resourcemanager.projects.list is not supported in custom roles"),
+			},
+		},
+	})
+}
+
 func testAccCheckGoogleProjectIamCustomRoleDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
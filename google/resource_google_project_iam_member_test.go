@@ -4,8 +4,9 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-google/google/fakegcp"
 )
 
 func projectIamMemberImportStep(resourceName, pid, role, member string) resource.TestStep {
@@ -17,12 +18,86 @@ func projectIamMemberImportStep(resourceName, pid, role, member string) resource
 	}
 }
 
+// Test that members are added to and removed from the policy of a project
+// without affecting each other.
+func TestFakeProjectIamMember_multiple(t *testing.T) {
+	t.Parallel()
+
+	s := newFakeGcpServer(t)
+	defer s.Close()
+	role := "roles/compute.instanceAdmin"
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testFakeGcpProviders(),
+		CheckDestroy: func(*terraform.State) error {
+			if bindings := s.IamPolicy("projects/" + fakeGcpProject)["bindings"]; bindings != nil {
+				return fmt.Errorf("Policy still has bindings: %v", bindings)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeProjectIamMember_multiple(s, role, "user:admin@example.com", "user:other@example.com"),
+				Check:  testFakeProjectIamMembers(s, role, 2),
+			},
+			resource.TestStep{
+				Config:            testFakeGcpProviderConfig(s),
+				ResourceName:      "google_project_iam_member.first",
+				ImportStateId:     fmt.Sprintf("%s %s user:admin@example.com", fakeGcpProject, role),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testFakeProjectIamMember_multiple(s, role, "user:admin@example.com"),
+				Check:  testFakeProjectIamMembers(s, role, 1),
+			},
+		},
+	})
+}
+
+// Test that a conditional member is kept apart from the same member without
+// a condition.
+func TestFakeProjectIamMember_condition(t *testing.T) {
+	t.Parallel()
+
+	s := newFakeGcpServer(t)
+	defer s.Close()
+	role := "roles/compute.instanceAdmin"
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testFakeGcpProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeProjectIamMember_condition(s, role, "user:admin@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testFakeProjectIamBindings(s, role, 2),
+					resource.TestCheckResourceAttr("google_project_iam_member.conditional", "id", fmt.Sprintf("%s/%s/user:admin@example.com/expires after 2019", fakeGcpProject, role)),
+				),
+			},
+			resource.TestStep{
+				Config:            testFakeGcpProviderConfig(s),
+				ResourceName:      "google_project_iam_member.conditional",
+				ImportStateId:     fmt.Sprintf("%s %s user:admin@example.com expires after 2019", fakeGcpProject, role),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			resource.TestStep{
+				Config:            testFakeGcpProviderConfig(s),
+				ResourceName:      "google_project_iam_member.unconditional",
+				ImportStateId:     fmt.Sprintf("%s %s user:admin@example.com", fakeGcpProject, role),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 // Test that an IAM binding can be applied to a project
 func TestAccProjectIamMember_basic(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	pid := "terraform-" + acctest.RandString(10)
+	pid := "terraform-" + randString(t, 10)
 	resourceName := "google_project_iam_member.acceptance"
 	role := "roles/compute.instanceAdmin"
 	member := "user:admin@hashicorptest.com"
@@ -53,7 +128,7 @@ func TestAccProjectIamMember_multiple(t *testing.T) {
 	org := getTestOrgFromEnv(t)
 	skipIfEnvNotSet(t, "GOOGLE_ORG")
 
-	pid := "terraform-" + acctest.RandString(10)
+	pid := "terraform-" + randString(t, 10)
 	resourceName := "google_project_iam_member.acceptance"
 	resourceName2 := "google_project_iam_member.multiple"
 	role := "roles/compute.instanceAdmin"
@@ -94,7 +169,7 @@ func TestAccProjectIamMember_remove(t *testing.T) {
 	org := getTestOrgFromEnv(t)
 	skipIfEnvNotSet(t, "GOOGLE_ORG")
 
-	pid := "terraform-" + acctest.RandString(10)
+	pid := "terraform-" + randString(t, 10)
 	resourceName := "google_project_iam_member.acceptance"
 	role := "roles/compute.instanceAdmin"
 	member := "user:admin@hashicorptest.com"
@@ -167,3 +242,78 @@ resource "google_project_iam_member" "multiple" {
 }
 `, pid, name, org, role, member, role2, member2)
 }
+
+func testFakeProjectIamMembers(s *fakegcp.Server, role string, count int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		bindings, _ := s.IamPolicy("projects/" + fakeGcpProject)["bindings"].([]interface{})
+		for _, raw := range bindings {
+			binding := raw.(map[string]interface{})
+			if binding["role"] != role {
+				continue
+			}
+			if members := binding["members"].([]interface{}); len(members) != count {
+				return fmt.Errorf("Expected %d members of %s, got %v", count, role, members)
+			}
+			return nil
+		}
+		return fmt.Errorf("No binding found for %s", role)
+	}
+}
+
+// testFakeProjectIamBindings checks that the policy of the project has count
+// bindings for role, with or without a condition.
+func testFakeProjectIamBindings(s *fakegcp.Server, role string, count int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		bindings, _ := s.IamPolicy("projects/" + fakeGcpProject)["bindings"].([]interface{})
+		var found []interface{}
+		for _, raw := range bindings {
+			if raw.(map[string]interface{})["role"] == role {
+				found = append(found, raw)
+			}
+		}
+		if len(found) != count {
+			return fmt.Errorf("Expected %d bindings for %s, got %v", count, role, found)
+		}
+		return nil
+	}
+}
+
+func testFakeProjectIamMember_condition(s *fakegcp.Server, role, member string) string {
+	return testFakeGcpProviderConfig(s) + fmt.Sprintf(`
+resource "google_project_iam_member" "unconditional" {
+	project = "%s"
+	role    = "%s"
+	member  = "%s"
+}
+
+resource "google_project_iam_member" "conditional" {
+	project = "%s"
+	role    = "%s"
+	member  = "%s"
+
+	condition {
+		title       = "expires after 2019"
+		description = "Expiring at midnight of 2019-12-31"
+		expression  = "request.time < timestamp(\"2020-01-01T00:00:00Z\")"
+	}
+}
+`, fakeGcpProject, role, member, fakeGcpProject, role, member)
+}
+
+func testFakeProjectIamMember_multiple(s *fakegcp.Server, role string, members ...string) string {
+	config := testFakeGcpProviderConfig(s)
+	for i, member := range members {
+		name := "first"
+		if i > 0 {
+			name = fmt.Sprintf("member%d", i)
+		}
+		config += fmt.Sprintf(`
+resource "google_project_iam_member" "%s" {
+	project = "%s"
+	role    = "%s"
+	member  = "%s"
+}
+`, name, fakeGcpProject, role, member)
+	}
+	return config
+}
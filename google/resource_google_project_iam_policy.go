@@ -51,6 +51,7 @@ func resourceGoogleProjectIamPolicy() *schema.Resource {
 				Type:       schema.TypeBool,
 				Optional:   true,
 			},
+			"allow_lockout": IamLockoutSchema["allow_lockout"],
 		},
 	}
 }
@@ -71,6 +72,9 @@ func resourceGoogleProjectIamPolicyCreate(d *schema.ResourceData, meta interface
 	// policy.
 	if v, ok := d.GetOk("authoritative"); ok && v.(bool) {
 		log.Printf("[DEBUG] Setting authoritative IAM policy for project %q", pid)
+		if err := checkProjectIamPolicyLockout(d, config, pid, p); err != nil {
+			return err
+		}
 		err := setProjectIamPolicy(p, config, pid)
 		if err != nil {
 			return err
@@ -99,6 +103,10 @@ func resourceGoogleProjectIamPolicyCreate(d *schema.ResourceData, meta interface
 		// Merge the policies together
 		mb := mergeBindings(append(p.Bindings, rp.Bindings...))
 		ep.Bindings = mb
+		ep.AuditConfigs = mergeAuditConfigs(ep.AuditConfigs, p.AuditConfigs)
+		if err := checkProjectIamPolicyLockout(d, config, pid, ep); err != nil {
+			return err
+		}
 		if err = setProjectIamPolicy(ep, config, pid); err != nil {
 			return fmt.Errorf("Error applying IAM policy to project: %v", err)
 		}
@@ -120,9 +128,9 @@ func resourceGoogleProjectIamPolicyRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	var bindings []*cloudresourcemanager.Binding
+	var bindings []*IamBinding
 	if v, ok := d.GetOk("restore_policy"); ok {
-		var restored cloudresourcemanager.Policy
+		var restored IamPolicy
 		// if there's a restore policy, subtract it from the policy_data
 		err := json.Unmarshal([]byte(v.(string)), &restored)
 		if err != nil {
@@ -133,8 +141,18 @@ func resourceGoogleProjectIamPolicyRead(d *schema.ResourceData, meta interface{}
 	} else {
 		bindings = p.Bindings
 	}
-	// we only marshal the bindings, because only the bindings get set in the config
-	pBytes, err := json.Marshal(&cloudresourcemanager.Policy{Bindings: bindings})
+	// A non-authoritative policy only manages the audit configs of the
+	// services in its policy_data.
+	auditConfigs := p.AuditConfigs
+	if v, ok := d.GetOk("authoritative"); !ok || !v.(bool) {
+		cp, err := getResourceIamPolicy(d)
+		if err != nil {
+			return fmt.Errorf("Could not get valid 'policy_data' from resource: %v", err)
+		}
+		auditConfigs = filterAuditConfigs(p.AuditConfigs, cp.AuditConfigs)
+	}
+	// we only marshal the bindings and audit configs, because only they get set in the config
+	pBytes, err := json.Marshal(&IamPolicy{AuditConfigs: auditConfigs, Bindings: bindings})
 	if err != nil {
 		return fmt.Errorf("Error marshaling IAM policy: %v", err)
 	}
@@ -165,6 +183,9 @@ func resourceGoogleProjectIamPolicyUpdate(d *schema.ResourceData, meta interface
 	// policy.
 	if v, ok := d.GetOk("authoritative"); ok && v.(bool) {
 		log.Printf("[DEBUG] Updating authoritative IAM policy for project %q", pid)
+		if err := checkProjectIamPolicyLockout(d, config, pid, p); err != nil {
+			return err
+		}
 		err := setProjectIamPolicy(p, config, pid)
 		if err != nil {
 			return fmt.Errorf("Error setting project IAM policy: %v", err)
@@ -204,6 +225,10 @@ func resourceGoogleProjectIamPolicyUpdate(d *schema.ResourceData, meta interface
 		// Merge the policies together
 		mb := mergeBindings(append(p.Bindings, rp.Bindings...))
 		ep.Bindings = mb
+		ep.AuditConfigs = mergeAuditConfigs(removeAuditConfigs(ep.AuditConfigs, pp.AuditConfigs), p.AuditConfigs)
+		if err := checkProjectIamPolicyLockout(d, config, pid, ep); err != nil {
+			return err
+		}
 		if err = setProjectIamPolicy(ep, config, pid); err != nil {
 			return fmt.Errorf("Error applying IAM policy to project: %v", err)
 		}
@@ -233,7 +258,8 @@ func resourceGoogleProjectIamPolicyDelete(d *schema.ResourceData, meta interface
 		if v, ok := d.GetOk("disable_project"); !ok || !v.(bool) {
 			return fmt.Errorf("You must set 'disable_project' to true before deleting an authoritative IAM policy")
 		}
-		ep.Bindings = make([]*cloudresourcemanager.Binding, 0)
+		ep.Bindings = make([]*IamBinding, 0)
+		ep.AuditConfigs = nil
 
 	} else {
 		// A non-authoritative policy should set the policy to the value of "restore_policy" in state
@@ -243,6 +269,12 @@ func resourceGoogleProjectIamPolicyDelete(d *schema.ResourceData, meta interface
 			return fmt.Errorf("Error retrieving previous version of changed project IAM policy: %v", err)
 		}
 		ep.Bindings = rp.Bindings
+
+		p, err := getResourceIamPolicy(d)
+		if err != nil {
+			return fmt.Errorf("Could not get valid 'policy_data' from resource: %v", err)
+		}
+		ep.AuditConfigs = removeAuditConfigs(ep.AuditConfigs, p.AuditConfigs)
 	}
 	if err = setProjectIamPolicy(ep, config, pid); err != nil {
 		return fmt.Errorf("Error applying IAM policy to project: %v", err)
@@ -252,16 +284,17 @@ func resourceGoogleProjectIamPolicyDelete(d *schema.ResourceData, meta interface
 }
 
 // Subtract all bindings in policy b from policy a, and return the result
-func subtractIamPolicy(a, b *cloudresourcemanager.Policy) *cloudresourcemanager.Policy {
+func subtractIamPolicy(a, b *IamPolicy) *IamPolicy {
 	am := rolesToMembersMap(a.Bindings)
 
 	for _, b := range b.Bindings {
-		if _, ok := am[b.Role]; ok {
+		k := b.key()
+		if _, ok := am[k]; ok {
 			for _, m := range b.Members {
-				delete(am[b.Role], m)
+				delete(am[k], m)
 			}
-			if len(am[b.Role]) == 0 {
-				delete(am, b.Role)
+			if len(am[k]) == 0 {
+				delete(am, k)
 			}
 		}
 	}
@@ -269,12 +302,12 @@ func subtractIamPolicy(a, b *cloudresourcemanager.Policy) *cloudresourcemanager.
 	return a
 }
 
-func setProjectIamPolicy(policy *cloudresourcemanager.Policy, config *Config, pid string) error {
+func setProjectIamPolicy(policy *IamPolicy, config *Config, pid string) error {
 	// Apply the policy
 	pbytes, _ := json.Marshal(policy)
 	log.Printf("[DEBUG] Setting policy %#v for project: %s", string(pbytes), pid)
-	_, err := config.clientResourceManager.Projects.SetIamPolicy(pid,
-		&cloudresourcemanager.SetIamPolicyRequest{Policy: policy}).Do()
+	url := fmt.Sprintf("%sprojects/%s:setIamPolicy", config.basePath(ResourceManagerBasePathKey), pid)
+	err := setResourceManagerIamPolicy(config, url, pid, policy)
 
 	if err != nil {
 		return errwrap.Wrapf(fmt.Sprintf("Error applying IAM policy for project %q. Policy is %#v, error is {{err}}", pid, policy), err)
@@ -282,21 +315,28 @@ func setProjectIamPolicy(policy *cloudresourcemanager.Policy, config *Config, pi
 	return nil
 }
 
-// Get a cloudresourcemanager.Policy from a schema.ResourceData
-func getResourceIamPolicy(d *schema.ResourceData) (*cloudresourcemanager.Policy, error) {
+// checkProjectIamPolicyLockout returns an error if applying policy would lock
+// the caller out of the policy of the project, unless allowed.
+func checkProjectIamPolicyLockout(d *schema.ResourceData, config *Config, pid string, policy *IamPolicy) error {
+	updater := &ProjectIamUpdater{resourceId: pid, Config: config}
+	return checkIamPolicyLockout(config, updater, policy, iamAllowLockout(d))
+}
+
+// Get an IamPolicy from a schema.ResourceData
+func getResourceIamPolicy(d *schema.ResourceData) (*IamPolicy, error) {
 	ps := d.Get("policy_data").(string)
-	// The policy string is just a marshaled cloudresourcemanager.Policy.
-	policy := &cloudresourcemanager.Policy{}
+	// The policy string is just a marshaled IamPolicy.
+	policy := &IamPolicy{}
 	if err := json.Unmarshal([]byte(ps), policy); err != nil {
 		return nil, fmt.Errorf("Could not unmarshal %s:\n: %v", ps, err)
 	}
 	return policy, nil
 }
 
-// Get the previous cloudresourcemanager.Policy from a schema.ResourceData if the
+// Get the previous IamPolicy from a schema.ResourceData if the
 // resource has changed
-func getPrevResourceIamPolicy(d *schema.ResourceData) (*cloudresourcemanager.Policy, error) {
-	var policy *cloudresourcemanager.Policy = &cloudresourcemanager.Policy{}
+func getPrevResourceIamPolicy(d *schema.ResourceData) (*IamPolicy, error) {
+	var policy *IamPolicy = &IamPolicy{}
 	if d.HasChange("policy_data") {
 		v, _ := d.GetChange("policy_data")
 		if err := json.Unmarshal([]byte(v.(string)), policy); err != nil {
@@ -308,9 +348,9 @@ func getPrevResourceIamPolicy(d *schema.ResourceData) (*cloudresourcemanager.Pol
 
 // Get the restore_policy that can be used to restore a project's IAM policy to its
 // state before it was adopted into Terraform
-func getRestoreIamPolicy(d *schema.ResourceData) (*cloudresourcemanager.Policy, error) {
+func getRestoreIamPolicy(d *schema.ResourceData) (*IamPolicy, error) {
 	if v, ok := d.GetOk("restore_policy"); ok {
-		policy := &cloudresourcemanager.Policy{}
+		policy := &IamPolicy{}
 		if err := json.Unmarshal([]byte(v.(string)), policy); err != nil {
 			return nil, fmt.Errorf("Could not unmarshal previous policy %s:\n: %v", v, err)
 		}
@@ -320,9 +360,9 @@ func getRestoreIamPolicy(d *schema.ResourceData) (*cloudresourcemanager.Policy,
 }
 
 // Retrieve the existing IAM Policy for a Project
-func getProjectIamPolicy(project string, config *Config) (*cloudresourcemanager.Policy, error) {
-	p, err := config.clientResourceManager.Projects.GetIamPolicy(project,
-		&cloudresourcemanager.GetIamPolicyRequest{}).Do()
+func getProjectIamPolicy(project string, config *Config) (*IamPolicy, error) {
+	url := fmt.Sprintf("%sprojects/%s:getIamPolicy", config.basePath(ResourceManagerBasePathKey), project)
+	p, err := getIamPolicyWithBody(config, url, project)
 
 	if err != nil {
 		return nil, fmt.Errorf("Error retrieving IAM policy for project %q: %s", project, err)
@@ -331,23 +371,62 @@ func getProjectIamPolicy(project string, config *Config) (*cloudresourcemanager.
 }
 
 // Convert a map of roles->members to a list of Binding
-func rolesToMembersBinding(m map[string]map[string]bool) []*cloudresourcemanager.Binding {
-	bindings := make([]*cloudresourcemanager.Binding, 0)
-	for role, members := range m {
-		b := cloudresourcemanager.Binding{
-			Role:    role,
-			Members: make([]string, 0),
-		}
+func rolesToMembersBinding(m map[iamBindingKey]map[string]bool) []*IamBinding {
+	bindings := make([]*IamBinding, 0)
+	for k, members := range m {
+		b := k.binding()
+		b.Members = make([]string, 0)
 		for m, _ := range members {
 			b.Members = append(b.Members, m)
 		}
-		bindings = append(bindings, &b)
+		bindings = append(bindings, b)
 	}
 	return bindings
 }
 
+// mergeAuditConfigs returns the audit configs of a, with the audit configs of
+// the services of b replaced by the ones of b.
+func mergeAuditConfigs(a, b []*cloudresourcemanager.AuditConfig) []*cloudresourcemanager.AuditConfig {
+	for _, ac := range b {
+		a = setAuditConfig(a, ac)
+	}
+	return a
+}
+
+// removeAuditConfigs returns the audit configs of a without the ones of the
+// services of b.
+func removeAuditConfigs(a, b []*cloudresourcemanager.AuditConfig) []*cloudresourcemanager.AuditConfig {
+	configs := make([]*cloudresourcemanager.AuditConfig, 0)
+	for _, ac := range a {
+		if !hasAuditConfig(b, ac.Service) {
+			configs = append(configs, ac)
+		}
+	}
+	return configs
+}
+
+// filterAuditConfigs returns the audit configs of a of the services of b.
+func filterAuditConfigs(a, b []*cloudresourcemanager.AuditConfig) []*cloudresourcemanager.AuditConfig {
+	var configs []*cloudresourcemanager.AuditConfig
+	for _, ac := range a {
+		if hasAuditConfig(b, ac.Service) {
+			configs = append(configs, ac)
+		}
+	}
+	return configs
+}
+
+func hasAuditConfig(configs []*cloudresourcemanager.AuditConfig, service string) bool {
+	for _, ac := range configs {
+		if ac.Service == service {
+			return true
+		}
+	}
+	return false
+}
+
 func jsonPolicyDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
-	var oldPolicy, newPolicy cloudresourcemanager.Policy
+	var oldPolicy, newPolicy IamPolicy
 	if err := json.Unmarshal([]byte(old), &oldPolicy); err != nil {
 		log.Printf("[ERROR] Could not unmarshal old policy %s: %v", old, err)
 		return false
@@ -364,6 +443,9 @@ func jsonPolicyDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
 	if newPolicy.Version != oldPolicy.Version {
 		return false
 	}
+	if !auditConfigsEqual(newPolicy.AuditConfigs, oldPolicy.AuditConfigs) {
+		return false
+	}
 	if len(newPolicy.Bindings) != len(oldPolicy.Bindings) {
 		return false
 	}
@@ -371,7 +453,7 @@ func jsonPolicyDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
 	sort.Sort(sortableBindings(oldPolicy.Bindings))
 	for pos, newBinding := range newPolicy.Bindings {
 		oldBinding := oldPolicy.Bindings[pos]
-		if oldBinding.Role != newBinding.Role {
+		if oldBinding.key() != newBinding.key() {
 			return false
 		}
 		if len(oldBinding.Members) != len(newBinding.Members) {
@@ -389,7 +471,7 @@ func jsonPolicyDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
 	return true
 }
 
-type sortableBindings []*cloudresourcemanager.Binding
+type sortableBindings []*IamBinding
 
 func (b sortableBindings) Len() int {
 	return len(b)
@@ -398,5 +480,15 @@ func (b sortableBindings) Swap(i, j int) {
 	b[i], b[j] = b[j], b[i]
 }
 func (b sortableBindings) Less(i, j int) bool {
-	return b[i].Role < b[j].Role
+	ki, kj := b[i].key(), b[j].key()
+	if ki.Role != kj.Role {
+		return ki.Role < kj.Role
+	}
+	if ki.Title != kj.Title {
+		return ki.Title < kj.Title
+	}
+	if ki.Expression != kj.Expression {
+		return ki.Expression < kj.Expression
+	}
+	return ki.Description < kj.Description
 }
@@ -7,7 +7,6 @@ import (
 	"sort"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/cloudresourcemanager/v1"
@@ -15,13 +14,13 @@ import (
 
 func TestSubtractIamPolicy(t *testing.T) {
 	table := []struct {
-		a      *cloudresourcemanager.Policy
-		b      *cloudresourcemanager.Policy
-		expect cloudresourcemanager.Policy
+		a      *IamPolicy
+		b      *IamPolicy
+		expect IamPolicy
 	}{
 		{
-			a: &cloudresourcemanager.Policy{
-				Bindings: []*cloudresourcemanager.Binding{
+			a: &IamPolicy{
+				Bindings: []*IamBinding{
 					{
 						Role: "a",
 						Members: []string{
@@ -38,8 +37,8 @@ func TestSubtractIamPolicy(t *testing.T) {
 					},
 				},
 			},
-			b: &cloudresourcemanager.Policy{
-				Bindings: []*cloudresourcemanager.Binding{
+			b: &IamPolicy{
+				Bindings: []*IamBinding{
 					{
 						Role: "a",
 						Members: []string{
@@ -56,8 +55,8 @@ func TestSubtractIamPolicy(t *testing.T) {
 					},
 				},
 			},
-			expect: cloudresourcemanager.Policy{
-				Bindings: []*cloudresourcemanager.Binding{
+			expect: IamPolicy{
+				Bindings: []*IamBinding{
 					{
 						Role: "a",
 						Members: []string{
@@ -69,8 +68,8 @@ func TestSubtractIamPolicy(t *testing.T) {
 			},
 		},
 		{
-			a: &cloudresourcemanager.Policy{
-				Bindings: []*cloudresourcemanager.Binding{
+			a: &IamPolicy{
+				Bindings: []*IamBinding{
 					{
 						Role: "a",
 						Members: []string{
@@ -87,8 +86,8 @@ func TestSubtractIamPolicy(t *testing.T) {
 					},
 				},
 			},
-			b: &cloudresourcemanager.Policy{
-				Bindings: []*cloudresourcemanager.Binding{
+			b: &IamPolicy{
+				Bindings: []*IamBinding{
 					{
 						Role: "a",
 						Members: []string{
@@ -105,13 +104,13 @@ func TestSubtractIamPolicy(t *testing.T) {
 					},
 				},
 			},
-			expect: cloudresourcemanager.Policy{
-				Bindings: []*cloudresourcemanager.Binding{},
+			expect: IamPolicy{
+				Bindings: []*IamBinding{},
 			},
 		},
 		{
-			a: &cloudresourcemanager.Policy{
-				Bindings: []*cloudresourcemanager.Binding{
+			a: &IamPolicy{
+				Bindings: []*IamBinding{
 					{
 						Role: "a",
 						Members: []string{
@@ -130,8 +129,8 @@ func TestSubtractIamPolicy(t *testing.T) {
 					},
 				},
 			},
-			b: &cloudresourcemanager.Policy{
-				Bindings: []*cloudresourcemanager.Binding{
+			b: &IamPolicy{
+				Bindings: []*IamBinding{
 					{
 						Role: "a",
 						Members: []string{
@@ -149,8 +148,8 @@ func TestSubtractIamPolicy(t *testing.T) {
 					},
 				},
 			},
-			expect: cloudresourcemanager.Policy{
-				Bindings: []*cloudresourcemanager.Binding{
+			expect: IamPolicy{
+				Bindings: []*IamBinding{
 					{
 						Role: "a",
 						Members: []string{
@@ -161,8 +160,8 @@ func TestSubtractIamPolicy(t *testing.T) {
 			},
 		},
 		{
-			a: &cloudresourcemanager.Policy{
-				Bindings: []*cloudresourcemanager.Binding{
+			a: &IamPolicy{
+				Bindings: []*IamBinding{
 					{
 						Role: "a",
 						Members: []string{
@@ -181,8 +180,8 @@ func TestSubtractIamPolicy(t *testing.T) {
 					},
 				},
 			},
-			b: &cloudresourcemanager.Policy{
-				Bindings: []*cloudresourcemanager.Binding{
+			b: &IamPolicy{
+				Bindings: []*IamBinding{
 					{
 						Role: "a",
 						Members: []string{
@@ -201,8 +200,8 @@ func TestSubtractIamPolicy(t *testing.T) {
 					},
 				},
 			},
-			expect: cloudresourcemanager.Policy{
-				Bindings: []*cloudresourcemanager.Binding{},
+			expect: IamPolicy{
+				Bindings: []*IamBinding{},
 			},
 		},
 	}
@@ -225,7 +224,7 @@ func TestAccProjectIamPolicy_basic(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	pid := "terraform-" + acctest.RandString(10)
+	pid := "terraform-" + randString(t, 10)
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
@@ -289,7 +288,7 @@ func TestAccProjectIamPolicy_expanded(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	pid := "terraform-" + acctest.RandString(10)
+	pid := "terraform-" + randString(t, 10)
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
@@ -316,8 +315,8 @@ func getStatePrimaryResource(s *terraform.State, res, expectedID string) (*terra
 	return resource.Primary, nil
 }
 
-func getGoogleProjectIamPolicyFromResource(resource *terraform.InstanceState) (cloudresourcemanager.Policy, error) {
-	var p cloudresourcemanager.Policy
+func getGoogleProjectIamPolicyFromResource(resource *terraform.InstanceState) (IamPolicy, error) {
+	var p IamPolicy
 	ps, ok := resource.Attributes["policy_data"]
 	if !ok {
 		return p, fmt.Errorf("Resource %q did not have a 'policy_data' attribute. Attributes were %#v", resource.ID, resource.Attributes)
@@ -328,15 +327,15 @@ func getGoogleProjectIamPolicyFromResource(resource *terraform.InstanceState) (c
 	return p, nil
 }
 
-func getGoogleProjectIamPolicyFromState(s *terraform.State, res, expectedID string) (cloudresourcemanager.Policy, error) {
+func getGoogleProjectIamPolicyFromState(s *terraform.State, res, expectedID string) (IamPolicy, error) {
 	project, err := getStatePrimaryResource(s, res, expectedID)
 	if err != nil {
-		return cloudresourcemanager.Policy{}, err
+		return IamPolicy{}, err
 	}
 	return getGoogleProjectIamPolicyFromResource(project)
 }
 
-func compareBindings(a, b []*cloudresourcemanager.Binding) bool {
+func compareBindings(a, b []*IamBinding) bool {
 	a = mergeBindings(a)
 	b = mergeBindings(b)
 	sort.Sort(sortableBindings(a))
@@ -376,7 +375,7 @@ func testAccCheckGoogleProjectIamPolicyIsMerged(projectRes, policyRes, pid strin
 		}
 
 		// Merge the project policy in Terraform state with the policy the project had before the config was applied
-		var expected []*cloudresourcemanager.Binding
+		var expected []*IamBinding
 		expected = append(expected, originalPolicy.Bindings...)
 		expected = append(expected, projectPolicy.Bindings...)
 		expected = mergeBindings(expected)
@@ -398,11 +397,11 @@ func testAccCheckGoogleProjectIamPolicyIsMerged(projectRes, policyRes, pid strin
 
 func TestIamRolesToMembersBinding(t *testing.T) {
 	table := []struct {
-		expect []*cloudresourcemanager.Binding
-		input  map[string]map[string]bool
+		expect []*IamBinding
+		input  map[iamBindingKey]map[string]bool
 	}{
 		{
-			expect: []*cloudresourcemanager.Binding{
+			expect: []*IamBinding{
 				{
 					Role: "role-1",
 					Members: []string{
@@ -411,15 +410,15 @@ func TestIamRolesToMembersBinding(t *testing.T) {
 					},
 				},
 			},
-			input: map[string]map[string]bool{
-				"role-1": map[string]bool{
+			input: map[iamBindingKey]map[string]bool{
+				{Role: "role-1"}: map[string]bool{
 					"member-1": true,
 					"member-2": true,
 				},
 			},
 		},
 		{
-			expect: []*cloudresourcemanager.Binding{
+			expect: []*IamBinding{
 				{
 					Role: "role-1",
 					Members: []string{
@@ -428,22 +427,22 @@ func TestIamRolesToMembersBinding(t *testing.T) {
 					},
 				},
 			},
-			input: map[string]map[string]bool{
-				"role-1": map[string]bool{
+			input: map[iamBindingKey]map[string]bool{
+				{Role: "role-1"}: map[string]bool{
 					"member-1": true,
 					"member-2": true,
 				},
 			},
 		},
 		{
-			expect: []*cloudresourcemanager.Binding{
+			expect: []*IamBinding{
 				{
 					Role:    "role-1",
 					Members: []string{},
 				},
 			},
-			input: map[string]map[string]bool{
-				"role-1": map[string]bool{},
+			input: map[iamBindingKey]map[string]bool{
+				{Role: "role-1"}: map[string]bool{},
 			},
 		},
 	}
@@ -463,11 +462,11 @@ func TestIamRolesToMembersBinding(t *testing.T) {
 }
 func TestIamRolesToMembersMap(t *testing.T) {
 	table := []struct {
-		input  []*cloudresourcemanager.Binding
-		expect map[string]map[string]bool
+		input  []*IamBinding
+		expect map[iamBindingKey]map[string]bool
 	}{
 		{
-			input: []*cloudresourcemanager.Binding{
+			input: []*IamBinding{
 				{
 					Role: "role-1",
 					Members: []string{
@@ -476,15 +475,15 @@ func TestIamRolesToMembersMap(t *testing.T) {
 					},
 				},
 			},
-			expect: map[string]map[string]bool{
-				"role-1": map[string]bool{
+			expect: map[iamBindingKey]map[string]bool{
+				{Role: "role-1"}: map[string]bool{
 					"member-1": true,
 					"member-2": true,
 				},
 			},
 		},
 		{
-			input: []*cloudresourcemanager.Binding{
+			input: []*IamBinding{
 				{
 					Role: "role-1",
 					Members: []string{
@@ -495,21 +494,45 @@ func TestIamRolesToMembersMap(t *testing.T) {
 					},
 				},
 			},
-			expect: map[string]map[string]bool{
-				"role-1": map[string]bool{
+			expect: map[iamBindingKey]map[string]bool{
+				{Role: "role-1"}: map[string]bool{
 					"member-1": true,
 					"member-2": true,
 				},
 			},
 		},
 		{
-			input: []*cloudresourcemanager.Binding{
+			input: []*IamBinding{
 				{
 					Role: "role-1",
 				},
 			},
-			expect: map[string]map[string]bool{
-				"role-1": map[string]bool{},
+			expect: map[iamBindingKey]map[string]bool{
+				{Role: "role-1"}: map[string]bool{},
+			},
+		},
+		{
+			input: []*IamBinding{
+				{
+					Role:    "role-1",
+					Members: []string{"member-1"},
+				},
+				{
+					Role:    "role-1",
+					Members: []string{"member-2"},
+					Condition: &IamCondition{
+						Title:      "expires",
+						Expression: "request.time < timestamp(\"2020-01-01T00:00:00Z\")",
+					},
+				},
+			},
+			expect: map[iamBindingKey]map[string]bool{
+				{Role: "role-1"}: map[string]bool{
+					"member-1": true,
+				},
+				{Role: "role-1", Title: "expires", Expression: "request.time < timestamp(\"2020-01-01T00:00:00Z\")"}: map[string]bool{
+					"member-2": true,
+				},
 			},
 		},
 	}
@@ -524,11 +547,11 @@ func TestIamRolesToMembersMap(t *testing.T) {
 
 func TestIamMergeBindings(t *testing.T) {
 	table := []struct {
-		input  []*cloudresourcemanager.Binding
-		expect []cloudresourcemanager.Binding
+		input  []*IamBinding
+		expect []IamBinding
 	}{
 		{
-			input: []*cloudresourcemanager.Binding{
+			input: []*IamBinding{
 				{
 					Role: "role-1",
 					Members: []string{
@@ -543,7 +566,7 @@ func TestIamMergeBindings(t *testing.T) {
 					},
 				},
 			},
-			expect: []cloudresourcemanager.Binding{
+			expect: []IamBinding{
 				{
 					Role: "role-1",
 					Members: []string{
@@ -555,7 +578,7 @@ func TestIamMergeBindings(t *testing.T) {
 			},
 		},
 		{
-			input: []*cloudresourcemanager.Binding{
+			input: []*IamBinding{
 				{
 					Role: "role-1",
 					Members: []string{
@@ -596,7 +619,7 @@ func TestIamMergeBindings(t *testing.T) {
 				},
 				{Role: "empty-role", Members: []string{}},
 			},
-			expect: []cloudresourcemanager.Binding{
+			expect: []IamBinding{
 				{
 					Role: "role-1",
 					Members: []string{
@@ -622,6 +645,48 @@ func TestIamMergeBindings(t *testing.T) {
 				},
 			},
 		},
+		{
+			input: []*IamBinding{
+				{
+					Role:    "role-1",
+					Members: []string{"member-1"},
+				},
+				{
+					Role:      "role-1",
+					Members:   []string{"member-2"},
+					Condition: &IamCondition{Title: "expires", Expression: "request.time < timestamp(\"2020-01-01T00:00:00Z\")"},
+				},
+				{
+					Role:      "role-1",
+					Members:   []string{"member-3"},
+					Condition: &IamCondition{Title: "expires", Expression: "request.time < timestamp(\"2020-01-01T00:00:00Z\")"},
+				},
+				{
+					Role:      "role-1",
+					Members:   []string{"member-4"},
+					Condition: &IamCondition{Title: "weekdays", Expression: "request.time.getDayOfWeek() < 5"},
+				},
+			},
+			expect: []IamBinding{
+				{
+					Role:    "role-1",
+					Members: []string{"member-1"},
+				},
+				{
+					Role: "role-1",
+					Members: []string{
+						"member-2",
+						"member-3",
+					},
+					Condition: &IamCondition{Title: "expires", Expression: "request.time < timestamp(\"2020-01-01T00:00:00Z\")"},
+				},
+				{
+					Role:      "role-1",
+					Members:   []string{"member-4"},
+					Condition: &IamCondition{Title: "weekdays", Expression: "request.time.getDayOfWeek() < 5"},
+				},
+			},
+		},
 	}
 
 	for _, test := range table {
@@ -637,8 +702,8 @@ func TestIamMergeBindings(t *testing.T) {
 	}
 }
 
-func derefBindings(b []*cloudresourcemanager.Binding) []cloudresourcemanager.Binding {
-	db := make([]cloudresourcemanager.Binding, len(b))
+func derefBindings(b []*IamBinding) []IamBinding {
+	db := make([]IamBinding, len(b))
 
 	for i, v := range b {
 		db[i] = *v
@@ -770,3 +835,71 @@ data "google_iam_policy" "expanded" {
     }
 }`, pid, name, org)
 }
+
+func TestIamPolicyChangedBy(t *testing.T) {
+	policy := &IamPolicy{
+		Bindings: []*IamBinding{
+			{Role: "roles/owner", Members: []string{"user:a@example.com"}},
+			{Role: "roles/viewer", Members: []string{"user:b@example.com", "user:c@example.com"}},
+		},
+	}
+	addViewer := func(member string) iamPolicyModifyFunc {
+		return func(p *IamPolicy) error {
+			p.Bindings = mergeBindings(append(p.Bindings, &IamBinding{Role: "roles/viewer", Members: []string{member}}))
+			return nil
+		}
+	}
+
+	// Merging the bindings reorders them, which isn't a change.
+	changed, err := iamPolicyChangedBy(policy, addViewer("user:c@example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed {
+		t.Errorf("Expected adding an existing member not to change the policy")
+	}
+
+	changed, err = iamPolicyChangedBy(policy, addViewer("user:d@example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed {
+		t.Errorf("Expected adding a new member to change the policy")
+	}
+	if len(policy.Bindings[1].Members) != 2 {
+		t.Errorf("Expected the policy to be left as is, got %+v", policy.Bindings[1])
+	}
+}
+
+func TestIamMergeAuditConfigs(t *testing.T) {
+	current := []*cloudresourcemanager.AuditConfig{
+		{
+			Service:         "allServices",
+			AuditLogConfigs: []*cloudresourcemanager.AuditLogConfig{{LogType: "ADMIN_READ"}},
+		},
+		{
+			Service:         "storage.googleapis.com",
+			AuditLogConfigs: []*cloudresourcemanager.AuditLogConfig{{LogType: "DATA_READ"}},
+		},
+	}
+	policy := []*cloudresourcemanager.AuditConfig{
+		{
+			Service: "storage.googleapis.com",
+			AuditLogConfigs: []*cloudresourcemanager.AuditLogConfig{
+				{LogType: "DATA_WRITE", ExemptedMembers: []string{"user:jane@example.com"}},
+			},
+		},
+	}
+
+	merged := mergeAuditConfigs(current, policy)
+	expect := []*cloudresourcemanager.AuditConfig{current[0], policy[0]}
+	if !auditConfigsEqual(merged, expect) {
+		t.Errorf("got %+v, expected %+v", merged, expect)
+	}
+	if filtered := filterAuditConfigs(merged, policy); !auditConfigsEqual(filtered, policy) {
+		t.Errorf("got %+v, expected %+v", filtered, policy)
+	}
+	if removed := removeAuditConfigs(merged, policy); !auditConfigsEqual(removed, current[:1]) {
+		t.Errorf("got %+v, expected %+v", removed, current[:1])
+	}
+}
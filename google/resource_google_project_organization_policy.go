@@ -13,6 +13,9 @@ func resourceGoogleProjectOrganizationPolicy() *schema.Resource {
 		Read:   resourceGoogleProjectOrganizationPolicyRead,
 		Update: resourceGoogleProjectOrganizationPolicyUpdate,
 		Delete: resourceGoogleProjectOrganizationPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGoogleProjectOrganizationPolicyImportState,
+		},
 
 		Schema: mergeSchemas(
 			schemaOrganizationPolicy,
@@ -83,6 +86,23 @@ func resourceGoogleProjectOrganizationPolicyDelete(d *schema.ResourceData, meta
 	return nil
 }
 
+func resourceGoogleProjectOrganizationPolicyImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	// Project ids may contain a colon, e.g. `example.com:my-project`.
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/constraints/(?P<constraint>[^/]+)", "(?P<project>[^/]+):(?:constraints/)?(?P<constraint>[^/:]+)", "(?P<project>[^/]+)/(?P<constraint>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{project}}:{{constraint}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func setProjectOrganizationPolicy(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	project := prefixedProject(d.Get("project").(string))
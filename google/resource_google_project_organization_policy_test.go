@@ -52,6 +52,11 @@ func TestAccProjectOrganizationPolicy_boolean(t *testing.T) {
 				Config: testAccProjectOrganizationPolicy_boolean(projectId, true),
 				Check:  testAccCheckGoogleProjectOrganizationBooleanPolicy("bool", true),
 			},
+			resource.TestStep{
+				ResourceName:      "google_project_organization_policy.bool",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -8,7 +8,6 @@ import (
 	"sort"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -18,7 +17,7 @@ func TestAccProjectServices_basic(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	pid := "terraform-" + acctest.RandString(10)
+	pid := "terraform-" + randString(t, 10)
 	services1 := []string{"iam.googleapis.com", "cloudresourcemanager.googleapis.com"}
 	services2 := []string{"cloudresourcemanager.googleapis.com"}
 	oobService := "iam.googleapis.com"
@@ -68,7 +67,7 @@ func TestAccProjectServices_authoritative(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	pid := "terraform-" + acctest.RandString(10)
+	pid := "terraform-" + randString(t, 10)
 	services := []string{"cloudresourcemanager.googleapis.com"}
 	oobService := "iam.googleapis.com"
 	resource.Test(t, resource.TestCase{
@@ -105,7 +104,7 @@ func TestAccProjectServices_authoritative2(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	pid := "terraform-" + acctest.RandString(10)
+	pid := "terraform-" + randString(t, 10)
 	oobServices := []string{"iam.googleapis.com", "cloudresourcemanager.googleapis.com"}
 	services := []string{"iam.googleapis.com"}
 
@@ -146,7 +145,7 @@ func TestAccProjectServices_ignoreUnenablableServices(t *testing.T) {
 
 	org := getTestOrgFromEnv(t)
 	billingId := getTestBillingAccountFromEnv(t)
-	pid := "terraform-" + acctest.RandString(10)
+	pid := "terraform-" + randString(t, 10)
 	services := []string{
 		"dataproc.googleapis.com",
 		// The following services are enabled as a side-effect of dataproc's enablement
@@ -183,7 +182,7 @@ func TestAccProjectServices_manyServices(t *testing.T) {
 
 	org := getTestOrgFromEnv(t)
 	billingId := getTestBillingAccountFromEnv(t)
-	pid := "terraform-" + acctest.RandString(10)
+	pid := "terraform-" + randString(t, 10)
 	services := []string{
 		"bigquery-json.googleapis.com",
 		"cloudbuild.googleapis.com",
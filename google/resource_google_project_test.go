@@ -1,7 +1,9 @@
 package google
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"os"
 	"reflect"
 	"strconv"
@@ -9,15 +11,47 @@ import (
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/cloudresourcemanager/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_project", testSweepProjects)
+}
+
+// Deleted projects are only shut down for 30 days before being removed, so
+// they still count towards the project quota of the credentials in the
+// meantime.
+func testSweepProjects(region string) error {
+	config, err := sweeperConfig(region)
+	if err != nil {
+		return err
+	}
+
+	filter := fmt.Sprintf("id:%s* lifecycleState:ACTIVE", sweeperTestPrefix)
+	err = config.clientResourceManager.Projects.List().Filter(filter).Pages(context.Background(), func(res *cloudresourcemanager.ListProjectsResponse) error {
+		for _, project := range res.Projects {
+			// Never delete the project the tests run in.
+			if !isSweepableTestResource(project.ProjectId) || project.ProjectId == config.Project {
+				continue
+			}
+			log.Printf("[INFO] Sweeping project %s", project.ProjectId)
+			if _, err := config.clientResourceManager.Projects.Delete(project.ProjectId).Do(); err != nil {
+				log.Printf("[INFO] Error deleting project %s: %s", project.ProjectId, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error listing projects: %s", err)
+	}
+	return nil
+}
+
 var (
 	pname          = "Terraform Acceptance Tests"
-	originalPolicy *cloudresourcemanager.Policy
+	originalPolicy *IamPolicy
 )
 
 // Test that a Project resource can be created without an organization
@@ -29,7 +63,7 @@ func TestAccProject_createWithoutOrg(t *testing.T) {
 		t.Skip("Service accounts cannot create projects without a parent. Requires user credentials.")
 	}
 
-	pid := "terraform-" + acctest.RandString(10)
+	pid := "tf-test-" + randString(t, 10)
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
@@ -51,7 +85,7 @@ func TestAccProject_create(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	pid := "terraform-" + acctest.RandString(10)
+	pid := "tf-test-" + randString(t, 10)
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
@@ -75,7 +109,7 @@ func TestAccProject_billing(t *testing.T) {
 	skipIfEnvNotSet(t, "GOOGLE_BILLING_ACCOUNT_2")
 	billingId2 := os.Getenv("GOOGLE_BILLING_ACCOUNT_2")
 	billingId := getTestBillingAccountFromEnv(t)
-	pid := "terraform-" + acctest.RandString(10)
+	pid := "tf-test-" + randString(t, 10)
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
@@ -116,7 +150,7 @@ func TestAccProject_labels(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	pid := "terraform-" + acctest.RandString(10)
+	pid := "tf-test-" + randString(t, 10)
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
@@ -157,7 +191,7 @@ func TestAccProject_deleteDefaultNetwork(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	pid := "terraform-" + acctest.RandString(10)
+	pid := "tf-test-" + randString(t, 10)
 	billingId := getTestBillingAccountFromEnv(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -174,8 +208,8 @@ func TestAccProject_parentFolder(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	pid := "terraform-" + acctest.RandString(10)
-	folderDisplayName := "tf-test-" + acctest.RandString(10)
+	pid := "tf-test-" + randString(t, 10)
+	folderDisplayName := "tf-test-" + randString(t, 10)
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
@@ -191,7 +225,7 @@ func TestAccProject_appEngineBasic(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	pid := acctest.RandomWithPrefix("tf-test")
+	pid := randomWithPrefix(t, "tf-test")
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
@@ -220,7 +254,7 @@ func TestAccProject_appEngineFeatureSettings(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	pid := acctest.RandomWithPrefix("tf-test")
+	pid := randomWithPrefix(t, "tf-test")
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
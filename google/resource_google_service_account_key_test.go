@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -14,7 +13,7 @@ func TestAccServiceAccountKey_basic(t *testing.T) {
 	t.Parallel()
 
 	resourceName := "google_service_account_key.acceptance"
-	accountID := "a" + acctest.RandString(10)
+	accountID := "tf-test-" + randString(t, 10)
 	displayName := "Terraform Test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -38,7 +37,7 @@ func TestAccServiceAccountKey_fromEmail(t *testing.T) {
 	t.Parallel()
 
 	resourceName := "google_service_account_key.acceptance"
-	accountID := "a" + acctest.RandString(10)
+	accountID := "tf-test-" + randString(t, 10)
 	displayName := "Terraform Test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -61,7 +60,7 @@ func TestAccServiceAccountKey_fromEmail(t *testing.T) {
 func TestAccServiceAccountKey_pgp(t *testing.T) {
 	t.Parallel()
 	resourceName := "google_service_account_key.acceptance"
-	accountID := "a" + acctest.RandString(10)
+	accountID := "tf-test-" + randString(t, 10)
 	displayName := "Terraform Test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
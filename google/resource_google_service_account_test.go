@@ -1,19 +1,51 @@
 package google
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"google.golang.org/api/iam/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_service_account", testSweepServiceAccounts)
+}
+
+func testSweepServiceAccounts(region string) error {
+	config, err := sweeperConfig(region)
+	if err != nil {
+		return err
+	}
+
+	err = config.clientIAM.Projects.ServiceAccounts.List("projects/"+config.Project).Pages(context.Background(), func(res *iam.ListServiceAccountsResponse) error {
+		for _, sa := range res.Accounts {
+			// The account ID is the part of the email before the @.
+			if !isSweepableTestResource(strings.Split(sa.Email, "@")[0]) {
+				continue
+			}
+			log.Printf("[INFO] Sweeping service account %s", sa.Email)
+			if _, err := config.clientIAM.Projects.ServiceAccounts.Delete(sa.Name).Do(); err != nil {
+				log.Printf("[INFO] Error deleting service account %s: %s", sa.Email, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error listing service accounts: %s", err)
+	}
+	return nil
+}
+
 // Test that a service account resource can be created, updated, and destroyed
 func TestAccServiceAccount_basic(t *testing.T) {
 	t.Parallel()
 
-	accountId := "a" + acctest.RandString(10)
+	accountId := "tf-test-" + randString(t, 10)
 	uniqueId := ""
 	displayName := "Terraform Test"
 	displayName2 := "Terraform Test Update"
@@ -62,7 +94,7 @@ func TestAccServiceAccount_basic(t *testing.T) {
 func TestAccServiceAccount_createPolicy(t *testing.T) {
 	t.Parallel()
 
-	accountId := "a" + acctest.RandString(10)
+	accountId := "tf-test-" + randString(t, 10)
 	displayName := "Terraform Test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
-	"google.golang.org/api/cloudresourcemanager/v1"
 )
 
 var iamBindingSchema = map[string]*schema.Schema{
@@ -23,6 +22,7 @@ var iamBindingSchema = map[string]*schema.Schema{
 			Type: schema.TypeString,
 		},
 	},
+	"condition": iamConditionSchema,
 	"etag": {
 		Type:     schema.TypeString,
 		Computed: true,
@@ -56,7 +56,7 @@ func resourceIamBindingCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.C
 		}
 
 		p := getResourceIamBinding(d)
-		err = iamPolicyReadModifyWrite(updater, func(ep *cloudresourcemanager.Policy) error {
+		err = iamPolicyReadModifyWrite(config, updater, func(ep *IamPolicy) error {
 			// Creating a binding does not remove existing members if they are not in the provided members list.
 			// This prevents removing existing permission without the user's knowledge.
 			// Instead, a diff is shown in that case after creation. Subsequent calls to update will remove any
@@ -67,7 +67,7 @@ func resourceIamBindingCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.C
 		if err != nil {
 			return err
 		}
-		d.SetId(updater.GetResourceId() + "/" + p.Role)
+		d.SetId(updater.GetResourceId() + "/" + p.Role + iamConditionIdSuffix(p.Condition))
 		return resourceIamBindingRead(newUpdaterFunc)(d, meta)
 	}
 }
@@ -93,9 +93,9 @@ func resourceIamBindingRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.Rea
 		}
 		log.Printf("[DEBUG]: Retrieved policy for %s: %+v", updater.DescribeResource(), p)
 
-		var binding *cloudresourcemanager.Binding
+		var binding *IamBinding
 		for _, b := range p.Bindings {
-			if b.Role != eBinding.Role {
+			if !b.matches(eBinding) {
 				continue
 			}
 			binding = b
@@ -109,6 +109,7 @@ func resourceIamBindingRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.Rea
 		d.Set("etag", p.Etag)
 		d.Set("members", binding.Members)
 		d.Set("role", binding.Role)
+		d.Set("condition", flattenIamCondition(binding.Condition))
 		return nil
 	}
 }
@@ -119,16 +120,23 @@ func iamBindingImport(resourceIdParser resourceIdParserFunc) schema.StateFunc {
 			return nil, errors.New("Import not supported for this IAM resource.")
 		}
 		config := m.(*Config)
-		s := strings.Split(d.Id(), " ")
-		if len(s) != 2 {
+		// The title of the condition of a conditional binding may contain spaces.
+		s := strings.SplitN(d.Id(), " ", 3)
+		if len(s) < 2 {
 			d.SetId("")
-			return nil, fmt.Errorf("Wrong number of parts to Binding id %s; expected 'resource_name role'.", s)
+			return nil, fmt.Errorf("Wrong number of parts to Binding id %s; expected 'resource_name role [condition_title]'.", s)
 		}
 		id, role := s[0], s[1]
 
 		// Set the ID only to the first part so all IAM types can share the same resourceIdParserFunc.
 		d.SetId(id)
 		d.Set("role", role)
+		var condition *IamCondition
+		if len(s) == 3 {
+			// The rest of the condition is read from the binding with this title.
+			condition = &IamCondition{Title: s[2]}
+			d.Set("condition", flattenIamCondition(condition))
+		}
 		err := resourceIdParser(d, config)
 		if err != nil {
 			return nil, err
@@ -136,7 +144,7 @@ func iamBindingImport(resourceIdParser resourceIdParserFunc) schema.StateFunc {
 
 		// Set the ID again so that the ID matches the ID it would have if it had been created via TF.
 		// Use the current ID in case it changed in the resourceIdParserFunc.
-		d.SetId(d.Id() + "/" + role)
+		d.SetId(d.Id() + "/" + role + iamConditionIdSuffix(condition))
 		// It is possible to return multiple bindings, since we can learn about all the bindings
 		// for this resource here.  Unfortunately, `terraform import` has some messy behavior here -
 		// there's no way to know at this point which resource is being imported, so it's not possible
@@ -160,10 +168,10 @@ func resourceIamBindingUpdate(newUpdaterFunc newResourceIamUpdaterFunc) schema.U
 		}
 
 		binding := getResourceIamBinding(d)
-		err = iamPolicyReadModifyWrite(updater, func(p *cloudresourcemanager.Policy) error {
+		err = iamPolicyReadModifyWrite(config, updater, func(p *IamPolicy) error {
 			var found bool
 			for pos, b := range p.Bindings {
-				if b.Role != binding.Role {
+				if b.key() != binding.key() {
 					continue
 				}
 				found = true
@@ -192,10 +200,10 @@ func resourceIamBindingDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.D
 		}
 
 		binding := getResourceIamBinding(d)
-		err = iamPolicyReadModifyWrite(updater, func(p *cloudresourcemanager.Policy) error {
+		err = iamPolicyReadModifyWrite(config, updater, func(p *IamPolicy) error {
 			toRemove := -1
 			for pos, b := range p.Bindings {
-				if b.Role != binding.Role {
+				if b.key() != binding.key() {
 					continue
 				}
 				toRemove = pos
@@ -221,10 +229,11 @@ func resourceIamBindingDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.D
 	}
 }
 
-func getResourceIamBinding(d *schema.ResourceData) *cloudresourcemanager.Binding {
+func getResourceIamBinding(d *schema.ResourceData) *IamBinding {
 	members := d.Get("members").(*schema.Set).List()
-	return &cloudresourcemanager.Binding{
-		Members: convertStringArr(members),
-		Role:    d.Get("role").(string),
+	return &IamBinding{
+		Members:   convertStringArr(members),
+		Role:      d.Get("role").(string),
+		Condition: expandIamCondition(d.Get("condition")),
 	}
 }
@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
-	"google.golang.org/api/cloudresourcemanager/v1"
 )
 
 var IamMemberBaseSchema = map[string]*schema.Schema{
@@ -21,6 +20,7 @@ var IamMemberBaseSchema = map[string]*schema.Schema{
 		Required: true,
 		ForceNew: true,
 	},
+	"condition": iamConditionSchema,
 	"etag": {
 		Type:     schema.TypeString,
 		Computed: true,
@@ -33,10 +33,11 @@ func iamMemberImport(resourceIdParser resourceIdParserFunc) schema.StateFunc {
 			return nil, errors.New("Import not supported for this IAM resource.")
 		}
 		config := m.(*Config)
-		s := strings.Split(d.Id(), " ")
-		if len(s) != 3 {
+		// The title of the condition of a conditional binding may contain spaces.
+		s := strings.SplitN(d.Id(), " ", 4)
+		if len(s) < 3 {
 			d.SetId("")
-			return nil, fmt.Errorf("Wrong number of parts to Member id %s; expected 'resource_name role username'.", s)
+			return nil, fmt.Errorf("Wrong number of parts to Member id %s; expected 'resource_name role username [condition_title]'.", s)
 		}
 		id, role, member := s[0], s[1], s[2]
 
@@ -44,6 +45,12 @@ func iamMemberImport(resourceIdParser resourceIdParserFunc) schema.StateFunc {
 		d.SetId(id)
 		d.Set("role", role)
 		d.Set("member", member)
+		var condition *IamCondition
+		if len(s) == 4 {
+			// The rest of the condition is read from the binding with this title.
+			condition = &IamCondition{Title: s[3]}
+			d.Set("condition", flattenIamCondition(condition))
+		}
 		err := resourceIdParser(d, config)
 		if err != nil {
 			return nil, err
@@ -51,7 +58,7 @@ func iamMemberImport(resourceIdParser resourceIdParserFunc) schema.StateFunc {
 
 		// Set the ID again so that the ID matches the ID it would have if it had been created via TF.
 		// Use the current ID in case it changed in the resourceIdParserFunc.
-		d.SetId(d.Id() + "/" + role + "/" + member)
+		d.SetId(d.Id() + "/" + role + "/" + member + iamConditionIdSuffix(condition))
 		return []*schema.ResourceData{d}, nil
 	}
 }
@@ -74,10 +81,11 @@ func ResourceIamMemberWithImport(parentSpecificSchema map[string]*schema.Schema,
 	return r
 }
 
-func getResourceIamMember(d *schema.ResourceData) *cloudresourcemanager.Binding {
-	return &cloudresourcemanager.Binding{
-		Members: []string{d.Get("member").(string)},
-		Role:    d.Get("role").(string),
+func getResourceIamMember(d *schema.ResourceData) *IamBinding {
+	return &IamBinding{
+		Members:   []string{d.Get("member").(string)},
+		Role:      d.Get("role").(string),
+		Condition: expandIamCondition(d.Get("condition")),
 	}
 }
 
@@ -90,7 +98,7 @@ func resourceIamMemberCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.Cr
 		}
 
 		p := getResourceIamMember(d)
-		err = iamPolicyReadModifyWrite(updater, func(ep *cloudresourcemanager.Policy) error {
+		err = iamPolicyReadModifyWrite(config, updater, func(ep *IamPolicy) error {
 			// Merge the bindings together
 			ep.Bindings = mergeBindings(append(ep.Bindings, p))
 			return nil
@@ -98,7 +106,7 @@ func resourceIamMemberCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.Cr
 		if err != nil {
 			return err
 		}
-		d.SetId(updater.GetResourceId() + "/" + p.Role + "/" + p.Members[0])
+		d.SetId(updater.GetResourceId() + "/" + p.Role + "/" + p.Members[0] + iamConditionIdSuffix(p.Condition))
 		return resourceIamMemberRead(newUpdaterFunc)(d, meta)
 	}
 }
@@ -123,9 +131,9 @@ func resourceIamMemberRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.Read
 		}
 		log.Printf("[DEBUG]: Retrieved policy for %s: %+v\n", updater.DescribeResource(), p)
 
-		var binding *cloudresourcemanager.Binding
+		var binding *IamBinding
 		for _, b := range p.Bindings {
-			if b.Role != eMember.Role {
+			if !b.matches(eMember) {
 				continue
 			}
 			binding = b
@@ -150,6 +158,7 @@ func resourceIamMemberRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.Read
 		d.Set("etag", p.Etag)
 		d.Set("member", member)
 		d.Set("role", binding.Role)
+		d.Set("condition", flattenIamCondition(binding.Condition))
 		return nil
 	}
 }
@@ -163,10 +172,10 @@ func resourceIamMemberDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.De
 		}
 
 		member := getResourceIamMember(d)
-		err = iamPolicyReadModifyWrite(updater, func(p *cloudresourcemanager.Policy) error {
+		err = iamPolicyReadModifyWrite(config, updater, func(p *IamPolicy) error {
 			bindingToRemove := -1
 			for pos, b := range p.Bindings {
-				if b.Role != member.Role {
+				if b.key() != member.key() {
 					continue
 				}
 				bindingToRemove = pos
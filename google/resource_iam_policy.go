@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"google.golang.org/api/cloudresourcemanager/v1"
 	"log"
 )
 
@@ -64,7 +63,7 @@ func ResourceIamPolicyCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.Cr
 			return err
 		}
 
-		if err := setIamPolicyData(d, updater); err != nil {
+		if err := setIamPolicyData(d, config, updater); err != nil {
 			return err
 		}
 
@@ -90,6 +89,13 @@ func ResourceIamPolicyRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.Read
 			return err
 		}
 
+		// Only the audit configs of the services in policy_data are managed.
+		configured, err := unmarshalIamPolicy(d.Get("policy_data").(string))
+		if err != nil {
+			configured = &IamPolicy{}
+		}
+		policy.AuditConfigs = filterAuditConfigs(policy.AuditConfigs, configured.AuditConfigs)
+
 		d.Set("etag", policy.Etag)
 		d.Set("policy_data", marshalIamPolicy(policy))
 
@@ -105,8 +111,10 @@ func ResourceIamPolicyUpdate(newUpdaterFunc newResourceIamUpdaterFunc) schema.Up
 			return err
 		}
 
-		if d.HasChange("policy_data") {
-			if err := setIamPolicyData(d, updater); err != nil {
+		// Changing manage_legacy_roles of google_storage_bucket_iam_policy
+		// changes the policy set.
+		if d.HasChange("policy_data") || d.HasChange("manage_legacy_roles") {
+			if err := setIamPolicyData(d, config, updater); err != nil {
 				return err
 			}
 		}
@@ -124,7 +132,14 @@ func ResourceIamPolicyDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.De
 		}
 
 		// Set an empty policy to delete the attached policy.
-		err = updater.SetResourceIamPolicy(&cloudresourcemanager.Policy{})
+		policy := &IamPolicy{}
+		if err := mergeIamPolicyAuditConfigs(d, updater, policy); err != nil {
+			return err
+		}
+		if err := checkIamPolicyLockout(config, updater, policy, iamAllowLockout(d)); err != nil {
+			return err
+		}
+		err = updater.SetResourceIamPolicy(policy)
 		if err != nil {
 			return err
 		}
@@ -133,11 +148,18 @@ func ResourceIamPolicyDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.De
 	}
 }
 
-func setIamPolicyData(d *schema.ResourceData, updater ResourceIamUpdater) error {
+func setIamPolicyData(d *schema.ResourceData, config *Config, updater ResourceIamUpdater) error {
 	policy, err := unmarshalIamPolicy(d.Get("policy_data").(string))
 	if err != nil {
 		return fmt.Errorf("'policy_data' is not valid for %s: %s", updater.DescribeResource(), err)
 	}
+	if err := mergeIamPolicyAuditConfigs(d, updater, policy); err != nil {
+		return err
+	}
+
+	if err := checkIamPolicyLockout(config, updater, policy, iamAllowLockout(d)); err != nil {
+		return err
+	}
 
 	err = updater.SetResourceIamPolicy(policy)
 	if err != nil {
@@ -147,15 +169,42 @@ func setIamPolicyData(d *schema.ResourceData, updater ResourceIamUpdater) error
 	return nil
 }
 
-func marshalIamPolicy(policy *cloudresourcemanager.Policy) string {
-	pdBytes, _ := json.Marshal(&cloudresourcemanager.Policy{
-		Bindings: policy.Bindings,
+// mergeIamPolicyAuditConfigs adds to policy the current audit configs of the
+// resource of updater for the services which policy_data doesn't configure,
+// and didn't before this change, so that like google_project_iam_policy the
+// policy resources leave the audit logging of other services alone.
+func mergeIamPolicyAuditConfigs(d *schema.ResourceData, updater ResourceIamUpdater, policy *IamPolicy) error {
+	current, err := updater.GetResourceIamPolicy()
+	if err != nil {
+		return err
+	}
+
+	kept := current.AuditConfigs
+	old, _ := d.GetChange("policy_data")
+	if previous, err := unmarshalIamPolicy(old.(string)); err == nil {
+		kept = removeAuditConfigs(kept, previous.AuditConfigs)
+	}
+	policy.AuditConfigs = mergeAuditConfigs(kept, policy.AuditConfigs)
+	return nil
+}
+
+// iamAllowLockout returns whether the policy resource allows its caller to
+// lock itself out. Only the resources with IamLockoutSchema have the argument.
+func iamAllowLockout(d *schema.ResourceData) bool {
+	allow, _ := d.Get("allow_lockout").(bool)
+	return allow
+}
+
+func marshalIamPolicy(policy *IamPolicy) string {
+	pdBytes, _ := json.Marshal(&IamPolicy{
+		AuditConfigs: policy.AuditConfigs,
+		Bindings:     policy.Bindings,
 	})
 	return string(pdBytes)
 }
 
-func unmarshalIamPolicy(policyData string) (*cloudresourcemanager.Policy, error) {
-	policy := &cloudresourcemanager.Policy{}
+func unmarshalIamPolicy(policyData string) (*IamPolicy, error) {
+	policy := &IamPolicy{}
 	if err := json.Unmarshal([]byte(policyData), policy); err != nil {
 		return nil, fmt.Errorf("Could not unmarshal policy data %s:\n%s", policyData, err)
 	}
@@ -0,0 +1,88 @@
+package google
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+// memoryIamUpdater keeps the IAM policy of a resource in memory.
+type memoryIamUpdater struct {
+	ResourceIamUpdater
+	policy *IamPolicy
+}
+
+func (u *memoryIamUpdater) GetResourceIamPolicy() (*IamPolicy, error) {
+	return copyIamPolicy(u.policy)
+}
+
+func (u *memoryIamUpdater) SetResourceIamPolicy(policy *IamPolicy) error {
+	u.policy = policy
+	return nil
+}
+
+func (u *memoryIamUpdater) GetResourceId() string {
+	return "memory"
+}
+
+func (u *memoryIamUpdater) DescribeResource() string {
+	return "memory"
+}
+
+func TestResourceIamPolicy_auditConfigs(t *testing.T) {
+	t.Parallel()
+
+	unmanaged := &cloudresourcemanager.AuditConfig{
+		Service:         "allServices",
+		AuditLogConfigs: []*cloudresourcemanager.AuditLogConfig{{LogType: "ADMIN_READ"}},
+	}
+	cases := map[string]struct {
+		policyData string
+		managed    bool
+	}{
+		"without audit configs": {
+			policyData: `{"bindings":[{"role":"roles/viewer","members":["user:a@example.com"]}]}`,
+		},
+		"with audit configs": {
+			policyData: `{"auditConfigs":[{"service":"storage.googleapis.com","auditLogConfigs":[{"logType":"DATA_READ"}]}]}`,
+			managed:    true,
+		},
+	}
+	for name, c := range cases {
+		updater := &memoryIamUpdater{policy: &IamPolicy{AuditConfigs: []*cloudresourcemanager.AuditConfig{unmanaged}}}
+		r := ResourceIamPolicy(map[string]*schema.Schema{}, func(*schema.ResourceData, *Config) (ResourceIamUpdater, error) {
+			return updater, nil
+		})
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+			"policy_data": c.policyData,
+		})
+
+		if err := r.Create(d, &Config{}); err != nil {
+			t.Fatalf("%s: error creating policy: %s", name, err)
+		}
+		services := make(map[string]bool)
+		for _, ac := range updater.policy.AuditConfigs {
+			services[ac.Service] = true
+		}
+		if !services["allServices"] || services["storage.googleapis.com"] != c.managed {
+			t.Errorf("%s: expected the audit configs of the policy to be kept, got services %v", name, services)
+		}
+		if strings.Contains(d.Get("policy_data").(string), "allServices") {
+			t.Errorf("%s: expected policy_data to only have the audit configs it sets, got %s", name, d.Get("policy_data"))
+		}
+		if c.managed && !strings.Contains(d.Get("policy_data").(string), "storage.googleapis.com") {
+			t.Errorf("%s: expected policy_data to have the audit configs it sets, got %s", name, d.Get("policy_data"))
+		}
+
+		// Resources are deleted from their state.
+		d = r.Data(d.State())
+		if err := r.Delete(d, &Config{}); err != nil {
+			t.Fatalf("%s: error deleting policy: %s", name, err)
+		}
+		if len(updater.policy.AuditConfigs) != 1 || updater.policy.AuditConfigs[0].Service != "allServices" {
+			t.Errorf("%s: expected only the audit configs set outside of the policy to be left, got %v", name, updater.policy.AuditConfigs)
+		}
+	}
+}
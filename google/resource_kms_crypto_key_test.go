@@ -1,16 +1,69 @@
 package google
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"testing"
 	"time"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"google.golang.org/api/cloudkms/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_kms_crypto_key_version", testSweepKmsCryptoKeyVersions)
+}
+
+// Key rings and crypto keys can't be deleted, so the versions of the keys of
+// test key rings are destroyed instead, like when the key is deleted.
+func testSweepKmsCryptoKeyVersions(region string) error {
+	config, err := sweeperConfig(region)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	keyRings := config.clientKms.Projects.Locations.KeyRings
+	parent := fmt.Sprintf("projects/%s/locations/%s", config.Project, region)
+	err = keyRings.List(parent).Pages(ctx, func(res *cloudkms.ListKeyRingsResponse) error {
+		for _, keyRing := range res.KeyRings {
+			if !isSweepableTestResource(GetResourceNameFromSelfLink(keyRing.Name)) {
+				continue
+			}
+			err := keyRings.CryptoKeys.List(keyRing.Name).Pages(ctx, func(res *cloudkms.ListCryptoKeysResponse) error {
+				for _, cryptoKey := range res.CryptoKeys {
+					err := keyRings.CryptoKeys.CryptoKeyVersions.List(cryptoKey.Name).Pages(ctx, func(res *cloudkms.ListCryptoKeyVersionsResponse) error {
+						for _, version := range res.CryptoKeyVersions {
+							if version.State != "ENABLED" && version.State != "DISABLED" {
+								continue
+							}
+							log.Printf("[INFO] Sweeping KMS crypto key version %s", version.Name)
+							if _, err := keyRings.CryptoKeys.CryptoKeyVersions.Destroy(version.Name, &cloudkms.DestroyCryptoKeyVersionRequest{}).Do(); err != nil {
+								log.Printf("[INFO] Error destroying KMS crypto key version %s: %s", version.Name, err)
+							}
+						}
+						return nil
+					})
+					if err != nil {
+						log.Printf("[INFO] Error listing versions of KMS crypto key %s: %s", cryptoKey.Name, err)
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				log.Printf("[INFO] Error listing crypto keys of KMS key ring %s: %s", keyRing.Name, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error listing KMS key rings: %s", err)
+	}
+	return nil
+}
+
 func TestCryptoKeyIdParsing(t *testing.T) {
 	t.Parallel()
 
@@ -107,12 +160,12 @@ func TestCryptoKeyNextRotationCalculation_validation(t *testing.T) {
 func TestAccKmsCryptoKey_basic(t *testing.T) {
 	t.Parallel()
 
-	projectId := "terraform-" + acctest.RandString(10)
+	projectId := "tf-test-" + randString(t, 10)
 	projectOrg := getTestOrgFromEnv(t)
 	location := getTestRegionFromEnv()
 	projectBillingAccount := getTestBillingAccountFromEnv(t)
-	keyRingName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	cryptoKeyName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	keyRingName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	cryptoKeyName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -139,12 +192,12 @@ func TestAccKmsCryptoKey_basic(t *testing.T) {
 func TestAccKmsCryptoKey_rotation(t *testing.T) {
 	t.Parallel()
 
-	projectId := "terraform-" + acctest.RandString(10)
+	projectId := "tf-test-" + randString(t, 10)
 	projectOrg := getTestOrgFromEnv(t)
 	location := getTestRegionFromEnv()
 	projectBillingAccount := getTestBillingAccountFromEnv(t)
-	keyRingName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	cryptoKeyName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	keyRingName := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	cryptoKeyName := fmt.Sprintf("tf-test-%s", randString(t, 10))
 	rotationPeriod := "100000s"
 	updatedRotationPeriod := "7776000s"
 
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/logging/v2"
@@ -14,8 +13,8 @@ func TestAccLoggingBillingAccountExclusion_basic(t *testing.T) {
 	t.Parallel()
 
 	billingAccount := getTestBillingAccountFromEnv(t)
-	exclusionName := "tf-test-exclusion-" + acctest.RandString(10)
-	description := "Description " + acctest.RandString(10)
+	exclusionName := "tf-test-exclusion-" + randString(t, 10)
+	description := "Description " + randString(t, 10)
 
 	var exclusion logging.LogExclusion
 
@@ -44,9 +43,9 @@ func TestAccLoggingBillingAccountExclusion_update(t *testing.T) {
 	t.Parallel()
 
 	billingAccount := getTestBillingAccountFromEnv(t)
-	exclusionName := "tf-test-exclusion-" + acctest.RandString(10)
-	descriptionBefore := "Basic BillingAccount Logging Exclusion" + acctest.RandString(10)
-	descriptionAfter := "Updated Basic BillingAccount Logging Exclusion" + acctest.RandString(10)
+	exclusionName := "tf-test-exclusion-" + randString(t, 10)
+	descriptionBefore := "Basic BillingAccount Logging Exclusion" + randString(t, 10)
+	descriptionAfter := "Updated Basic BillingAccount Logging Exclusion" + randString(t, 10)
 
 	var exclusionBefore, exclusionAfter logging.LogExclusion
 
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/logging/v2"
@@ -14,9 +13,9 @@ func TestAccLoggingFolderExclusion_basic(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	exclusionName := "tf-test-exclusion-" + acctest.RandString(10)
-	folderName := "tf-test-folder-" + acctest.RandString(10)
-	description := "Description " + acctest.RandString(10)
+	exclusionName := "tf-test-exclusion-" + randString(t, 10)
+	folderName := "tf-test-folder-" + randString(t, 10)
+	description := "Description " + randString(t, 10)
 
 	var exclusion logging.LogExclusion
 
@@ -45,9 +44,9 @@ func TestAccLoggingFolderExclusion_folderAcceptsFullFolderPath(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	exclusionName := "tf-test-exclusion-" + acctest.RandString(10)
-	folderName := "tf-test-folder-" + acctest.RandString(10)
-	description := "Description " + acctest.RandString(10)
+	exclusionName := "tf-test-exclusion-" + randString(t, 10)
+	folderName := "tf-test-folder-" + randString(t, 10)
+	description := "Description " + randString(t, 10)
 
 	var exclusion logging.LogExclusion
 
@@ -96,11 +95,11 @@ func TestAccLoggingFolderExclusion_update(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	exclusionName := "tf-test-exclusion-" + acctest.RandString(10)
-	folderName := "tf-test-folder-" + acctest.RandString(10)
+	exclusionName := "tf-test-exclusion-" + randString(t, 10)
+	folderName := "tf-test-folder-" + randString(t, 10)
 	parent := "organizations/" + org
-	descriptionBefore := "Basic Folder Logging Exclusion" + acctest.RandString(10)
-	descriptionAfter := "Updated Basic Folder Logging Exclusion" + acctest.RandString(10)
+	descriptionBefore := "Basic Folder Logging Exclusion" + randString(t, 10)
+	descriptionAfter := "Updated Basic Folder Logging Exclusion" + randString(t, 10)
 
 	var exclusionBefore, exclusionAfter logging.LogExclusion
 
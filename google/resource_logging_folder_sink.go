@@ -13,6 +13,9 @@ func resourceLoggingFolderSink() *schema.Resource {
 		Delete: resourceLoggingFolderSinkDelete,
 		Update: resourceLoggingFolderSinkUpdate,
 		Schema: resourceLoggingSinkSchema(),
+		Importer: &schema.ResourceImporter{
+			State: resourceLoggingSinkImportState("folders", "folder"),
+		},
 	}
 	schm.Schema["folder"] = &schema.Schema{
 		Type:             schema.TypeString,
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/logging/v2"
@@ -15,9 +14,9 @@ func TestAccLoggingFolderSink_basic(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	sinkName := "tf-test-sink-" + acctest.RandString(10)
-	bucketName := "tf-test-sink-bucket-" + acctest.RandString(10)
-	folderName := "tf-test-folder-" + acctest.RandString(10)
+	sinkName := "tf-test-sink-" + randString(t, 10)
+	bucketName := "tf-test-sink-bucket-" + randString(t, 10)
+	folderName := "tf-test-folder-" + randString(t, 10)
 
 	var sink logging.LogSink
 
@@ -33,6 +32,11 @@ func TestAccLoggingFolderSink_basic(t *testing.T) {
 					testAccCheckLoggingFolderSink(&sink, "google_logging_folder_sink.basic"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "google_logging_folder_sink.basic",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -41,9 +45,9 @@ func TestAccLoggingFolderSink_folderAcceptsFullFolderPath(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	sinkName := "tf-test-sink-" + acctest.RandString(10)
-	bucketName := "tf-test-sink-bucket-" + acctest.RandString(10)
-	folderName := "tf-test-folder-" + acctest.RandString(10)
+	sinkName := "tf-test-sink-" + randString(t, 10)
+	bucketName := "tf-test-sink-bucket-" + randString(t, 10)
+	folderName := "tf-test-folder-" + randString(t, 10)
 
 	var sink logging.LogSink
 
@@ -67,10 +71,10 @@ func TestAccLoggingFolderSink_update(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	sinkName := "tf-test-sink-" + acctest.RandString(10)
-	bucketName := "tf-test-sink-bucket-" + acctest.RandString(10)
-	updatedBucketName := "tf-test-sink-bucket-" + acctest.RandString(10)
-	folderName := "tf-test-folder-" + acctest.RandString(10)
+	sinkName := "tf-test-sink-" + randString(t, 10)
+	bucketName := "tf-test-sink-bucket-" + randString(t, 10)
+	updatedBucketName := "tf-test-sink-bucket-" + randString(t, 10)
+	folderName := "tf-test-folder-" + randString(t, 10)
 	parent := "organizations/" + org
 
 	var sinkBefore, sinkAfter logging.LogSink
@@ -110,9 +114,9 @@ func TestAccLoggingFolderSink_heredoc(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	sinkName := "tf-test-sink-" + acctest.RandString(10)
-	bucketName := "tf-test-sink-bucket-" + acctest.RandString(10)
-	folderName := "tf-test-folder-" + acctest.RandString(10)
+	sinkName := "tf-test-sink-" + randString(t, 10)
+	bucketName := "tf-test-sink-bucket-" + randString(t, 10)
+	folderName := "tf-test-folder-" + randString(t, 10)
 
 	var sink logging.LogSink
 
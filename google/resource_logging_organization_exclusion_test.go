@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/logging/v2"
@@ -14,8 +13,8 @@ func TestAccLoggingOrganizationExclusion_basic(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	exclusionName := "tf-test-exclusion-" + acctest.RandString(10)
-	description := "Description " + acctest.RandString(10)
+	exclusionName := "tf-test-exclusion-" + randString(t, 10)
+	description := "Description " + randString(t, 10)
 
 	var exclusion logging.LogExclusion
 
@@ -44,9 +43,9 @@ func TestAccLoggingOrganizationExclusion_update(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	exclusionName := "tf-test-exclusion-" + acctest.RandString(10)
-	descriptionBefore := "Basic Organization Logging Exclusion" + acctest.RandString(10)
-	descriptionAfter := "Updated Basic Organization Logging Exclusion" + acctest.RandString(10)
+	exclusionName := "tf-test-exclusion-" + randString(t, 10)
+	descriptionBefore := "Basic Organization Logging Exclusion" + randString(t, 10)
+	descriptionAfter := "Updated Basic Organization Logging Exclusion" + randString(t, 10)
 
 	var exclusionBefore, exclusionAfter logging.LogExclusion
 
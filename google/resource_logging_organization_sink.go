@@ -12,6 +12,9 @@ func resourceLoggingOrganizationSink() *schema.Resource {
 		Delete: resourceLoggingOrganizationSinkDelete,
 		Update: resourceLoggingOrganizationSinkUpdate,
 		Schema: resourceLoggingSinkSchema(),
+		Importer: &schema.ResourceImporter{
+			State: resourceLoggingSinkImportState("organizations", "org_id"),
+		},
 	}
 	schm.Schema["org_id"] = &schema.Schema{
 		Type:             schema.TypeString,
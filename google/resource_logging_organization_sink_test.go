@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/logging/v2"
@@ -15,8 +14,8 @@ func TestAccLoggingOrganizationSink_basic(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	sinkName := "tf-test-sink-" + acctest.RandString(10)
-	bucketName := "tf-test-sink-bucket-" + acctest.RandString(10)
+	sinkName := "tf-test-sink-" + randString(t, 10)
+	bucketName := "tf-test-sink-bucket-" + randString(t, 10)
 
 	var sink logging.LogSink
 
@@ -32,6 +31,11 @@ func TestAccLoggingOrganizationSink_basic(t *testing.T) {
 					testAccCheckLoggingOrganizationSink(&sink, "google_logging_organization_sink.basic"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "google_logging_organization_sink.basic",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -40,9 +44,9 @@ func TestAccLoggingOrganizationSink_update(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	sinkName := "tf-test-sink-" + acctest.RandString(10)
-	bucketName := "tf-test-sink-bucket-" + acctest.RandString(10)
-	updatedBucketName := "tf-test-sink-bucket-" + acctest.RandString(10)
+	sinkName := "tf-test-sink-" + randString(t, 10)
+	bucketName := "tf-test-sink-bucket-" + randString(t, 10)
+	updatedBucketName := "tf-test-sink-bucket-" + randString(t, 10)
 
 	var sinkBefore, sinkAfter logging.LogSink
 
@@ -81,8 +85,8 @@ func TestAccLoggingOrganizationSink_heredoc(t *testing.T) {
 	t.Parallel()
 
 	org := getTestOrgFromEnv(t)
-	sinkName := "tf-test-sink-" + acctest.RandString(10)
-	bucketName := "tf-test-sink-bucket-" + acctest.RandString(10)
+	sinkName := "tf-test-sink-" + randString(t, 10)
+	bucketName := "tf-test-sink-bucket-" + randString(t, 10)
 
 	var sink logging.LogSink
 
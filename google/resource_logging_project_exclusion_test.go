@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/logging/v2"
@@ -13,7 +12,7 @@ import (
 func TestAccLoggingProjectExclusion_basic(t *testing.T) {
 	t.Parallel()
 
-	exclusionName := "tf-test-exclusion-" + acctest.RandString(10)
+	exclusionName := "tf-test-exclusion-" + randString(t, 10)
 
 	var exclusion logging.LogExclusion
 
@@ -40,7 +39,7 @@ func TestAccLoggingProjectExclusion_basic(t *testing.T) {
 func TestAccLoggingProjectExclusion_disablePreservesFilter(t *testing.T) {
 	t.Parallel()
 
-	exclusionName := "tf-test-exclusion-" + acctest.RandString(10)
+	exclusionName := "tf-test-exclusion-" + randString(t, 10)
 
 	var exclusionBefore, exclusionAfter logging.LogExclusion
 
@@ -87,7 +86,7 @@ func TestAccLoggingProjectExclusion_disablePreservesFilter(t *testing.T) {
 func TestAccLoggingProjectExclusion_update(t *testing.T) {
 	t.Parallel()
 
-	exclusionName := "tf-test-exclusion-" + acctest.RandString(10)
+	exclusionName := "tf-test-exclusion-" + randString(t, 10)
 
 	var exclusionBefore, exclusionAfter logging.LogExclusion
 
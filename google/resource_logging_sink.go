@@ -1,6 +1,8 @@
 package google
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	"google.golang.org/api/logging/v2"
 )
@@ -69,3 +71,23 @@ func expandResourceLoggingSinkForUpdate(d *schema.ResourceData) *logging.LogSink
 	}
 	return &sink
 }
+
+// resourceLoggingSinkImportState returns the importer of the sinks of
+// resourceType, e.g. `folders`, whose id is stored in parentField.
+func resourceLoggingSinkImportState(resourceType, parentField string) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		config := meta.(*Config)
+		if err := parseImportId([]string{fmt.Sprintf("%s/(?P<%s>[^/]+)/sinks/(?P<name>[^/]+)", resourceType, parentField), fmt.Sprintf("(?P<%s>[^/]+)/(?P<name>[^/]+)", parentField)}, d, config); err != nil {
+			return nil, err
+		}
+
+		id := LoggingSinkId{
+			resourceType: resourceType,
+			resourceId:   d.Get(parentField).(string),
+			name:         d.Get("name").(string),
+		}
+		d.SetId(id.canonicalId())
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
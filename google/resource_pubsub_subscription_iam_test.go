@@ -6,7 +6,6 @@ import (
 	"sort"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -14,9 +13,9 @@ import (
 func TestAccPubsubSubscriptionIamBinding(t *testing.T) {
 	t.Parallel()
 
-	topic := "test-topic-iam-" + acctest.RandString(10)
-	subscription := "test-subscription-iam-" + acctest.RandString(10)
-	account := "test-iam-" + acctest.RandString(10)
+	topic := "test-topic-iam-" + randString(t, 10)
+	subscription := "test-subscription-iam-" + randString(t, 10)
+	account := "test-iam-" + randString(t, 10)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -50,9 +49,9 @@ func TestAccPubsubSubscriptionIamBinding(t *testing.T) {
 func TestAccPubsubSubscriptionIamMember(t *testing.T) {
 	t.Parallel()
 
-	topic := "test-topic-iam-" + acctest.RandString(10)
-	subscription := "test-subscription-iam-" + acctest.RandString(10)
-	account := "test-iam-" + acctest.RandString(10)
+	topic := "test-topic-iam-" + randString(t, 10)
+	subscription := "test-subscription-iam-" + randString(t, 10)
+	account := "test-iam-" + randString(t, 10)
 	accountEmail := fmt.Sprintf("%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv())
 
 	resource.Test(t, resource.TestCase{
@@ -79,9 +78,9 @@ func TestAccPubsubSubscriptionIamMember(t *testing.T) {
 func TestAccPubsubSubscriptionIamPolicy(t *testing.T) {
 	t.Parallel()
 
-	topic := "test-topic-iam-" + acctest.RandString(10)
-	subscription := "test-subscription-iam-" + acctest.RandString(10)
-	account := "test-iam-" + acctest.RandString(10)
+	topic := "test-topic-iam-" + randString(t, 10)
+	subscription := "test-subscription-iam-" + randString(t, 10)
+	account := "test-iam-" + randString(t, 10)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -1,19 +1,50 @@
 package google
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-google/google/fakegcp"
+	"google.golang.org/api/pubsub/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_pubsub_subscription", testSweepPubsubSubscriptions)
+}
+
+func testSweepPubsubSubscriptions(region string) error {
+	config, err := sweeperConfig(region)
+	if err != nil {
+		return err
+	}
+
+	err = config.clientPubsub.Projects.Subscriptions.List("projects/"+config.Project).Pages(context.Background(), func(res *pubsub.ListSubscriptionsResponse) error {
+		for _, subscription := range res.Subscriptions {
+			if !isSweepableTestResource(GetResourceNameFromSelfLink(subscription.Name)) {
+				continue
+			}
+			log.Printf("[INFO] Sweeping Pub/Sub subscription %s", subscription.Name)
+			if _, err := config.clientPubsub.Projects.Subscriptions.Delete(subscription.Name).Do(); err != nil {
+				log.Printf("[INFO] Error deleting Pub/Sub subscription %s: %s", subscription.Name, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error listing Pub/Sub subscriptions: %s", err)
+	}
+	return nil
+}
+
 func TestAccPubsubSubscription_basic(t *testing.T) {
 	t.Parallel()
 
-	topic := fmt.Sprintf("tf-test-topic-%s", acctest.RandString(10))
-	subscription := fmt.Sprintf("tf-test-sub-%s", acctest.RandString(10))
+	topic := fmt.Sprintf("tf-test-topic-%s", randString(t, 10))
+	subscription := fmt.Sprintf("tf-test-sub-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -33,6 +64,40 @@ func TestAccPubsubSubscription_basic(t *testing.T) {
 	})
 }
 
+// Push endpoints are only tested against the fake GCP backend, see the note
+// below.
+func TestFakePubsubSubscription_pushConfig(t *testing.T) {
+	t.Parallel()
+
+	s := newFakeGcpServer(t)
+	defer s.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testFakeGcpProviders(),
+		CheckDestroy: testFakeGcpCheckDestroy(s),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakePubsubSubscription_pushConfig(s, "https://example.com/push"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_pubsub_subscription.foo", "topic", "projects/fake-project/topics/topic-test"),
+					resource.TestCheckResourceAttr("google_pubsub_subscription.foo", "push_config.0.push_endpoint", "https://example.com/push"),
+				),
+			},
+			resource.TestStep{
+				Config:            testFakeGcpProviderConfig(s),
+				ResourceName:      "google_pubsub_subscription.foo",
+				ImportStateId:     "subscription-test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testFakePubsubSubscription_pushConfig(s, "https://example.com/other"),
+				Check:  resource.TestCheckResourceAttr("google_pubsub_subscription.foo", "push_config.0.push_endpoint", "https://example.com/other"),
+			},
+		},
+	})
+}
+
 // TODO: Add acceptance test for push delivery.
 //
 // Testing push endpoints is tricky for the following reason:
@@ -74,6 +139,22 @@ resource "google_pubsub_subscription" "foo" {
 }`, topic, subscription)
 }
 
+func testFakePubsubSubscription_pushConfig(s *fakegcp.Server, endpoint string) string {
+	return testFakeGcpProviderConfig(s) + fmt.Sprintf(`
+resource "google_pubsub_topic" "foo" {
+	name = "topic-test"
+}
+
+resource "google_pubsub_subscription" "foo" {
+	name  = "subscription-test"
+	topic = "${google_pubsub_topic.foo.name}"
+
+	push_config {
+		push_endpoint = "%s"
+	}
+}`, endpoint)
+}
+
 func TestGetComputedTopicName(t *testing.T) {
 	type testData struct {
 		project  string
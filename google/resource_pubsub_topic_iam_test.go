@@ -6,7 +6,6 @@ import (
 	"sort"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -14,8 +13,8 @@ import (
 func TestAccPubsubTopicIamBinding(t *testing.T) {
 	t.Parallel()
 
-	topic := "test-topic-iam-" + acctest.RandString(10)
-	account := "test-topic-iam-" + acctest.RandString(10)
+	topic := "test-topic-iam-" + randString(t, 10)
+	account := "test-topic-iam-" + randString(t, 10)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -55,8 +54,8 @@ func TestAccPubsubTopicIamBinding(t *testing.T) {
 func TestAccPubsubTopicIamBinding_topicName(t *testing.T) {
 	t.Parallel()
 
-	topic := "test-topic-iam-" + acctest.RandString(10)
-	account := "test-topic-iam-" + acctest.RandString(10)
+	topic := "test-topic-iam-" + randString(t, 10)
+	account := "test-topic-iam-" + randString(t, 10)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -77,8 +76,8 @@ func TestAccPubsubTopicIamBinding_topicName(t *testing.T) {
 func TestAccPubsubTopicIamMember(t *testing.T) {
 	t.Parallel()
 
-	topic := "test-topic-iam-" + acctest.RandString(10)
-	account := "test-topic-iam-" + acctest.RandString(10)
+	topic := "test-topic-iam-" + randString(t, 10)
+	account := "test-topic-iam-" + randString(t, 10)
 	accountEmail := fmt.Sprintf("%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv())
 
 	resource.Test(t, resource.TestCase{
@@ -105,8 +104,8 @@ func TestAccPubsubTopicIamMember(t *testing.T) {
 func TestAccPubsubTopicIamPolicy(t *testing.T) {
 	t.Parallel()
 
-	topic := "test-topic-iam-" + acctest.RandString(10)
-	account := "test-topic-iam-" + acctest.RandString(10)
+	topic := "test-topic-iam-" + randString(t, 10)
+	account := "test-topic-iam-" + randString(t, 10)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -1,18 +1,48 @@
 package google
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"google.golang.org/api/pubsub/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_pubsub_topic", testSweepPubsubTopics, "gcp_pubsub_subscription")
+}
+
+func testSweepPubsubTopics(region string) error {
+	config, err := sweeperConfig(region)
+	if err != nil {
+		return err
+	}
+
+	err = config.clientPubsub.Projects.Topics.List("projects/"+config.Project).Pages(context.Background(), func(res *pubsub.ListTopicsResponse) error {
+		for _, topic := range res.Topics {
+			if !isSweepableTestResource(GetResourceNameFromSelfLink(topic.Name)) {
+				continue
+			}
+			log.Printf("[INFO] Sweeping Pub/Sub topic %s", topic.Name)
+			if _, err := config.clientPubsub.Projects.Topics.Delete(topic.Name).Do(); err != nil {
+				log.Printf("[INFO] Error deleting Pub/Sub topic %s: %s", topic.Name, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error listing Pub/Sub topics: %s", err)
+	}
+	return nil
+}
+
 func TestAccPubsubTopic_basic(t *testing.T) {
 	t.Parallel()
 
-	topicName := acctest.RandomWithPrefix("tf-test-topic")
+	topicName := randomWithPrefix(t, "tf-test-topic")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -29,6 +29,7 @@ func resourceRedisInstance() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRedisInstanceCreate,
 		Read:   resourceRedisInstanceRead,
+		Update: resourceRedisInstanceUpdate,
 		Delete: resourceRedisInstanceDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -37,14 +38,15 @@ func resourceRedisInstance() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(360 * time.Second),
+			Update: schema.DefaultTimeout(240 * time.Second),
 			Delete: schema.DefaultTimeout(240 * time.Second),
 		},
+		CustomizeDiff: effectiveLabelsCustomizeDiff(false),
 
 		Schema: map[string]*schema.Schema{
 			"memory_size_gb": {
 				Type:     schema.TypeInt,
 				Required: true,
-				ForceNew: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -65,14 +67,13 @@ func resourceRedisInstance() *schema.Resource {
 			"display_name": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 			"labels": {
 				Type:     schema.TypeMap,
 				Optional: true,
-				ForceNew: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"effective_labels": effectiveLabelsSchema(),
 			"location_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -201,13 +202,13 @@ func resourceRedisInstanceCreate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	url, err := replaceVars(d, config, "https://redis.googleapis.com/v1beta1/projects/{{project}}/locations/{{region}}/instances?instanceId={{name}}")
+	url, err := replaceVars(d, config, "{{RedisBasePath}}projects/{{project}}/locations/{{region}}/instances?instanceId={{name}}")
 	if err != nil {
 		return err
 	}
 
 	log.Printf("[DEBUG] Creating new Instance: %#v", obj)
-	res, err := Post(config, url, obj)
+	res, err := Post(config, url, project, obj)
 	if err != nil {
 		return fmt.Errorf("Error creating Instance: %s", err)
 	}
@@ -226,12 +227,11 @@ func resourceRedisInstanceCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	waitErr := redisOperationWaitTime(
-		config.clientRedis, op, project, "Creating Instance",
-		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+		config, op, project, "Creating Instance",
+		d.Timeout(schema.TimeoutCreate))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		clearIdUnlessStopped(d, config)
 		return fmt.Errorf("Error waiting to create Instance: %s", waitErr)
 	}
 
@@ -248,12 +248,12 @@ func resourceRedisInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	url, err := replaceVars(d, config, "https://redis.googleapis.com/v1beta1/projects/{{project}}/locations/{{region}}/instances/{{name}}")
+	url, err := replaceVars(d, config, "{{RedisBasePath}}projects/{{project}}/locations/{{region}}/instances/{{name}}")
 	if err != nil {
 		return err
 	}
 
-	res, err := Get(config, url)
+	res, err := Get(config, url, project)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("RedisInstance %q", d.Id()))
 	}
@@ -281,7 +281,7 @@ func resourceRedisInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("host", flattenRedisInstanceHost(res["host"])); err != nil {
 		return fmt.Errorf("Error reading Instance: %s", err)
 	}
-	if err := d.Set("labels", flattenRedisInstanceLabels(res["labels"])); err != nil {
+	if err := setLabels(d, config, flattenRedisInstanceLabels(res["labels"])); err != nil {
 		return fmt.Errorf("Error reading Instance: %s", err)
 	}
 	if err := d.Set("location_id", flattenRedisInstanceLocationId(res["locationId"])); err != nil {
@@ -315,6 +315,69 @@ func resourceRedisInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceRedisInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	displayNameProp, err := expandRedisInstanceDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	}
+	labelsProp, err := expandRedisInstanceLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	}
+	memorySizeGbProp, err := expandRedisInstanceMemorySizeGb(d.Get("memory_size_gb"), d, config)
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"displayName":  displayNameProp,
+		"labels":       labelsProp,
+		"memorySizeGb": memorySizeGbProp,
+	}
+
+	updateMask := buildUpdateMask(d, map[string]string{
+		"display_name":     "displayName",
+		"labels":           "labels",
+		"effective_labels": "labels",
+		"memory_size_gb":   "memorySizeGb",
+	})
+
+	url, err := replaceVars(d, config, "{{RedisBasePath}}projects/{{project}}/locations/{{region}}/instances/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Instance %q: %#v", d.Id(), obj)
+	res, err := Patch(config, url, project, obj, updateMask)
+
+	if err != nil {
+		return fmt.Errorf("Error updating Instance %q: %s", d.Id(), err)
+	}
+
+	op := &redis.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	err = redisOperationWaitTime(
+		config, op, project, "Updating Instance",
+		d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceRedisInstanceRead(d, meta)
+}
+
 func resourceRedisInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -323,13 +386,13 @@ func resourceRedisInstanceDelete(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	url, err := replaceVars(d, config, "https://redis.googleapis.com/v1beta1/projects/{{project}}/locations/{{region}}/instances/{{name}}")
+	url, err := replaceVars(d, config, "{{RedisBasePath}}projects/{{project}}/locations/{{region}}/instances/{{name}}")
 	if err != nil {
 		return err
 	}
 
 	log.Printf("[DEBUG] Deleting Instance %q", d.Id())
-	res, err := Delete(config, url)
+	res, err := Delete(config, url, project)
 	if err != nil {
 		return handleNotFoundError(err, d, "Instance")
 	}
@@ -341,8 +404,8 @@ func resourceRedisInstanceDelete(d *schema.ResourceData, meta interface{}) error
 	}
 
 	err = redisOperationWaitTime(
-		config.clientRedis, op, project, "Deleting Instance",
-		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+		config, op, project, "Deleting Instance",
+		d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
 		return err
@@ -390,8 +453,11 @@ func flattenRedisInstanceHost(v interface{}) interface{} {
 	return v
 }
 
-func flattenRedisInstanceLabels(v interface{}) interface{} {
-	return v
+func flattenRedisInstanceLabels(v interface{}) map[string]string {
+	if v == nil {
+		return nil
+	}
+	return convertStringMap(v.(map[string]interface{}))
 }
 
 func flattenRedisInstanceLocationId(v interface{}) interface{} {
@@ -452,13 +518,13 @@ func expandRedisInstanceDisplayName(v interface{}, d *schema.ResourceData, confi
 
 func expandRedisInstanceLabels(v interface{}, d *schema.ResourceData, config *Config) (map[string]string, error) {
 	if v == nil {
-		return map[string]string{}, nil
+		return mergeDefaultLabels(config, nil), nil
 	}
 	m := make(map[string]string)
 	for k, val := range v.(map[string]interface{}) {
 		m[k] = val.(string)
 	}
-	return m, nil
+	return mergeDefaultLabels(config, m), nil
 }
 
 func expandRedisInstanceLocationId(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
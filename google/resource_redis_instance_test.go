@@ -1,17 +1,53 @@
 package google
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"google.golang.org/api/redis/v1beta1"
 )
 
+func init() {
+	addTestSweeper("gcp_redis_instance", testSweepRedisInstances)
+}
+
+func testSweepRedisInstances(region string) error {
+	config, err := sweeperConfig(region)
+	if err != nil {
+		return err
+	}
+
+	parent := fmt.Sprintf("projects/%s/locations/%s", config.Project, region)
+	err = config.clientRedis.Projects.Locations.Instances.List(parent).Pages(context.Background(), func(res *redis.ListInstancesResponse) error {
+		for _, instance := range res.Instances {
+			if !isSweepableTestResource(GetResourceNameFromSelfLink(instance.Name)) {
+				continue
+			}
+			log.Printf("[INFO] Sweeping Redis instance %s", instance.Name)
+			op, err := config.clientRedis.Projects.Locations.Instances.Delete(instance.Name).Do()
+			if err != nil {
+				log.Printf("[INFO] Error deleting Redis instance %s: %s", instance.Name, err)
+				continue
+			}
+			if err := redisOperationWait(config, op, config.Project, "Sweeping Redis instance"); err != nil {
+				log.Printf("[INFO] Error deleting Redis instance %s: %s", instance.Name, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error listing Redis instances: %s", err)
+	}
+	return nil
+}
+
 func TestAccRedisInstance_basic(t *testing.T) {
 	t.Parallel()
 
-	name := acctest.RandomWithPrefix("tf-test")
+	name := randomWithPrefix(t, "tf-test")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -33,8 +69,8 @@ func TestAccRedisInstance_basic(t *testing.T) {
 func TestAccRedisInstance_full(t *testing.T) {
 	t.Parallel()
 
-	name := acctest.RandomWithPrefix("tf-test")
-	network := acctest.RandomWithPrefix("tf-test")
+	name := randomWithPrefix(t, "tf-test")
+	network := randomWithPrefix(t, "tf-test")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
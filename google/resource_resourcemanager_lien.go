@@ -97,13 +97,13 @@ func resourceResourceManagerLienCreate(d *schema.ResourceData, meta interface{})
 		"restrictions": restrictionsProp,
 	}
 
-	url, err := replaceVars(d, config, "https://cloudresourcemanager.googleapis.com/v1/liens")
+	url, err := replaceVars(d, config, "{{ResourceManagerBasePath}}liens")
 	if err != nil {
 		return err
 	}
 
 	log.Printf("[DEBUG] Creating new Lien: %#v", obj)
-	res, err := Post(config, url, obj)
+	res, err := Post(config, url, "", obj)
 	if err != nil {
 		return fmt.Errorf("Error creating Lien: %s", err)
 	}
@@ -133,12 +133,12 @@ func resourceResourceManagerLienCreate(d *schema.ResourceData, meta interface{})
 func resourceResourceManagerLienRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	url, err := replaceVars(d, config, "https://cloudresourcemanager.googleapis.com/v1/liens?parent={{parent}}")
+	url, err := replaceVars(d, config, "{{ResourceManagerBasePath}}liens?parent={{parent}}")
 	if err != nil {
 		return err
 	}
 
-	res, err := Get(config, url)
+	res, err := Get(config, url, "")
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("ResourceManagerLien %q", d.Id()))
 	}
@@ -200,17 +200,17 @@ func resourceResourceManagerLienRead(d *schema.ResourceData, meta interface{}) e
 func resourceResourceManagerLienDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	url, err := replaceVars(d, config, "https://cloudresourcemanager.googleapis.com/v1/liens?parent={{parent}}")
+	url, err := replaceVars(d, config, "{{ResourceManagerBasePath}}liens?parent={{parent}}")
 	if err != nil {
 		return err
 	}
 
-	url, err = replaceVars(d, config, "https://cloudresourcemanager.googleapis.com/v1/liens/{{name}}")
+	url, err = replaceVars(d, config, "{{ResourceManagerBasePath}}liens/{{name}}")
 	if err != nil {
 		return err
 	}
 	log.Printf("[DEBUG] Deleting Lien %q", d.Id())
-	res, err := Delete(config, url)
+	res, err := Delete(config, url, "")
 	if err != nil {
 		return handleNotFoundError(err, d, "Lien")
 	}
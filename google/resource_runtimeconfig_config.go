@@ -16,6 +16,9 @@ func resourceRuntimeconfigConfig() *schema.Resource {
 		Read:   resourceRuntimeconfigConfigRead,
 		Update: resourceRuntimeconfigConfigUpdate,
 		Delete: resourceRuntimeconfigConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRuntimeconfigConfigImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -133,6 +136,22 @@ func resourceRuntimeconfigConfigDelete(d *schema.ResourceData, meta interface{})
 
 // resourceRuntimeconfigFullName turns a given project and a 'short name' for a runtime config into a full name
 // (e.g. projects/my-project/configs/my-config).
+func resourceRuntimeconfigConfigImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/configs/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/configs/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceRuntimeconfigFullName(project, name string) string {
 	return fmt.Sprintf("projects/%s/configs/%s", project, name)
 }
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/runtimeconfig/v1beta1"
@@ -14,7 +13,7 @@ func TestAccRuntimeconfigConfig_basic(t *testing.T) {
 	t.Parallel()
 
 	var runtimeConfig runtimeconfig.RuntimeConfig
-	configName := fmt.Sprintf("runtimeconfig-test-%s", acctest.RandString(10))
+	configName := fmt.Sprintf("runtimeconfig-test-%s", randString(t, 10))
 	description := "my test description"
 
 	resource.Test(t, resource.TestCase{
@@ -30,6 +29,11 @@ func TestAccRuntimeconfigConfig_basic(t *testing.T) {
 					testAccCheckRuntimeConfigDescription(&runtimeConfig, description),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "google_runtimeconfig_config.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -38,7 +42,7 @@ func TestAccRuntimeconfig_update(t *testing.T) {
 	t.Parallel()
 
 	var runtimeConfig runtimeconfig.RuntimeConfig
-	configName := fmt.Sprintf("runtimeconfig-test-%s", acctest.RandString(10))
+	configName := fmt.Sprintf("runtimeconfig-test-%s", randString(t, 10))
 	firstDescription := "my test description"
 	secondDescription := "my updated test description"
 
@@ -70,7 +74,7 @@ func TestAccRuntimeconfig_updateEmptyDescription(t *testing.T) {
 	t.Parallel()
 
 	var runtimeConfig runtimeconfig.RuntimeConfig
-	configName := fmt.Sprintf("runtimeconfig-test-%s", acctest.RandString(10))
+	configName := fmt.Sprintf("runtimeconfig-test-%s", randString(t, 10))
 	description := "my test description"
 
 	resource.Test(t, resource.TestCase{
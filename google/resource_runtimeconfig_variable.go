@@ -13,6 +13,9 @@ func resourceRuntimeconfigVariable() *schema.Resource {
 		Read:   resourceRuntimeconfigVariableRead,
 		Update: resourceRuntimeconfigVariableUpdate,
 		Delete: resourceRuntimeconfigVariableDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceRuntimeconfigVariableImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -126,6 +129,23 @@ func resourceRuntimeconfigVariableDelete(d *schema.ResourceData, meta interface{
 
 // resourceRuntimeconfigVariableFullName turns a given project, runtime config name, and a 'short name' for a runtime
 // config variable into a full name (e.g. projects/my-project/configs/my-config/variables/my-variable).
+func resourceRuntimeconfigVariableImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	// Variable names are paths, e.g. `ports/http`, which may contain slashes.
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/configs/(?P<parent>[^/]+)/variables/(?P<name>.+)", "(?P<parent>[^/]+)/(?P<name>.+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/configs/{{parent}}/variables/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceRuntimeconfigVariableFullName(project, config, name string) string {
 	return fmt.Sprintf("projects/%s/configs/%s/variables/%s", project, config, name)
 }
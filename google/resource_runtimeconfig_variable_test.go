@@ -6,7 +6,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/runtimeconfig/v1beta1"
@@ -17,7 +16,7 @@ func TestAccRuntimeconfigVariable_basic(t *testing.T) {
 
 	var variable runtimeconfig.Variable
 
-	varName := fmt.Sprintf("variable-test-%s", acctest.RandString(10))
+	varName := fmt.Sprintf("variable-test-%s", randString(t, 10))
 	varText := "this is my test value"
 
 	resource.Test(t, resource.TestCase{
@@ -26,7 +25,7 @@ func TestAccRuntimeconfigVariable_basic(t *testing.T) {
 		CheckDestroy: testAccCheckRuntimeconfigVariableDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccRuntimeconfigVariable_basicText(varName, varText),
+				Config: testAccRuntimeconfigVariable_basicText(t, varName, varText),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckRuntimeconfigVariableExists(
 						"google_runtimeconfig_variable.foobar", &variable),
@@ -34,6 +33,11 @@ func TestAccRuntimeconfigVariable_basic(t *testing.T) {
 					testAccCheckRuntimeconfigVariableUpdateTime("google_runtimeconfig_variable.foobar"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "google_runtimeconfig_variable.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -43,8 +47,8 @@ func TestAccRuntimeconfigVariable_basicUpdate(t *testing.T) {
 
 	var variable runtimeconfig.Variable
 
-	configName := fmt.Sprintf("some-name-%s", acctest.RandString(10))
-	varName := fmt.Sprintf("variable-test-%s", acctest.RandString(10))
+	configName := fmt.Sprintf("some-name-%s", randString(t, 10))
+	varName := fmt.Sprintf("variable-test-%s", randString(t, 10))
 	varText := "this is my test value"
 	varText2 := "this is my updated value"
 
@@ -77,7 +81,7 @@ func TestAccRuntimeconfigVariable_basicValue(t *testing.T) {
 
 	var variable runtimeconfig.Variable
 
-	varName := fmt.Sprintf("variable-test-%s", acctest.RandString(10))
+	varName := fmt.Sprintf("variable-test-%s", randString(t, 10))
 	varValue := "Zm9vYmFyCg=="
 
 	resource.Test(t, resource.TestCase{
@@ -86,7 +90,7 @@ func TestAccRuntimeconfigVariable_basicValue(t *testing.T) {
 		CheckDestroy: testAccCheckRuntimeconfigVariableDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccRuntimeconfigVariable_basicValue(varName, varValue),
+				Config: testAccRuntimeconfigVariable_basicValue(t, varName, varValue),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckRuntimeconfigVariableExists(
 						"google_runtimeconfig_variable.foobar", &variable),
@@ -106,7 +110,7 @@ func TestAccRuntimeconfigVariable_errorsOnBothValueAndText(t *testing.T) {
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config:      testAccRuntimeconfigVariable_invalidBothTextValue(),
+				Config:      testAccRuntimeconfigVariable_invalidBothTextValue(t),
 				ExpectError: regexp.MustCompile("conflicts with"),
 			},
 		},
@@ -121,7 +125,7 @@ func TestAccRuntimeconfigVariable_errorsOnMissingValueAndText(t *testing.T) {
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config:      testAccRuntimeconfigVariable_invalidMissingTextValue(),
+				Config:      testAccRuntimeconfigVariable_invalidMissingTextValue(t),
 				ExpectError: regexp.MustCompile("You must specify one of value or text"),
 			},
 		},
@@ -214,7 +218,7 @@ func testAccCheckRuntimeconfigVariableDestroy(s *terraform.State) error {
 	return nil
 }
 
-func testAccRuntimeconfigVariable_basicText(name, text string) string {
+func testAccRuntimeconfigVariable_basicText(t *testing.T, name, text string) string {
 	return fmt.Sprintf(`
 resource "google_runtimeconfig_config" "foobar" {
  	name = "some-config-%s"
@@ -224,7 +228,7 @@ resource "google_runtimeconfig_variable" "foobar" {
 	parent = "${google_runtimeconfig_config.foobar.name}"
 	name = "%s"
 	text = "%s"
-}`, acctest.RandString(10), name, text)
+}`, randString(t, 10), name, text)
 }
 
 func testAccRuntimeconfigVariable_basicTextUpdate(configName, name, text string) string {
@@ -240,7 +244,7 @@ resource "google_runtimeconfig_variable" "foobar" {
 }`, configName, name, text)
 }
 
-func testAccRuntimeconfigVariable_basicValue(name, value string) string {
+func testAccRuntimeconfigVariable_basicValue(t *testing.T, name, value string) string {
 	return fmt.Sprintf(`
 resource "google_runtimeconfig_config" "foobar" {
  	name = "some-config-%s"
@@ -250,10 +254,10 @@ resource "google_runtimeconfig_variable" "foobar" {
 	parent = "${google_runtimeconfig_config.foobar.name}"
 	name = "%s"
 	value = "%s"
-}`, acctest.RandString(10), name, value)
+}`, randString(t, 10), name, value)
 }
 
-func testAccRuntimeconfigVariable_invalidBothTextValue() string {
+func testAccRuntimeconfigVariable_invalidBothTextValue(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_runtimeconfig_config" "foobar" {
  	name = "some-config-%s"
@@ -264,10 +268,10 @@ resource "google_runtimeconfig_variable" "foobar" {
 	name = "%s"
 	text = "here's my value"
 	value = "Zm9vYmFyCg=="
-}`, acctest.RandString(10), acctest.RandString(10))
+}`, randString(t, 10), randString(t, 10))
 }
 
-func testAccRuntimeconfigVariable_invalidMissingTextValue() string {
+func testAccRuntimeconfigVariable_invalidMissingTextValue(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "google_runtimeconfig_config" "foobar" {
  	name = "some-config-%s"
@@ -276,5 +280,5 @@ resource "google_runtimeconfig_config" "foobar" {
 resource "google_runtimeconfig_variable" "foobar" {
 	parent = "${google_runtimeconfig_config.foobar.name}"
 	name = "my-variable-namespace/%s"
-}`, acctest.RandString(10), acctest.RandString(10))
+}`, randString(t, 10), randString(t, 10))
 }
@@ -13,6 +13,9 @@ func resourceSourceRepoRepository() *schema.Resource {
 		Read:   resourceSourceRepoRepositoryRead,
 		Delete: resourceSourceRepoRepositoryDelete,
 		//Update: not supported,
+		Importer: &schema.ResourceImporter{
+			State: resourceSourceRepoRepositoryImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -110,6 +113,23 @@ func resourceSourceRepoRepositoryDelete(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+func resourceSourceRepoRepositoryImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	// Repository names may contain slashes.
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/repos/(?P<name>.+)", "(?P<name>.+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/repos/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func buildRepositoryName(project, name string) string {
 	repositoryName := "projects/" + project + "/repos/" + name
 	return repositoryName
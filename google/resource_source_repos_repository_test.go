@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -12,7 +11,7 @@ import (
 func TestAccSourceRepoRepository_basic(t *testing.T) {
 	t.Parallel()
 
-	repositoryName := fmt.Sprintf("source-repo-repository-test-%s", acctest.RandString(10))
+	repositoryName := fmt.Sprintf("source-repo-repository-test-%s", randString(t, 10))
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -25,6 +24,11 @@ func TestAccSourceRepoRepository_basic(t *testing.T) {
 						"google_sourcerepo_repository.acceptance", repositoryName),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "google_sourcerepo_repository.acceptance",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -0,0 +1,101 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccSourceRepoRepositoryIamBinding(t *testing.T) {
+	t.Parallel()
+
+	repository := randomWithPrefix(t, "source-repo-iam")
+	account := randomWithPrefix(t, "tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceRepoRepositoryIamBinding_basic(repository, account),
+				Check: testAccCheckIamUpdaterBinding(func(config *Config) ResourceIamUpdater {
+					return &SourceRepoRepositoryIamUpdater{project: getTestProjectFromEnv(), repository: repository, Config: config}
+				}, "roles/source.reader", []string{
+					fmt.Sprintf("serviceAccount:%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+			{
+				ResourceName:      "google_sourcerepo_repository_iam_binding.foo",
+				ImportStateId:     fmt.Sprintf("%s roles/source.reader", fmt.Sprintf("projects/%s/repos/%s", getTestProjectFromEnv(), repository)),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSourceRepoRepositoryIamMember(t *testing.T) {
+	t.Parallel()
+
+	repository := randomWithPrefix(t, "source-repo-iam")
+	account := randomWithPrefix(t, "tf-test")
+	accountEmail := fmt.Sprintf("%s@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceRepoRepositoryIamMember_basic(repository, account),
+				Check: testAccCheckIamUpdaterBinding(func(config *Config) ResourceIamUpdater {
+					return &SourceRepoRepositoryIamUpdater{project: getTestProjectFromEnv(), repository: repository, Config: config}
+				}, "roles/source.reader", []string{"serviceAccount:" + accountEmail}),
+			},
+			{
+				ResourceName:      "google_sourcerepo_repository_iam_member.foo",
+				ImportStateId:     fmt.Sprintf("%s roles/source.reader serviceAccount:%s", fmt.Sprintf("projects/%s/repos/%s", getTestProjectFromEnv(), repository), accountEmail),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSourceRepoRepositoryIamBinding_basic(repository, account string) string {
+	return fmt.Sprintf(`
+resource "google_sourcerepo_repository" "repository" {
+  name = "%s"
+}
+
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_sourcerepo_repository_iam_binding" "foo" {
+  repository = "${google_sourcerepo_repository.repository.name}"
+  role    = "roles/source.reader"
+  members = ["serviceAccount:${google_service_account.test-account.email}"]
+}
+`, repository, account)
+}
+
+func testAccSourceRepoRepositoryIamMember_basic(repository, account string) string {
+	return fmt.Sprintf(`
+resource "google_sourcerepo_repository" "repository" {
+  name = "%s"
+}
+
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Iam Testing Account"
+}
+
+resource "google_sourcerepo_repository_iam_member" "foo" {
+  repository = "${google_sourcerepo_repository.repository.name}"
+  role   = "roles/source.reader"
+  member = "serviceAccount:${google_service_account.test-account.email}"
+}
+`, repository, account)
+}
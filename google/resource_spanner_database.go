@@ -105,11 +105,10 @@ func resourceSpannerDatabaseCreate(d *schema.ResourceData, meta interface{}) err
 	d.SetId(id.terraformId())
 
 	// Wait until it's created
-	timeoutMins := int(d.Timeout(schema.TimeoutCreate).Minutes())
-	waitErr := spannerDatabaseOperationWait(config, op, "Creating Spanner database", timeoutMins)
+	timeout := d.Timeout(schema.TimeoutCreate)
+	waitErr := spannerDatabaseOperationWait(config, op, "Creating Spanner database", timeout)
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		clearIdUnlessStopped(d, config)
 		return waitErr
 	}
 
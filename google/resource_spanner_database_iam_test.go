@@ -4,18 +4,17 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccSpannerDatabaseIamBinding(t *testing.T) {
 	t.Parallel()
 
-	account := acctest.RandomWithPrefix("tf-test")
+	account := randomWithPrefix(t, "tf-test")
 	role := "roles/spanner.databaseAdmin"
 	project := getTestProjectFromEnv()
-	database := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	instance := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	database := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	instance := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -56,10 +55,10 @@ func TestAccSpannerDatabaseIamMember(t *testing.T) {
 	t.Parallel()
 
 	project := getTestProjectFromEnv()
-	account := acctest.RandomWithPrefix("tf-test")
+	account := randomWithPrefix(t, "tf-test")
 	role := "roles/spanner.databaseAdmin"
-	database := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	instance := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	database := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	instance := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -87,10 +86,10 @@ func TestAccSpannerDatabaseIamPolicy(t *testing.T) {
 	t.Parallel()
 
 	project := getTestProjectFromEnv()
-	account := acctest.RandomWithPrefix("tf-test")
+	account := randomWithPrefix(t, "tf-test")
 	role := "roles/spanner.databaseAdmin"
-	database := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
-	instance := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	database := fmt.Sprintf("tf-test-%s", randString(t, 10))
+	instance := fmt.Sprintf("tf-test-%s", randString(t, 10))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -7,7 +7,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 
@@ -119,7 +118,7 @@ func TestAccSpannerDatabase_basic(t *testing.T) {
 	t.Parallel()
 
 	var db spanner.Database
-	rnd := acctest.RandString(10)
+	rnd := randString(t, 10)
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
@@ -144,7 +143,7 @@ func TestAccSpannerDatabase_basicWithInitialDDL(t *testing.T) {
 	t.Parallel()
 
 	var db spanner.Database
-	rnd := acctest.RandString(10)
+	rnd := randString(t, 10)
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testAccProviders,
@@ -166,7 +165,7 @@ func TestAccSpannerDatabase_duplicateNameError(t *testing.T) {
 	t.Parallel()
 
 	var db spanner.Database
-	rnd := acctest.RandString(10)
+	rnd := randString(t, 10)
 	dbName := fmt.Sprintf("spanner-test-%s", rnd)
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -259,7 +258,7 @@ func testAccCheckSpannerDatabaseExists(n string, instance *spanner.Database) res
 func testAccSpannerDatabase_basic(rnd string) string {
 	return fmt.Sprintf(`
 resource "google_spanner_instance" "basic" {
-  name          = "my-instance-%s"
+  name          = "tf-test-instance-%s"
   config        = "regional-us-central1"
   display_name  = "my-displayname-%s"
   num_nodes     = 1
@@ -276,7 +275,7 @@ resource "google_spanner_database" "basic" {
 func testAccSpannerDatabase_basicWithInitialDDL(rnd string) string {
 	return fmt.Sprintf(`
 resource "google_spanner_instance" "basic" {
-  name          = "my-instance-%s"
+  name          = "tf-test-instance-%s"
   config        = "regional-us-central1"
   display_name  = "my-displayname-%s"
   num_nodes     = 1
@@ -295,7 +294,7 @@ resource "google_spanner_database" "basic" {
 func testAccSpannerDatabase_duplicateNameError_part1(rnd, dbName string) string {
 	return fmt.Sprintf(`
 resource "google_spanner_instance" "basic" {
-  name          = "my-instance-%s"
+  name          = "tf-test-instance-%s"
   config        = "regional-us-central1"
   display_name  = "my-displayname-%s"
   num_nodes     = 1
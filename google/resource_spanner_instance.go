@@ -24,6 +24,8 @@ func resourceSpannerInstance() *schema.Resource {
 			State: resourceSpannerInstanceImportState,
 		},
 
+		CustomizeDiff: effectiveLabelsCustomizeDiff(false),
+
 		Schema: map[string]*schema.Schema{
 
 			"config": &schema.Schema{
@@ -86,6 +88,8 @@ func resourceSpannerInstance() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"effective_labels": effectiveLabelsSchema(),
+
 			"project": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -114,9 +118,7 @@ func resourceSpannerInstanceCreate(d *schema.ResourceData, meta interface{}) err
 		d.Set("name", cir.InstanceId)
 	}
 
-	if v, ok := d.GetOk("labels"); ok {
-		cir.Instance.Labels = convertStringMap(v.(map[string]interface{}))
-	}
+	cir.Instance.Labels = expandLabels(d, config)
 
 	id, err := buildSpannerInstanceId(d, config)
 	if err != nil {
@@ -139,11 +141,10 @@ func resourceSpannerInstanceCreate(d *schema.ResourceData, meta interface{}) err
 	d.SetId(id.terraformId())
 
 	// Wait until it's created
-	timeoutMins := int(d.Timeout(schema.TimeoutCreate).Minutes())
-	waitErr := spannerInstanceOperationWait(config, op, "Creating Spanner instance", timeoutMins)
+	timeout := d.Timeout(schema.TimeoutCreate)
+	waitErr := spannerInstanceOperationWait(config, op, "Creating Spanner instance", timeout)
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		clearIdUnlessStopped(d, config)
 		return waitErr
 	}
 
@@ -166,7 +167,9 @@ func resourceSpannerInstanceRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	d.Set("config", GetResourceNameFromSelfLink(instance.Config))
-	d.Set("labels", instance.Labels)
+	if err := setLabels(d, config, instance.Labels); err != nil {
+		return fmt.Errorf("Error setting labels: %s", err)
+	}
 	d.Set("display_name", instance.DisplayName)
 	d.Set("num_nodes", instance.NodeCount)
 	d.Set("state", instance.State)
@@ -196,9 +199,9 @@ func resourceSpannerInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 		fieldMask = append(fieldMask, "displayName")
 		uir.Instance.DisplayName = d.Get("display_name").(string)
 	}
-	if d.HasChange("labels") {
+	if hasLabelsChange(d) {
 		fieldMask = append(fieldMask, "labels")
-		uir.Instance.Labels = convertStringMap(d.Get("labels").(map[string]interface{}))
+		uir.Instance.Labels = expandLabels(d, config)
 	}
 
 	uir.FieldMask = strings.Join(fieldMask, ",")
@@ -209,8 +212,8 @@ func resourceSpannerInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	// Wait until it's updated
-	timeoutMins := int(d.Timeout(schema.TimeoutUpdate).Minutes())
-	err = spannerInstanceOperationWait(config, op, "Update Spanner Instance", timeoutMins)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	err = spannerInstanceOperationWait(config, op, "Update Spanner Instance", timeout)
 	if err != nil {
 		return err
 	}
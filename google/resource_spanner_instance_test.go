@@ -1,12 +1,13 @@
 package google
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"net/http"
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 
@@ -15,6 +16,35 @@ import (
 	"strings"
 )
 
+func init() {
+	// Databases are deleted along with their instance.
+	addTestSweeper("gcp_spanner_instance", testSweepSpannerInstances)
+}
+
+func testSweepSpannerInstances(region string) error {
+	config, err := sweeperConfig(region)
+	if err != nil {
+		return err
+	}
+
+	err = config.clientSpanner.Projects.Instances.List("projects/"+config.Project).Pages(context.Background(), func(res *spanner.ListInstancesResponse) error {
+		for _, instance := range res.Instances {
+			if !isSweepableTestResource(GetResourceNameFromSelfLink(instance.Name)) {
+				continue
+			}
+			log.Printf("[INFO] Sweeping Spanner instance %s", instance.Name)
+			if _, err := config.clientSpanner.Projects.Instances.Delete(instance.Name).Do(); err != nil {
+				log.Printf("[INFO] Error deleting Spanner instance %s: %s", instance.Name, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error listing Spanner instances: %s", err)
+	}
+	return nil
+}
+
 // Unit Tests
 
 func TestSpannerInstanceId_instanceUri(t *testing.T) {
@@ -148,8 +178,8 @@ func TestAccSpannerInstance_basic(t *testing.T) {
 	t.Parallel()
 
 	var instance spanner.Instance
-	rnd := acctest.RandString(10)
-	idName := fmt.Sprintf("spanner-test-%s", rnd)
+	rnd := randString(t, 10)
+	idName := fmt.Sprintf("tf-test-spanner-%s", rnd)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -174,7 +204,7 @@ func TestAccSpannerInstance_basicWithAutogenName(t *testing.T) {
 	t.Parallel()
 
 	var instance spanner.Instance
-	rnd := acctest.RandString(10)
+	rnd := randString(t, 10)
 	displayName := fmt.Sprintf("spanner-test-%s-dname", rnd)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -198,8 +228,8 @@ func TestAccSpannerInstance_duplicateNameError(t *testing.T) {
 	t.Parallel()
 
 	var instance spanner.Instance
-	rnd := acctest.RandString(10)
-	idName := fmt.Sprintf("spanner-test-%s", rnd)
+	rnd := randString(t, 10)
+	idName := fmt.Sprintf("tf-test-spanner-%s", rnd)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -224,7 +254,7 @@ func TestAccSpannerInstance_update(t *testing.T) {
 	t.Parallel()
 
 	var instance spanner.Instance
-	rnd := acctest.RandString(10)
+	rnd := randString(t, 10)
 	dName1 := fmt.Sprintf("spanner-dname1-%s", rnd)
 	dName2 := fmt.Sprintf("spanner-dname2-%s", rnd)
 	resource.Test(t, resource.TestCase{
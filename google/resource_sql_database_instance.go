@@ -694,7 +694,7 @@ func resourceSqlDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(instance.Name)
 
-	err = sqladminOperationWaitTime(config, op, project, "Create Instance", int(d.Timeout(schema.TimeoutCreate).Minutes()))
+	err = sqladminOperationWaitTime(config, op, project, "Create Instance", d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		d.SetId("")
 		return err
@@ -721,7 +721,7 @@ func resourceSqlDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 				err = retry(func() error {
 					op, err = config.clientSqlAdmin.Users.Delete(project, instance.Name, u.Host, u.Name).Do()
 					if err == nil {
-						err = sqladminOperationWaitTime(config, op, project, "Delete default root User", int(d.Timeout(schema.TimeoutCreate).Minutes()))
+						err = sqladminOperationWaitTime(config, op, project, "Delete default root User", d.Timeout(schema.TimeoutCreate))
 					}
 					return err
 				})
@@ -1055,7 +1055,7 @@ func resourceSqlDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error, failed to update instance %s: %s", instance.Name, err)
 	}
 
-	err = sqladminOperationWaitTime(config, op, project, "Update Instance", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+	err = sqladminOperationWaitTime(config, op, project, "Update Instance", d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return err
 	}
@@ -1077,7 +1077,7 @@ func resourceSqlDatabaseInstanceDelete(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error, failed to delete instance %s: %s", d.Get("name").(string), err)
 	}
 
-	err = sqladminOperationWaitTime(config, op, project, "Delete Instance", int(d.Timeout(schema.TimeoutDelete).Minutes()))
+	err = sqladminOperationWaitTime(config, op, project, "Delete Instance", d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
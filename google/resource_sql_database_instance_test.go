@@ -14,7 +14,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 
@@ -22,10 +21,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("gcp_sql_db_instance", &resource.Sweeper{
-		Name: "gcp_sql_db_instance",
-		F:    testSweepDatabases,
-	})
+	addTestSweeper("gcp_sql_db_instance", testSweepDatabases)
 }
 
 func testSweepDatabases(region string) error {
@@ -53,7 +49,7 @@ func testSweepDatabases(region string) error {
 
 	for _, d := range found.Items {
 		var testDbInstance bool
-		for _, testName := range []string{"tf-lw-", "sqldatabasetest"} {
+		for _, testName := range []string{"tf-lw-", "sqldatabasetest", sweeperTestPrefix} {
 			// only destroy instances we know to fit our test naming pattern
 			if strings.HasPrefix(d.Name, testName) {
 				testDbInstance = true
@@ -143,7 +139,7 @@ func TestAccSqlDatabaseInstance_basic(t *testing.T) {
 	t.Parallel()
 
 	var instance sqladmin.DatabaseInstance
-	databaseID := acctest.RandInt()
+	databaseID := randInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -191,7 +187,7 @@ func TestAccSqlDatabaseInstance_basic3(t *testing.T) {
 	t.Parallel()
 
 	var instance sqladmin.DatabaseInstance
-	databaseID := acctest.RandInt()
+	databaseID := randInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -218,8 +214,8 @@ func TestAccSqlDatabaseInstance_dontDeleteDefaultUserOnReplica(t *testing.T) {
 	t.Parallel()
 
 	var instance sqladmin.DatabaseInstance
-	databaseName := "sql-instance-test-" + acctest.RandString(10)
-	failoverName := "sql-instance-test-failover-" + acctest.RandString(10)
+	databaseName := "sql-instance-test-" + randString(t, 10)
+	failoverName := "sql-instance-test-failover-" + randString(t, 10)
 	// 1. Create an instance.
 	// 2. Add a root@'%' user.
 	// 3. Create a replica and assert it succeeds (it'll fail if we try to delete the root user thinking it's a
@@ -244,7 +240,7 @@ func TestAccSqlDatabaseInstance_dontDeleteDefaultUserOnReplica(t *testing.T) {
 					user := sqladmin.User{
 						Name:     "root",
 						Host:     "%",
-						Password: acctest.RandString(26),
+						Password: randString(t, 26),
 					}
 					op, err := config.clientSqlAdmin.Users.Insert(config.Project, databaseName, &user).Do()
 					if err != nil {
@@ -267,7 +263,7 @@ func TestAccSqlDatabaseInstance_settings_basic(t *testing.T) {
 	t.Parallel()
 
 	var instance sqladmin.DatabaseInstance
-	databaseID := acctest.RandInt()
+	databaseID := randInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -292,8 +288,8 @@ func TestAccSqlDatabaseInstance_slave(t *testing.T) {
 	t.Parallel()
 
 	var instance sqladmin.DatabaseInstance
-	masterID := acctest.RandInt()
-	slaveID := acctest.RandInt()
+	masterID := randInt(t)
+	slaveID := randInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -322,7 +318,7 @@ func TestAccSqlDatabaseInstance_highAvailability(t *testing.T) {
 	t.Parallel()
 
 	var instance sqladmin.DatabaseInstance
-	instanceID := acctest.RandInt()
+	instanceID := randInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -356,7 +352,7 @@ func TestAccSqlDatabaseInstance_diskspecs(t *testing.T) {
 	t.Parallel()
 
 	var instance sqladmin.DatabaseInstance
-	masterID := acctest.RandInt()
+	masterID := randInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -381,7 +377,7 @@ func TestAccSqlDatabaseInstance_maintenance(t *testing.T) {
 	t.Parallel()
 
 	var instance sqladmin.DatabaseInstance
-	masterID := acctest.RandInt()
+	masterID := randInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -406,7 +402,7 @@ func TestAccSqlDatabaseInstance_settings_upgrade(t *testing.T) {
 	t.Parallel()
 
 	var instance sqladmin.DatabaseInstance
-	databaseID := acctest.RandInt()
+	databaseID := randInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -441,7 +437,7 @@ func TestAccSqlDatabaseInstance_settingsDowngrade(t *testing.T) {
 	t.Parallel()
 
 	var instance sqladmin.DatabaseInstance
-	databaseID := acctest.RandInt()
+	databaseID := randInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -478,7 +474,7 @@ func TestAccSqlDatabaseInstance_authNets(t *testing.T) {
 	// var instance sqladmin.DatabaseInstance
 	)
 
-	databaseID := acctest.RandInt()
+	databaseID := randInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -506,7 +502,7 @@ func TestAccSqlDatabaseInstance_authNets(t *testing.T) {
 func TestAccSqlDatabaseInstance_multipleOperations(t *testing.T) {
 	t.Parallel()
 
-	databaseID, instanceID, userID := acctest.RandString(8), acctest.RandString(8), acctest.RandString(8)
+	databaseID, instanceID, userID := randString(t, 8), randString(t, 8), randString(t, 8)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -525,7 +521,7 @@ func TestAccSqlDatabaseInstance_basic_with_user_labels(t *testing.T) {
 	t.Parallel()
 
 	var instance sqladmin.DatabaseInstance
-	databaseID := acctest.RandInt()
+	databaseID := randInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -12,7 +11,7 @@ import (
 func TestAccSqlUser_firstGen(t *testing.T) {
 	t.Parallel()
 
-	instance := acctest.RandomWithPrefix("i")
+	instance := randomWithPrefix(t, "i")
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -47,7 +46,7 @@ func TestAccSqlUser_firstGen(t *testing.T) {
 func TestAccSqlUser_secondGen(t *testing.T) {
 	t.Parallel()
 
-	instance := acctest.RandomWithPrefix("i")
+	instance := randomWithPrefix(t, "i")
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
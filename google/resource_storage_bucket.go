@@ -27,6 +27,8 @@ func resourceStorageBucket() *schema.Resource {
 			State: resourceStorageBucketStateImporter,
 		},
 
+		CustomizeDiff: effectiveLabelsCustomizeDiff(false),
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -46,6 +48,8 @@ func resourceStorageBucket() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"effective_labels": effectiveLabelsSchema(),
+
 			"location": &schema.Schema{
 				Type:     schema.TypeString,
 				Default:  "US",
@@ -251,7 +255,7 @@ func resourceStorageBucketCreate(d *schema.ResourceData, meta interface{}) error
 	// Create a bucket, setting the acl, location and name.
 	sb := &storage.Bucket{
 		Name:     bucket,
-		Labels:   expandLabels(d),
+		Labels:   expandLabels(d, config),
 		Location: location,
 	}
 
@@ -373,16 +377,19 @@ func resourceStorageBucketUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if d.HasChange("labels") {
-		sb.Labels = expandLabels(d)
+	if hasLabelsChange(d) {
+		sb.Labels = expandLabels(d, config)
 		if len(sb.Labels) == 0 {
 			sb.NullFields = append(sb.NullFields, "Labels")
 		}
 
 		// To delete a label using PATCH, we have to explicitly set its value
-		// to null.
-		old, _ := d.GetChange("labels")
-		for k := range old.(map[string]interface{}) {
+		// to null. This includes labels inherited from the provider that were
+		// removed from its default labels.
+		oldLabels, _ := d.GetChange("labels")
+		oldEffectiveLabels, _ := d.GetChange("effective_labels")
+		old := mergeMaps(oldEffectiveLabels.(map[string]interface{}), oldLabels.(map[string]interface{}))
+		for k := range old {
 			if _, ok := sb.Labels[k]; !ok {
 				sb.NullFields = append(sb.NullFields, fmt.Sprintf("Labels.%s", k))
 			}
@@ -401,7 +408,9 @@ func resourceStorageBucketUpdate(d *schema.ResourceData, meta interface{}) error
 	d.Set("self_link", res.SelfLink)
 	d.SetId(res.Id)
 
-	return nil
+	// The labels of the bucket may include labels inherited from the
+	// provider, so they are read back.
+	return resourceStorageBucketRead(d, meta)
 }
 
 func resourceStorageBucketRead(d *schema.ResourceData, meta interface{}) error {
@@ -443,7 +452,9 @@ func resourceStorageBucketRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("logging", flattenBucketLogging(res.Logging))
 	d.Set("versioning", flattenBucketVersioning(res.Versioning))
 	d.Set("lifecycle_rule", flattenBucketLifecycle(res.Lifecycle))
-	d.Set("labels", res.Labels)
+	if err := setLabels(d, config, res.Labels); err != nil {
+		return fmt.Errorf("Error setting labels: %s", err)
+	}
 	d.SetId(res.Id)
 	return nil
 }
@@ -17,6 +17,9 @@ func resourceStorageBucketAcl() *schema.Resource {
 		Read:   resourceStorageBucketAclRead,
 		Update: resourceStorageBucketAclUpdate,
 		Delete: resourceStorageBucketAclDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceStorageBucketAclImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": &schema.Schema{
@@ -273,6 +276,29 @@ func resourceStorageBucketAclUpdate(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
+func resourceStorageBucketAclImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"(?P<bucket>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Only role_entity is read, and only once set, so import the whole ACL.
+	bucket := d.Get("bucket").(string)
+	res, err := config.clientStorage.BucketAccessControls.List(bucket).Do()
+	if err != nil {
+		return nil, fmt.Errorf("Error reading Storage Bucket ACL for bucket %q: %s", bucket, err)
+	}
+	entities := make([]string, 0, len(res.Items))
+	for _, item := range res.Items {
+		entities = append(entities, item.Role+":"+item.Entity)
+	}
+	d.Set("role_entity", entities)
+
+	d.SetId(getBucketAclId(bucket))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceStorageBucketAclDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -5,7 +5,6 @@ import (
 	"os"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -21,14 +20,14 @@ var (
 	roleEntityViewers = "READER:project-viewers-" + os.Getenv("GOOGLE_PROJECT_NUMBER")
 )
 
-func testBucketName() string {
-	return fmt.Sprintf("%s-%d", "tf-test-acl-bucket", acctest.RandInt())
+func testBucketName(t *testing.T) string {
+	return fmt.Sprintf("%s-%d", "tf-test-acl-bucket", randInt(t))
 }
 
 func TestAccStorageBucketAcl_basic(t *testing.T) {
 	t.Parallel()
 
-	bucketName := testBucketName()
+	bucketName := testBucketName(t)
 	skipIfEnvNotSet(t, "GOOGLE_PROJECT_NUMBER")
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -49,7 +48,7 @@ func TestAccStorageBucketAcl_basic(t *testing.T) {
 func TestAccStorageBucketAcl_upgrade(t *testing.T) {
 	t.Parallel()
 
-	bucketName := testBucketName()
+	bucketName := testBucketName(t)
 	skipIfEnvNotSet(t, "GOOGLE_PROJECT_NUMBER")
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -87,7 +86,7 @@ func TestAccStorageBucketAcl_upgrade(t *testing.T) {
 func TestAccStorageBucketAcl_downgrade(t *testing.T) {
 	t.Parallel()
 
-	bucketName := testBucketName()
+	bucketName := testBucketName(t)
 	skipIfEnvNotSet(t, "GOOGLE_PROJECT_NUMBER")
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -125,7 +124,7 @@ func TestAccStorageBucketAcl_downgrade(t *testing.T) {
 func TestAccStorageBucketAcl_predefined(t *testing.T) {
 	t.Parallel()
 
-	bucketName := testBucketName()
+	bucketName := testBucketName(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -6,16 +6,16 @@ import (
 	"sort"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-google/google/fakegcp"
 )
 
 func TestAccStorageBucketIamBinding(t *testing.T) {
 	t.Parallel()
 
-	bucket := acctest.RandomWithPrefix("tf-test")
-	account := acctest.RandomWithPrefix("tf-test")
+	bucket := randomWithPrefix(t, "tf-test")
+	account := randomWithPrefix(t, "tf-test")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -36,6 +36,12 @@ func TestAccStorageBucketIamBinding(t *testing.T) {
 					fmt.Sprintf("serviceAccount:%s-2@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
 				}),
 			},
+			{
+				ResourceName:      "google_storage_bucket_iam_binding.foo",
+				ImportStateId:     fmt.Sprintf("%s roles/storage.objectViewer", bucket),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -43,8 +49,8 @@ func TestAccStorageBucketIamBinding(t *testing.T) {
 func TestAccStorageBucketIamPolicy(t *testing.T) {
 	t.Parallel()
 
-	bucket := acctest.RandomWithPrefix("tf-test")
-	account := acctest.RandomWithPrefix("tf-test")
+	bucket := randomWithPrefix(t, "tf-test")
+	account := randomWithPrefix(t, "tf-test")
 	serviceAcct := getTestServiceAccountFromEnv(t)
 
 	resource.Test(t, resource.TestCase{
@@ -66,6 +72,14 @@ func TestAccStorageBucketIamPolicy(t *testing.T) {
 					fmt.Sprintf("serviceAccount:%s-2@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
 				}),
 			},
+			{
+				ResourceName:      "google_storage_bucket_iam_policy.bucket-binding",
+				ImportStateId:     bucket,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Defaults aren't set on import.
+				ImportStateVerifyIgnore: []string{"manage_legacy_roles"},
+			},
 		},
 	})
 }
@@ -73,8 +87,8 @@ func TestAccStorageBucketIamPolicy(t *testing.T) {
 func TestAccStorageBucketIamMember(t *testing.T) {
 	t.Parallel()
 
-	bucket := acctest.RandomWithPrefix("tf-test")
-	account := acctest.RandomWithPrefix("tf-test")
+	bucket := randomWithPrefix(t, "tf-test")
+	account := randomWithPrefix(t, "tf-test")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -87,10 +101,153 @@ func TestAccStorageBucketIamMember(t *testing.T) {
 					fmt.Sprintf("serviceAccount:%s-1@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
 				}),
 			},
+			{
+				ResourceName:      "google_storage_bucket_iam_member.foo",
+				ImportStateId:     fmt.Sprintf("%s roles/storage.admin serviceAccount:%s-1@%s.iam.gserviceaccount.com", bucket, account, getTestProjectFromEnv()),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+// Test that the policy preserves the legacy roles added to the bucket on
+// creation, unless it manages them.
+func TestFakeStorageBucketIamPolicy_legacyRoles(t *testing.T) {
+	t.Parallel()
+
+	s := newFakeGcpServer(t)
+	defer s.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testFakeGcpProviders(),
+		CheckDestroy: testFakeGcpCheckDestroy(s),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeStorageBucketIamPolicy(s, false, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testFakeStorageBucketIamMembers(s, "roles/storage.objectViewer", 1),
+					testFakeStorageBucketIamMembers(s, "roles/storage.legacyBucketOwner", 2),
+					testFakeStorageBucketIamMembers(s, "roles/storage.legacyBucketReader", 1),
+				),
+			},
+			{
+				Config:                  testFakeGcpProviderConfig(s),
+				ResourceName:            "google_storage_bucket_iam_policy.policy",
+				ImportStateId:           "b/bucket-test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"manage_legacy_roles"},
+			},
+			// A legacy role in the policy replaces the legacy binding.
+			{
+				Config: testFakeStorageBucketIamPolicy(s, false, fmt.Sprintf("projectOwner:%s", fakeGcpProject)),
+				Check: resource.ComposeTestCheckFunc(
+					testFakeStorageBucketIamMembers(s, "roles/storage.legacyBucketOwner", 1),
+					testFakeStorageBucketIamMembers(s, "roles/storage.legacyBucketReader", 1),
+				),
+			},
+			{
+				Config: testFakeStorageBucketIamPolicy(s, true, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testFakeStorageBucketIamMembers(s, "roles/storage.objectViewer", 1),
+					testFakeStorageBucketIamMembers(s, "roles/storage.legacyBucketOwner", 0),
+					testFakeStorageBucketIamMembers(s, "roles/storage.legacyBucketReader", 0),
+				),
+			},
+		},
+	})
+}
+
+func TestFakeStorageBucketIamMember_import(t *testing.T) {
+	t.Parallel()
+
+	s := newFakeGcpServer(t)
+	defer s.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testFakeGcpProviders(),
+		CheckDestroy: testFakeGcpCheckDestroy(s),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeGcpProviderConfig(s) + `
+resource "google_storage_bucket" "bucket" {
+	name = "bucket-test"
+}
+
+resource "google_storage_bucket_iam_member" "member" {
+	bucket = "${google_storage_bucket.bucket.name}"
+	role   = "roles/storage.objectViewer"
+	member = "user:jane@example.com"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					testFakeStorageBucketIamMembers(s, "roles/storage.objectViewer", 1),
+					testFakeStorageBucketIamMembers(s, "roles/storage.legacyBucketOwner", 2),
+				),
+			},
+			{
+				Config:            testFakeGcpProviderConfig(s),
+				ResourceName:      "google_storage_bucket_iam_member.member",
+				ImportStateId:     "bucket-test roles/storage.objectViewer user:jane@example.com",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testFakeStorageBucketIamMembers checks that the policy of the bucket of
+// testFakeStorageBucketIamPolicy has count members for role.
+func testFakeStorageBucketIamMembers(s *fakegcp.Server, role string, count int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		bindings, _ := s.IamPolicy("storage/b/bucket-test")["bindings"].([]interface{})
+		var members []interface{}
+		for _, raw := range bindings {
+			binding := raw.(map[string]interface{})
+			if binding["role"] == role {
+				members = append(members, binding["members"].([]interface{})...)
+			}
+		}
+		if len(members) != count {
+			return fmt.Errorf("Expected %d members of %s, got %v", count, role, members)
+		}
+		return nil
+	}
+}
+
+// testFakeStorageBucketIamPolicy returns a config setting the policy of a
+// bucket, which grants roles/storage.legacyBucketOwner to legacyOwner if set.
+func testFakeStorageBucketIamPolicy(s *fakegcp.Server, manageLegacyRoles bool, legacyOwner string) string {
+	config := testFakeGcpProviderConfig(s) + `
+resource "google_storage_bucket" "bucket" {
+	name = "bucket-test"
+}
+
+data "google_iam_policy" "policy" {
+	binding {
+		role    = "roles/storage.objectViewer"
+		members = ["user:jane@example.com"]
+	}
+`
+	if legacyOwner != "" {
+		config += fmt.Sprintf(`
+	binding {
+		role    = "roles/storage.legacyBucketOwner"
+		members = ["%s"]
+	}
+`, legacyOwner)
+	}
+	return config + fmt.Sprintf(`}
+
+resource "google_storage_bucket_iam_policy" "policy" {
+	bucket              = "${google_storage_bucket.bucket.name}"
+	policy_data         = "${data.google_iam_policy.policy.policy_data}"
+	manage_legacy_roles = %t
+}
+`, manageLegacyRoles)
+}
+
 func testAccCheckGoogleStorageBucketIam(bucket, role string, members []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := testAccProvider.Meta().(*Config)
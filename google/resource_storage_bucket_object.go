@@ -21,6 +21,9 @@ func resourceStorageBucketObject() *schema.Resource {
 		Create: resourceStorageBucketObjectCreate,
 		Read:   resourceStorageBucketObjectRead,
 		Delete: resourceStorageBucketObjectDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceStorageBucketObjectImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": &schema.Schema{
@@ -67,10 +70,11 @@ func resourceStorageBucketObject() *schema.Resource {
 			},
 
 			"content": &schema.Schema{
-				Type:          schema.TypeString,
-				Optional:      true,
-				ForceNew:      true,
-				ConflictsWith: []string{"source"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"source"},
+				DiffSuppressFunc: storageBucketObjectImportedDataDiffSuppress,
 			},
 
 			"crc32c": &schema.Schema{
@@ -91,10 +95,11 @@ func resourceStorageBucketObject() *schema.Resource {
 			},
 
 			"source": &schema.Schema{
-				Type:          schema.TypeString,
-				Optional:      true,
-				ForceNew:      true,
-				ConflictsWith: []string{"content"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"content"},
+				DiffSuppressFunc: storageBucketObjectImportedDataDiffSuppress,
 			},
 
 			// Detect changes to local file or changes made outside of Terraform to the file stored on the server.
@@ -266,6 +271,39 @@ func resourceStorageBucketObjectDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func resourceStorageBucketObjectImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	// Object names may contain slashes.
+	if err := parseImportId([]string{"gs://(?P<bucket>[^/]+)/(?P<name>.+)", "(?P<bucket>[^/]+)/(?P<name>.+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{bucket}}-{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// Imported objects have neither content nor source in state, as neither can
+// be read back. Keep them as long as the configured data matches the object.
+func storageBucketObjectImportedDataDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	if old != "" || new == "" || d.Id() == "" {
+		return false
+	}
+
+	md5hash := d.Get("md5hash").(string)
+	localMd5Hash := getContentMd5Hash([]byte(new))
+	if k == "source" {
+		localMd5Hash = getFileMd5Hash(new)
+	}
+
+	return md5hash != "" && localMd5Hash == md5hash
+}
+
 func getFileMd5Hash(filename string) string {
 	data, err := ioutil.ReadFile(filename)
 	if err != nil {
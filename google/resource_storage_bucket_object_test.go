@@ -23,7 +23,7 @@ const (
 func TestAccStorageObject_basic(t *testing.T) {
 	t.Parallel()
 
-	bucketName := testBucketName()
+	bucketName := testBucketName(t)
 	data := []byte("data data data")
 	h := md5.New()
 	h.Write(data)
@@ -40,6 +40,12 @@ func TestAccStorageObject_basic(t *testing.T) {
 				Config: testGoogleStorageBucketsObjectBasic(bucketName, testFile.Name()),
 				Check:  testAccCheckGoogleStorageObject(bucketName, objectName, data_md5),
 			},
+			resource.TestStep{
+				ResourceName:            "google_storage_bucket_object.object",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source"},
+			},
 		},
 	})
 }
@@ -47,7 +53,7 @@ func TestAccStorageObject_basic(t *testing.T) {
 func TestAccStorageObject_recreate(t *testing.T) {
 	t.Parallel()
 
-	bucketName := testBucketName()
+	bucketName := testBucketName(t)
 
 	writeFile := func(name string, data []byte) string {
 		h := md5.New()
@@ -88,7 +94,7 @@ func TestAccStorageObject_recreate(t *testing.T) {
 func TestAccStorageObject_content(t *testing.T) {
 	t.Parallel()
 
-	bucketName := testBucketName()
+	bucketName := testBucketName(t)
 	data := []byte(content)
 	h := md5.New()
 	h.Write(data)
@@ -118,7 +124,7 @@ func TestAccStorageObject_content(t *testing.T) {
 func TestAccStorageObject_withContentCharacteristics(t *testing.T) {
 	t.Parallel()
 
-	bucketName := testBucketName()
+	bucketName := testBucketName(t)
 	data := []byte(content)
 	h := md5.New()
 	h.Write(data)
@@ -160,7 +166,7 @@ func TestAccStorageObject_dynamicContent(t *testing.T) {
 		CheckDestroy: testAccStorageObjectDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testGoogleStorageBucketsObjectDynamicContent(testBucketName()),
+				Config: testGoogleStorageBucketsObjectDynamicContent(testBucketName(t)),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
 						"google_storage_bucket_object.object", "content_type", "text/plain; charset=utf-8"),
@@ -175,7 +181,7 @@ func TestAccStorageObject_dynamicContent(t *testing.T) {
 func TestAccStorageObject_cacheControl(t *testing.T) {
 	t.Parallel()
 
-	bucketName := testBucketName()
+	bucketName := testBucketName(t)
 	data := []byte(content)
 	h := md5.New()
 	h.Write(data)
@@ -204,7 +210,7 @@ func TestAccStorageObject_cacheControl(t *testing.T) {
 func TestAccStorageObject_storageClass(t *testing.T) {
 	t.Parallel()
 
-	bucketName := testBucketName()
+	bucketName := testBucketName(t)
 	data := []byte(content)
 	h := md5.New()
 	h.Write(data)
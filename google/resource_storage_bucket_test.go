@@ -2,23 +2,91 @@ package google
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-google/google/fakegcp"
 
 	"google.golang.org/api/googleapi"
 	"google.golang.org/api/storage/v1"
 )
 
+func init() {
+	addTestSweeper("gcp_storage_bucket", testSweepStorageBuckets)
+}
+
+func testSweepStorageBuckets(region string) error {
+	config, err := sweeperConfig(region)
+	if err != nil {
+		return err
+	}
+
+	err = config.clientStorage.Buckets.List(config.Project).Prefix(sweeperTestPrefix).Pages(context.Background(), func(res *storage.Buckets) error {
+		for _, bucket := range res.Items {
+			log.Printf("[INFO] Sweeping bucket %s", bucket.Name)
+
+			// Buckets can only be deleted once empty, including the
+			// noncurrent versions of objects.
+			err := config.clientStorage.Objects.List(bucket.Name).Versions(true).Pages(context.Background(), func(objects *storage.Objects) error {
+				for _, object := range objects.Items {
+					if err := config.clientStorage.Objects.Delete(bucket.Name, object.Name).Generation(object.Generation).Do(); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			if err == nil {
+				err = config.clientStorage.Buckets.Delete(bucket.Name).Do()
+			}
+			if err != nil {
+				log.Printf("[INFO] Error deleting bucket %s: %s", bucket.Name, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error listing buckets: %s", err)
+	}
+	return nil
+}
+
+func TestFakeStorageBucket_labels(t *testing.T) {
+	t.Parallel()
+
+	s := newFakeGcpServer(t)
+	defer s.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testFakeGcpProviders(),
+		CheckDestroy: testFakeGcpCheckDestroy(s),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testFakeStorageBucket_labels(s, "my_value"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_storage_bucket.bucket", "location", "EU"),
+					resource.TestCheckResourceAttr("google_storage_bucket.bucket", "project", fakeGcpProject),
+				),
+			},
+			// Buckets are imported without a project, which is looked up from
+			// the project number of the bucket.
+			testFakeGcpImportStep(s, "google_storage_bucket.bucket"),
+			resource.TestStep{
+				Config: testFakeStorageBucket_labels(s, "my_other_value"),
+				Check:  resource.TestCheckResourceAttr("google_storage_bucket.bucket", "labels.my_key", "my_other_value"),
+			},
+		},
+	})
+}
+
 func TestAccStorageBucket_basic(t *testing.T) {
 	t.Parallel()
 
 	var bucket storage.Bucket
-	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", acctest.RandInt())
+	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", randInt(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -51,7 +119,7 @@ func TestAccStorageBucket_lowercaseLocation(t *testing.T) {
 	t.Parallel()
 
 	var bucket storage.Bucket
-	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", acctest.RandInt())
+	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", randInt(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -73,7 +141,7 @@ func TestAccStorageBucket_customAttributes(t *testing.T) {
 	t.Parallel()
 
 	var bucket storage.Bucket
-	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", acctest.RandInt())
+	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", randInt(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -101,7 +169,7 @@ func TestAccStorageBucket_lifecycleRules(t *testing.T) {
 	t.Parallel()
 
 	var bucket storage.Bucket
-	bucketName := fmt.Sprintf("tf-test-acc-bucket-%d", acctest.RandInt())
+	bucketName := fmt.Sprintf("tf-test-acc-bucket-%d", randInt(t))
 
 	hash_step0_lc0_action := resourceGCSBucketLifecycleRuleActionHash(map[string]interface{}{"type": "SetStorageClass", "storage_class": "NEARLINE"})
 	hash_step0_lc0_condition := resourceGCSBucketLifecycleRuleConditionHash(map[string]interface{}{"age": 2, "created_before": "", "is_live": false, "num_newer_versions": 0})
@@ -156,7 +224,7 @@ func TestAccStorageBucket_storageClass(t *testing.T) {
 	t.Parallel()
 
 	var bucket storage.Bucket
-	bucketName := fmt.Sprintf("tf-test-acc-bucket-%d", acctest.RandInt())
+	bucketName := fmt.Sprintf("tf-test-acc-bucket-%d", randInt(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -211,7 +279,7 @@ func TestAccStorageBucket_update(t *testing.T) {
 	t.Parallel()
 
 	var bucket storage.Bucket
-	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", acctest.RandInt())
+	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", randInt(t))
 
 	hash_step2_lc0_action := resourceGCSBucketLifecycleRuleActionHash(map[string]interface{}{"type": "Delete", "storage_class": ""})
 	hash_step2_lc0_condition := resourceGCSBucketLifecycleRuleConditionHash(map[string]interface{}{"age": 10, "created_before": "", "is_live": false, "num_newer_versions": 0})
@@ -330,7 +398,7 @@ func TestAccStorageBucket_forceDestroy(t *testing.T) {
 	t.Parallel()
 
 	var bucket storage.Bucket
-	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", acctest.RandInt())
+	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", randInt(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -351,7 +419,7 @@ func TestAccStorageBucket_forceDestroy(t *testing.T) {
 				),
 			},
 			resource.TestStep{
-				Config: testAccStorageBucket_customAttributes(acctest.RandomWithPrefix("tf-test-acl-bucket")),
+				Config: testAccStorageBucket_customAttributes(randomWithPrefix(t, "tf-test-acl-bucket")),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckStorageBucketMissing(bucketName),
 				),
@@ -364,7 +432,7 @@ func TestAccStorageBucket_forceDestroyWithVersioning(t *testing.T) {
 	t.Parallel()
 
 	var bucket storage.Bucket
-	bucketName := fmt.Sprintf("tf-test-acc-bucket-%d", acctest.RandInt())
+	bucketName := fmt.Sprintf("tf-test-acc-bucket-%d", randInt(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -398,7 +466,7 @@ func TestAccStorageBucket_versioning(t *testing.T) {
 	t.Parallel()
 
 	var bucket storage.Bucket
-	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", acctest.RandInt())
+	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", randInt(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -429,7 +497,7 @@ func TestAccStorageBucket_logging(t *testing.T) {
 	t.Parallel()
 
 	var bucket storage.Bucket
-	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", acctest.RandInt())
+	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", randInt(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -479,7 +547,7 @@ func TestAccStorageBucket_cors(t *testing.T) {
 	t.Parallel()
 
 	var bucket storage.Bucket
-	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", acctest.RandInt())
+	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", randInt(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -551,7 +619,7 @@ func TestAccStorageBucket_labels(t *testing.T) {
 	t.Parallel()
 
 	var bucket storage.Bucket
-	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", acctest.RandInt())
+	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", randInt(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -913,3 +981,15 @@ resource "google_storage_bucket" "bucket" {
 }
 `, bucketName)
 }
+
+func testFakeStorageBucket_labels(s *fakegcp.Server, label string) string {
+	return testFakeGcpProviderConfig(s) + fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name     = "bucket-test"
+	location = "eu"
+
+	labels {
+		my_key = "%s"
+	}
+}`, label)
+}
@@ -14,6 +14,9 @@ func resourceStorageDefaultObjectAcl() *schema.Resource {
 		Read:   resourceStorageDefaultObjectAclRead,
 		Update: resourceStorageDefaultObjectAclUpdate,
 		Delete: resourceStorageDefaultObjectAclDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceStorageDefaultObjectAclImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": &schema.Schema{
@@ -160,6 +163,29 @@ func resourceStorageDefaultObjectAclUpdate(d *schema.ResourceData, meta interfac
 	return resourceStorageDefaultObjectAclRead(d, meta)
 }
 
+func resourceStorageDefaultObjectAclImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"(?P<bucket>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Only the entities already in state are read, so import every entity.
+	bucket := d.Get("bucket").(string)
+	res, err := config.clientStorage.DefaultObjectAccessControls.List(bucket).Do()
+	if err != nil {
+		return nil, fmt.Errorf("Error reading Storage Default Object ACL for bucket %q: %s", bucket, err)
+	}
+	roleEntities := make([]string, 0, len(res.Items))
+	for _, v := range res.Items {
+		roleEntities = append(roleEntities, fmt.Sprintf("%s:%s", v.Role, v.Entity))
+	}
+	d.Set("role_entity", roleEntities)
+
+	d.SetId(bucket)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceStorageDefaultObjectAclDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -11,7 +11,7 @@ import (
 func TestAccStorageDefaultObjectAcl_basic(t *testing.T) {
 	t.Parallel()
 
-	bucketName := testBucketName()
+	bucketName := testBucketName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -32,7 +32,7 @@ func TestAccStorageDefaultObjectAcl_basic(t *testing.T) {
 func TestAccStorageDefaultObjectAcl_upgrade(t *testing.T) {
 	t.Parallel()
 
-	bucketName := testBucketName()
+	bucketName := testBucketName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -70,7 +70,7 @@ func TestAccStorageDefaultObjectAcl_upgrade(t *testing.T) {
 func TestAccStorageDefaultObjectAcl_downgrade(t *testing.T) {
 	t.Parallel()
 
-	bucketName := testBucketName()
+	bucketName := testBucketName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
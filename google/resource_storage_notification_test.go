@@ -6,7 +6,6 @@ import (
 	"reflect"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/storage/v1"
@@ -23,8 +22,8 @@ func TestAccStorageNotification_basic(t *testing.T) {
 	skipIfEnvNotSet(t, "GOOGLE_PROJECT")
 
 	var notification storage.Notification
-	bucketName := testBucketName()
-	topicName := fmt.Sprintf("tf-pstopic-test-%d", acctest.RandInt())
+	bucketName := testBucketName(t)
+	topicName := fmt.Sprintf("tf-pstopic-test-%d", randInt(t))
 	topic := fmt.Sprintf("//pubsub.googleapis.com/projects/%s/topics/%s", os.Getenv("GOOGLE_PROJECT"), topicName)
 
 	resource.Test(t, resource.TestCase{
@@ -67,8 +66,8 @@ func TestAccStorageNotification_withEventsAndAttributes(t *testing.T) {
 	skipIfEnvNotSet(t, "GOOGLE_PROJECT")
 
 	var notification storage.Notification
-	bucketName := testBucketName()
-	topicName := fmt.Sprintf("tf-pstopic-test-%d", acctest.RandInt())
+	bucketName := testBucketName(t)
+	topicName := fmt.Sprintf("tf-pstopic-test-%d", randInt(t))
 	topic := fmt.Sprintf("//pubsub.googleapis.com/projects/%s/topics/%s", os.Getenv("GOOGLE_PROJECT"), topicName)
 	eventType1 := "OBJECT_FINALIZE"
 	eventType2 := "OBJECT_ARCHIVE"
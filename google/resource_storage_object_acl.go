@@ -15,6 +15,9 @@ func resourceStorageObjectAcl() *schema.Resource {
 		Read:   resourceStorageObjectAclRead,
 		Update: resourceStorageObjectAclUpdate,
 		Delete: resourceStorageObjectAclDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceStorageObjectAclImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": &schema.Schema{
@@ -219,6 +222,31 @@ func resourceStorageObjectAclUpdate(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
+func resourceStorageObjectAclImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	// Object names may contain slashes.
+	if err := parseImportId([]string{"gs://(?P<bucket>[^/]+)/(?P<object>.+)", "(?P<bucket>[^/]+)/(?P<object>.+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Only the entities already in state are read, so import every entity.
+	bucket := d.Get("bucket").(string)
+	object := d.Get("object").(string)
+	res, err := config.clientStorage.ObjectAccessControls.List(bucket, object).Do()
+	if err != nil {
+		return nil, fmt.Errorf("Error reading Storage Object ACL for object %q in bucket %q: %s", object, bucket, err)
+	}
+	role_entity := make([]string, 0, len(res.Items))
+	for _, v := range res.Items {
+		role_entity = append(role_entity, fmt.Sprintf("%s:%s", v.Role, v.Entity))
+	}
+	d.Set("role_entity", role_entity)
+
+	d.SetId(getObjectAclId(object))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceStorageObjectAclDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
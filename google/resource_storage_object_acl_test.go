@@ -22,7 +22,7 @@ func testAclObjectName() string {
 func TestAccStorageObjectAcl_basic(t *testing.T) {
 	t.Parallel()
 
-	bucketName := testBucketName()
+	bucketName := testBucketName(t)
 	objectName := testAclObjectName()
 	objectData := []byte("data data data")
 	ioutil.WriteFile(tfObjectAcl.Name(), objectData, 0644)
@@ -52,7 +52,7 @@ func TestAccStorageObjectAcl_basic(t *testing.T) {
 func TestAccStorageObjectAcl_upgrade(t *testing.T) {
 	t.Parallel()
 
-	bucketName := testBucketName()
+	bucketName := testBucketName(t)
 	objectName := testAclObjectName()
 	objectData := []byte("data data data")
 	ioutil.WriteFile(tfObjectAcl.Name(), objectData, 0644)
@@ -104,7 +104,7 @@ func TestAccStorageObjectAcl_upgrade(t *testing.T) {
 func TestAccStorageObjectAcl_downgrade(t *testing.T) {
 	t.Parallel()
 
-	bucketName := testBucketName()
+	bucketName := testBucketName(t)
 	objectName := testAclObjectName()
 	objectData := []byte("data data data")
 	ioutil.WriteFile(tfObjectAcl.Name(), objectData, 0644)
@@ -156,7 +156,7 @@ func TestAccStorageObjectAcl_downgrade(t *testing.T) {
 func TestAccStorageObjectAcl_predefined(t *testing.T) {
 	t.Parallel()
 
-	bucketName := testBucketName()
+	bucketName := testBucketName(t)
 	objectName := testAclObjectName()
 	objectData := []byte("data data data")
 	ioutil.WriteFile(tfObjectAcl.Name(), objectData, 0644)
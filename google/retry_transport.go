@@ -0,0 +1,112 @@
+package google
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"google.golang.org/api/googleapi"
+)
+
+const (
+	retryTransportMinBackoff = 500 * time.Millisecond
+	retryTransportMaxBackoff = 30 * time.Second
+)
+
+// retryTransport sends a request again, with exponential backoff, when it
+// fails with an error matching one of its predicates. It is the single place
+// where transient errors of the generated API clients and of sendRequest are
+// retried. The total time spent on a request, retries included, is bounded
+// by the timeout of the http.Client using the transport.
+type retryTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+	minBackoff time.Duration
+	predicates []RetryErrorPredicateFunc
+}
+
+func newRetryTransport(base http.RoundTripper, maxRetries int, predicates ...RetryErrorPredicateFunc) *retryTransport {
+	if len(predicates) == 0 {
+		predicates = defaultErrorRetryPredicates
+	}
+	return &retryTransport{
+		base:       base,
+		maxRetries: maxRetries,
+		minBackoff: retryTransportMinBackoff,
+		predicates: predicates,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Requests whose body can't be read twice are sent once.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return t.base.RoundTrip(req)
+	}
+
+	ctx := req.Context()
+	backoff := t.minBackoff
+	for attempt := 0; ; attempt++ {
+		r := req
+		if attempt > 0 && req.GetBody != nil {
+			// RoundTrippers must not modify the original request.
+			r = new(http.Request)
+			*r = *req
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r.Body = body
+		}
+
+		res, err := t.base.RoundTrip(r)
+		if attempt >= t.maxRetries {
+			return res, err
+		}
+
+		retryErr := err
+		if err == nil {
+			retryErr = checkResponseForRetry(res)
+		}
+		if !isRetryableError(retryErr, t.predicates...) {
+			return res, err
+		}
+		if res != nil {
+			googleapi.CloseBody(res)
+		}
+
+		wait := backoff + time.Duration(rand.Int63n(int64(backoff)))
+		log.Printf("[DEBUG] Retrying %s %s in %s (attempt %d of %d)", req.Method, req.URL, wait, attempt+1, t.maxRetries)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+
+		backoff *= 2
+		if backoff > retryTransportMaxBackoff {
+			backoff = retryTransportMaxBackoff
+		}
+	}
+}
+
+// checkResponseForRetry returns the error described by an unsuccessful
+// response, leaving its body readable by the caller.
+func checkResponseForRetry(res *http.Response) error {
+	if res.StatusCode < 300 {
+		return nil
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	res.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	copied := *res
+	copied.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return googleapi.CheckResponse(&copied)
+}
@@ -0,0 +1,148 @@
+package google
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"google.golang.org/api/googleapi"
+)
+
+func newTestRetryTransport(maxRetries int) *retryTransport {
+	t := newRetryTransport(http.DefaultTransport, maxRetries)
+	t.minBackoff = time.Millisecond
+	return t
+}
+
+func TestRetryTransport_retriesTransientErrors(t *testing.T) {
+	var requests int
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if requests < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newTestRetryTransport(5)}
+	res, err := client.Post(server.URL, "application/json", bytes.NewBufferString(`{"name":"foo"}`))
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", res.StatusCode)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+	for i, b := range bodies {
+		if b != `{"name":"foo"}` {
+			t.Errorf("expected request %d to send the original body, got %q", i, b)
+		}
+	}
+}
+
+func TestRetryTransport_maxRetries(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newTestRetryTransport(2)}
+	res, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("expected status 429, got %d", res.StatusCode)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+}
+
+func TestRetryTransport_nonRetryableError(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error": {"code": 400, "message": "bad request"}}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newTestRetryTransport(5)}
+	res, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+	defer res.Body.Close()
+
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+	err = googleapi.CheckResponse(res)
+	if gerr, ok := err.(*googleapi.Error); !ok || gerr.Message != "bad request" {
+		t.Errorf("expected the error of the response to be readable, got %v", err)
+	}
+}
+
+func TestErrorRetryPredicates(t *testing.T) {
+	cases := map[string]struct {
+		Err       error
+		Retryable bool
+	}{
+		"server error": {
+			Err:       &googleapi.Error{Code: 503},
+			Retryable: true,
+		},
+		"rate limit exceeded": {
+			Err:       &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "rateLimitExceeded"}}},
+			Retryable: true,
+		},
+		"permission denied": {
+			Err:       &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}},
+			Retryable: false,
+		},
+		"resource not ready": {
+			Err:       &googleapi.Error{Code: 400, Errors: []googleapi.ErrorItem{{Reason: "resourceNotReady"}}},
+			Retryable: true,
+		},
+		"conflict on a resource that is not ready": {
+			Err:       &googleapi.Error{Code: 409, Body: "The resource 'projects/foo/global/networks/bar' is not ready"},
+			Retryable: true,
+		},
+		"conflict": {
+			Err:       &googleapi.Error{Code: 409, Body: "The resource already exists"},
+			Retryable: false,
+		},
+		"connection reset": {
+			Err:       fmt.Errorf("read tcp 127.0.0.1:1234->127.0.0.1:443: read: connection reset by peer"),
+			Retryable: true,
+		},
+		"other error": {
+			Err:       fmt.Errorf("invalid character"),
+			Retryable: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		if retryable := isRetryableError(tc.Err, defaultErrorRetryPredicates...); retryable != tc.Retryable {
+			t.Errorf("bad: %s, expected retryable to be %t", tn, tc.Retryable)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package google
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func retry(retryFunc func() error) error {
+	return retryTime(retryFunc, 1)
+}
+
+func retryTime(retryFunc func() error, minutes int) error {
+	return retryTimeDuration(retryFunc, time.Duration(minutes)*time.Minute)
+}
+
+// retryTimeDuration calls retryFunc until it succeeds, fails with an error that
+// isn't retryable, or the timeout expires. Errors are retryable if they match
+// the default predicates of the retry transport or one of the extra predicates.
+// Unlike the retry transport, retryFunc may read the resource again before each
+// attempt, which makes this suitable for errors such as concurrent modifications.
+func retryTimeDuration(retryFunc func() error, duration time.Duration, errorRetryPredicates ...RetryErrorPredicateFunc) error {
+	predicates := append(errorRetryPredicates, defaultErrorRetryPredicates...)
+	return resource.Retry(duration, func() *resource.RetryError {
+		err := retryFunc()
+		if err == nil {
+			return nil
+		}
+		if isRetryableError(err, predicates...) {
+			return resource.RetryableError(err)
+		}
+		return resource.NonRetryableError(err)
+	})
+}
@@ -41,18 +41,18 @@ func (w *ServiceAccountKeyWaiter) Conf() *resource.StateChangeConf {
 	}
 }
 
-func serviceAccountKeyWaitTime(client *iam.ProjectsServiceAccountsKeysService, keyName, publicKeyType, activity string, timeoutMin int) error {
+func serviceAccountKeyWaitTime(config *Config, keyName, publicKeyType, activity string, timeout time.Duration) error {
 	w := &ServiceAccountKeyWaiter{
-		Service:       client,
+		Service:       config.clientIAM.Projects.ServiceAccounts.Keys,
 		PublicKeyType: publicKeyType,
 		KeyName:       keyName,
 	}
 
 	state := w.Conf()
 	state.Delay = 10 * time.Second
-	state.Timeout = time.Duration(timeoutMin) * time.Minute
+	state.Timeout = timeout
 	state.MinTimeout = 2 * time.Second
-	_, err := state.WaitForState()
+	_, err := waitForStateContext(config.stopContext(), state)
 	if err != nil {
 		return fmt.Errorf("Error waiting for %s: %s", activity, err)
 	}
@@ -3,11 +3,8 @@ package google
 import (
 	"bytes"
 	"fmt"
-	"log"
 	"time"
 
-	"github.com/hashicorp/terraform/helper/resource"
-	"google.golang.org/api/googleapi"
 	"google.golang.org/api/sqladmin/v1beta4"
 )
 
@@ -17,29 +14,44 @@ type SqlAdminOperationWaiter struct {
 	Project string
 }
 
-func (w *SqlAdminOperationWaiter) RefreshFunc() resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		log.Printf("[DEBUG] self_link: %s", w.Op.SelfLink)
-		op, err := w.Service.Operations.Get(w.Project, w.Op.Name).Do()
+func (w *SqlAdminOperationWaiter) QueryOp() (interface{}, error) {
+	return w.Service.Operations.Get(w.Project, w.Op.Name).Do()
+}
 
-		if e, ok := err.(*googleapi.Error); ok && (e.Code == 429 || e.Code == 503) {
-			return w.Op, "PENDING", nil
-		} else if err != nil {
-			return nil, "", err
-		}
+func (w *SqlAdminOperationWaiter) SetOp(op interface{}) error {
+	var ok bool
+	w.Op, ok = op.(*sqladmin.Operation)
+	if !ok {
+		return fmt.Errorf("Expected a *sqladmin.Operation, got %T", op)
+	}
+	return nil
+}
 
-		log.Printf("[DEBUG] Got %q when asking for operation %q", op.Status, w.Op.Name)
+func (w *SqlAdminOperationWaiter) State() string {
+	return w.Op.Status
+}
 
-		return op, op.Status, nil
-	}
+func (w *SqlAdminOperationWaiter) PendingStates() []string {
+	return []string{"PENDING", "RUNNING"}
+}
+
+func (w *SqlAdminOperationWaiter) TargetStates() []string {
+	return []string{"DONE"}
 }
 
-func (w *SqlAdminOperationWaiter) Conf() *resource.StateChangeConf {
-	return &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING"},
-		Target:  []string{"DONE"},
-		Refresh: w.RefreshFunc(),
+func (w *SqlAdminOperationWaiter) Error() error {
+	if w.Op.Error != nil {
+		return SqlAdminOperationError(*w.Op.Error)
 	}
+	return nil
+}
+
+func (w *SqlAdminOperationWaiter) OpName() string {
+	return w.Op.Name
+}
+
+func (w *SqlAdminOperationWaiter) Progress() int {
+	return -1
 }
 
 // SqlAdminOperationError wraps sqladmin.OperationError and implements the
@@ -57,29 +69,15 @@ func (e SqlAdminOperationError) Error() string {
 }
 
 func sqladminOperationWait(config *Config, op *sqladmin.Operation, project, activity string) error {
-	return sqladminOperationWaitTime(config, op, project, activity, 10)
+	return sqladminOperationWaitTime(config, op, project, activity, 10*time.Minute)
 }
 
-func sqladminOperationWaitTime(config *Config, op *sqladmin.Operation, project, activity string, timeoutMinutes int) error {
+func sqladminOperationWaitTime(config *Config, op *sqladmin.Operation, project, activity string, timeout time.Duration) error {
 	w := &SqlAdminOperationWaiter{
 		Service: config.clientSqlAdmin,
 		Op:      op,
 		Project: project,
 	}
 
-	state := w.Conf()
-	state.Timeout = time.Duration(timeoutMinutes) * time.Minute
-	state.MinTimeout = 2 * time.Second
-	state.Delay = 5 * time.Second
-	opRaw, err := state.WaitForState()
-	if err != nil {
-		return fmt.Errorf("Error waiting for %s (op %s): %s", activity, op.Name, err)
-	}
-
-	op = opRaw.(*sqladmin.Operation)
-	if op.Error != nil {
-		return SqlAdminOperationError(*op.Error)
-	}
-
-	return nil
+	return OperationWait(config, w, activity, timeout)
 }
@@ -0,0 +1,78 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// stopContextTransport cancels in-flight requests, and fails new ones, once
+// its context is done. The provider uses its stop context, which Terraform
+// cancels when the user interrupts a run, so that every API call made through
+// the generated clients and sendRequest returns promptly.
+type stopContextTransport struct {
+	ctx  context.Context
+	base http.RoundTripper
+}
+
+func (t *stopContextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.ctx.Err(); err != nil {
+		return nil, fmt.Errorf("Error sending request to %s: %s", req.URL.Host, err)
+	}
+
+	ctx, cancel := context.WithCancel(req.Context())
+	go func() {
+		select {
+		case <-t.ctx.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	res, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// The request must not be cancelled before its response is read.
+	res.Body = &cancelOnCloseBody{ReadCloser: res.Body, cancel: cancel}
+	return res, nil
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// waitForStateContext waits like conf.WaitForState, but returns as soon as ctx
+// is done instead of at the next refresh. The operation being waited for may
+// still be running on the server, so resources must not assume it failed.
+func waitForStateContext(ctx context.Context, conf *resource.StateChangeConf) (interface{}, error) {
+	type result struct {
+		state interface{}
+		err   error
+	}
+
+	ch := make(chan result, 1)
+	go func() {
+		state, err := conf.WaitForState()
+		ch <- result{state, err}
+	}()
+
+	select {
+	case r := <-ch:
+		return r.state, r.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("interrupted: %s", ctx.Err())
+	}
+}
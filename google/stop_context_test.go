@@ -0,0 +1,84 @@
+package google
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestStopContextTransport_cancelsInFlightRequests(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-done:
+		}
+	}))
+	defer server.Close()
+	defer close(done)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client := &http.Client{
+		Transport: &stopContextTransport{ctx: ctx, base: http.DefaultTransport},
+	}
+
+	time.AfterFunc(50*time.Millisecond, cancel)
+	start := time.Now()
+	if _, err := client.Get(server.URL); err == nil {
+		t.Fatalf("expected the request to be cancelled")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the request to be cancelled promptly, took %s", elapsed)
+	}
+
+	if _, err := client.Get(server.URL); err == nil {
+		t.Errorf("expected requests sent after the context is done to fail")
+	}
+}
+
+func TestStopContextTransport_readsResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &stopContextTransport{ctx: context.Background(), base: http.DefaultTransport},
+	}
+	res, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("error: %s", err)
+	}
+	defer res.Body.Close()
+	var body map[string]interface{}
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		t.Errorf("error reading response: %s", err)
+	}
+}
+
+func TestWaitForStateContext(t *testing.T) {
+	conf := &resource.StateChangeConf{
+		Pending: []string{"RUNNING"},
+		Target:  []string{"DONE"},
+		Refresh: func() (interface{}, string, error) {
+			return 42, "RUNNING", nil
+		},
+		Timeout:    time.Minute,
+		MinTimeout: 10 * time.Second,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	start := time.Now()
+	if _, err := waitForStateContext(ctx, conf); err == nil {
+		t.Fatalf("expected the wait to be interrupted")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the wait to be interrupted promptly, took %s", elapsed)
+	}
+}
@@ -3,10 +3,12 @@ package google
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
 	"reflect"
 	"regexp"
+	"sort"
 	"strings"
 
 	"google.golang.org/api/googleapi"
@@ -29,7 +31,8 @@ type serializableBody struct {
 	// with an empty value appearing in NullFields will be sent to the
 	// server as null. It is an error if a field in this list has a
 	// non-empty value. This may be used to include null fields in Patch
-	// requests.
+	// requests. Fields nested in objects are named using their path,
+	// e.g. "labels.foo".
 	NullFields []string
 }
 
@@ -47,19 +50,65 @@ func (b *serializableBody) MarshalJSON() ([]byte, error) {
 	// from the encoding if the field has an empty value, defined as
 	// false, 0, a nil pointer, a nil interface value, and any empty array,
 	// slice, map, or string.
-
-	// TODO: Add support for ForceSendFields and NullFields.
+	m := make(map[string]interface{}, len(b.body))
 	for k, v := range b.body {
-		if isEmptyValue(reflect.ValueOf(v)) {
-			delete(b.body, k)
+		if !isEmptyValue(reflect.ValueOf(v)) || (v != nil && containsString(b.ForceSendFields, k)) {
+			m[k] = v
+		}
+	}
+
+	for _, f := range b.NullFields {
+		if err := setNullField(m, strings.Split(f, ".")); err != nil {
+			return nil, fmt.Errorf("Error serializing null field %q: %s", f, err)
 		}
 	}
 
-	return json.Marshal(b.body)
+	return json.Marshal(m)
+}
+
+// setNullField sets the field at path to nil, copying the objects along the
+// path so that the body given by the caller isn't modified.
+func setNullField(m map[string]interface{}, path []string) error {
+	k := path[0]
+	if len(path) == 1 {
+		if v, ok := m[k]; ok && !isEmptyValue(reflect.ValueOf(v)) {
+			return fmt.Errorf("field has a non-empty value")
+		}
+		m[k] = nil
+		return nil
+	}
+
+	nested := make(map[string]interface{})
+	switch v := m[k].(type) {
+	case nil:
+	case map[string]interface{}:
+		for nk, nv := range v {
+			nested[nk] = nv
+		}
+	case map[string]string:
+		for nk, nv := range v {
+			nested[nk] = nv
+		}
+	default:
+		return fmt.Errorf("%q is not an object", k)
+	}
+	m[k] = nested
+	return setNullField(nested, path[1:])
+}
+
+func containsString(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
 }
 
 func isEmptyValue(v reflect.Value) bool {
 	switch v.Kind() {
+	case reflect.Invalid:
+		return true
 	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
 		return v.Len() == 0
 	case reflect.Bool:
@@ -76,31 +125,97 @@ func isEmptyValue(v reflect.Value) bool {
 	return false
 }
 
-func Post(config *Config, rawurl string, body map[string]interface{}) (map[string]interface{}, error) {
-	return sendRequest(config, "POST", rawurl, body)
+func Post(config *Config, rawurl, project string, body map[string]interface{}) (map[string]interface{}, error) {
+	return sendRequest(config, "POST", rawurl, project, body)
 }
 
-func Get(config *Config, rawurl string) (map[string]interface{}, error) {
-	return sendRequest(config, "GET", rawurl, nil)
+func Get(config *Config, rawurl, project string) (map[string]interface{}, error) {
+	return sendRequest(config, "GET", rawurl, project, nil)
 }
 
-func Put(config *Config, rawurl string, body map[string]interface{}) (map[string]interface{}, error) {
-	return sendRequest(config, "PUT", rawurl, body)
+func Put(config *Config, rawurl, project string, body map[string]interface{}) (map[string]interface{}, error) {
+	return sendRequest(config, "PUT", rawurl, project, body)
 }
 
-func Delete(config *Config, rawurl string) (map[string]interface{}, error) {
-	return sendRequest(config, "DELETE", rawurl, nil)
+func Delete(config *Config, rawurl, project string) (map[string]interface{}, error) {
+	return sendRequest(config, "DELETE", rawurl, project, nil)
 }
 
-func sendRequest(config *Config, method, rawurl string, body map[string]interface{}) (map[string]interface{}, error) {
+// Patch updates the fields of the resource at rawurl listed in updateMask,
+// using their values in body. The fields of the mask are sent even if their
+// value is empty, e.g. `false` or `0`, and are cleared if they are missing
+// from body. APIs that don't use update masks update every field present in
+// the body; use a nil updateMask and sendRequestWithFields for them.
+func Patch(config *Config, rawurl, project string, body map[string]interface{}, updateMask []string) (map[string]interface{}, error) {
+	if len(updateMask) == 0 {
+		return sendRequest(config, "PATCH", rawurl, project, body)
+	}
+
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, err
+	}
+	q := u.Query()
+	q.Set("updateMask", strings.Join(updateMask, ","))
+	u.RawQuery = q.Encode()
+
+	var forceSendFields []string
+	for _, path := range updateMask {
+		forceSendFields = append(forceSendFields, strings.SplitN(path, ".", 2)[0])
+	}
+
+	return sendRequestWithFields(config, "PATCH", u.String(), project, body, forceSendFields, nil)
+}
+
+// buildUpdateMask returns the update mask of a PATCH request updating the
+// fields of d that changed, given the API field path of each Terraform field.
+// Several Terraform fields may map to the same API field.
+func buildUpdateMask(d TerraformResourceData, fields map[string]string) []string {
+	updateMask := make([]string, 0)
+	for tfField, apiField := range fields {
+		if d.HasChange(tfField) && !containsString(updateMask, apiField) {
+			updateMask = append(updateMask, apiField)
+		}
+	}
+	sort.Strings(updateMask)
+	return updateMask
+}
+
+// sendRequest sends a request to a REST API on behalf of a resource of the
+// given project, which is billed for quota when the provider is configured
+// with user_project_override. The project may be empty for resources that
+// don't belong to one. Fields of body with an empty value are omitted.
+func sendRequest(config *Config, method, rawurl, project string, body map[string]interface{}) (map[string]interface{}, error) {
+	return sendRequestWithFields(config, method, rawurl, project, body, nil, nil)
+}
+
+// sendRequestWithFields is like sendRequest, except that the fields of body
+// listed in forceSendFields are sent even if their value is empty, and the
+// fields listed in nullFields are sent as null. See serializableBody.
+func sendRequestWithFields(config *Config, method, rawurl, project string, body map[string]interface{}, forceSendFields, nullFields []string) (map[string]interface{}, error) {
+	return sendRequestWithHeaders(config, method, rawurl, project, nil, body, forceSendFields, nullFields)
+}
+
+// sendRequestWithHeaders is like sendRequestWithFields, except that headers
+// are added to the request, e.g. an If-Match precondition.
+func sendRequestWithHeaders(config *Config, method, rawurl, project string, headers http.Header, body map[string]interface{}, forceSendFields, nullFields []string) (map[string]interface{}, error) {
 	reqHeaders := make(http.Header)
+	for k, v := range headers {
+		reqHeaders[k] = v
+	}
 	reqHeaders.Set("User-Agent", config.userAgent)
 	reqHeaders.Set("Content-Type", "application/json")
+	if userProject := config.userProject(project); userProject != "" {
+		reqHeaders.Set(userProjectHeader, userProject)
+	}
 
 	var buf bytes.Buffer
 	if body != nil {
 		err := json.NewEncoder(&buf).Encode(&serializableBody{
-			body: body})
+			body:            body,
+			ForceSendFields: forceSendFields,
+			NullFields:      nullFields,
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -118,6 +233,7 @@ func sendRequest(config *Config, method, rawurl string, body map[string]interfac
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(config.stopContext())
 	req.Header = reqHeaders
 	res, err := config.client.Do(req)
 	if err != nil {
@@ -136,6 +252,32 @@ func sendRequest(config *Config, method, rawurl string, body map[string]interfac
 	return result, nil
 }
 
+const userProjectHeader = "X-Goog-User-Project"
+
+// userProjectTransport sets the X-Goog-User-Project header on every request
+// that doesn't already carry one, so that the given project is billed for
+// quota instead of the project of the resource.
+type userProjectTransport struct {
+	project string
+	base    http.RoundTripper
+}
+
+func (t *userProjectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get(userProjectHeader) != "" {
+		return t.base.RoundTrip(req)
+	}
+
+	// RoundTrippers must not modify the original request.
+	r := new(http.Request)
+	*r = *req
+	r.Header = make(http.Header, len(req.Header)+1)
+	for k, v := range req.Header {
+		r.Header[k] = v
+	}
+	r.Header.Set(userProjectHeader, t.project)
+	return t.base.RoundTrip(r)
+}
+
 func replaceVars(d TerraformResourceData, config *Config, linkTmpl string) (string, error) {
 	re := regexp.MustCompile("{{([[:word:]]+)}}")
 	var project, region, zone string
@@ -173,6 +315,11 @@ func replaceVars(d TerraformResourceData, config *Config, linkTmpl string) (stri
 		if m == "zone" {
 			return zone
 		}
+		if key := strings.TrimSuffix(m, "BasePath"); key != m {
+			if _, ok := lookupCustomEndpoint(key); ok {
+				return config.basePath(key)
+			}
+		}
 		v, ok := d.GetOk(m)
 		if ok {
 			return v.(string)
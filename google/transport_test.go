@@ -1,6 +1,12 @@
 package google
 
 import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -82,6 +88,24 @@ func TestReplaceVars(t *testing.T) {
 			},
 			Expected: "projects/project1/zones/zone1/instances/instance1",
 		},
+		"default base path": {
+			Template: "{{ComputeBasePath}}projects/{{project}}/global/networks",
+			Config: &Config{
+				Project: "default-project",
+			},
+			Expected: "https://www.googleapis.com/compute/v1/projects/default-project/global/networks",
+		},
+		"custom base path": {
+			Template: "{{RedisBasePath}}projects/{{project}}/locations/{{region}}/instances",
+			Config: &Config{
+				Project: "default-project",
+				Region:  "default-region",
+				CustomEndpoints: map[string]string{
+					RedisBasePathKey: "http://localhost:8080/v1beta1/",
+				},
+			},
+			Expected: "http://localhost:8080/v1beta1/projects/default-project/locations/default-region/instances",
+		},
 	}
 
 	for tn, tc := range cases {
@@ -112,3 +136,152 @@ func TestReplaceVars(t *testing.T) {
 		}
 	}
 }
+
+func TestSendRequest_userProject(t *testing.T) {
+	cases := map[string]struct {
+		Config   *Config
+		Project  string
+		Expected string
+	}{
+		"no override": {
+			Config:   &Config{BillingProject: "billing-project"},
+			Project:  "resource-project",
+			Expected: "",
+		},
+		"resource project": {
+			Config:   &Config{UserProjectOverride: true},
+			Project:  "resource-project",
+			Expected: "resource-project",
+		},
+		"billing project": {
+			Config:   &Config{UserProjectOverride: true, BillingProject: "billing-project"},
+			Project:  "resource-project",
+			Expected: "billing-project",
+		},
+	}
+
+	for tn, tc := range cases {
+		var got string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = r.Header.Get("X-Goog-User-Project")
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{}`))
+		}))
+
+		tc.Config.client = server.Client()
+		if _, err := Get(tc.Config, server.URL, tc.Project); err != nil {
+			t.Errorf("bad: %s; unexpected error %s", tn, err)
+		}
+		server.Close()
+
+		if got != tc.Expected {
+			t.Errorf("bad: %s; expected X-Goog-User-Project %q, got %q", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestSerializableBody(t *testing.T) {
+	cases := map[string]struct {
+		Body            map[string]interface{}
+		ForceSendFields []string
+		NullFields      []string
+		Expected        string
+		ExpectedError   bool
+	}{
+		"empty values are omitted": {
+			Body:     map[string]interface{}{"name": "foo", "description": "", "enabled": false, "size": 0, "network": nil},
+			Expected: `{"name":"foo"}`,
+		},
+		"force send fields": {
+			Body:            map[string]interface{}{"name": "foo", "enabled": false, "size": 0, "network": nil},
+			ForceSendFields: []string{"enabled", "size", "network"},
+			Expected:        `{"enabled":false,"name":"foo","size":0}`,
+		},
+		"null fields": {
+			Body:       map[string]interface{}{"name": "foo", "description": ""},
+			NullFields: []string{"description", "network"},
+			Expected:   `{"description":null,"name":"foo","network":null}`,
+		},
+		"nested null fields": {
+			Body:       map[string]interface{}{"labels": map[string]string{"foo": "bar"}},
+			NullFields: []string{"labels.baz"},
+			Expected:   `{"labels":{"baz":null,"foo":"bar"}}`,
+		},
+		"null field with a value": {
+			Body:          map[string]interface{}{"name": "foo"},
+			NullFields:    []string{"name"},
+			ExpectedError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		b, err := json.Marshal(&serializableBody{
+			body:            tc.Body,
+			ForceSendFields: tc.ForceSendFields,
+			NullFields:      tc.NullFields,
+		})
+		if err != nil {
+			if !tc.ExpectedError {
+				t.Errorf("bad: %s; unexpected error %s", tn, err)
+			}
+			continue
+		}
+		if tc.ExpectedError {
+			t.Errorf("bad: %s; expected error", tn)
+			continue
+		}
+		if string(b) != tc.Expected {
+			t.Errorf("bad: %s; expected %s, got %s", tn, tc.Expected, b)
+		}
+	}
+}
+
+func TestPatch_updateMask(t *testing.T) {
+	var gotMethod, gotMask, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotMask = r.URL.Query().Get("updateMask")
+		b, _ := ioutil.ReadAll(r.Body)
+		gotBody = strings.TrimSpace(string(b))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	config := &Config{client: server.Client()}
+	body := map[string]interface{}{
+		"displayName":  "",
+		"memorySizeGb": 2,
+		"tier":         "",
+	}
+	if _, err := Patch(config, server.URL, "project", body, []string{"displayName", "memorySizeGb"}); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if gotMethod != "PATCH" {
+		t.Errorf("expected method PATCH, got %s", gotMethod)
+	}
+	if expected := "displayName,memorySizeGb"; gotMask != expected {
+		t.Errorf("expected updateMask %q, got %q", expected, gotMask)
+	}
+	if expected := `{"displayName":"","memorySizeGb":2}`; gotBody != expected {
+		t.Errorf("expected body %s, got %s", expected, gotBody)
+	}
+}
+
+func TestBuildUpdateMask(t *testing.T) {
+	d := &ResourceDataMock{
+		FieldsWithHasChange: []string{"display_name", "effective_labels"},
+	}
+	fields := map[string]string{
+		"display_name":     "displayName",
+		"labels":           "labels",
+		"effective_labels": "labels",
+		"memory_size_gb":   "memorySizeGb",
+	}
+
+	expected := []string{"displayName", "labels"}
+	if actual := buildUpdateMask(d, fields); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected update mask %v, got %v", expected, actual)
+	}
+}
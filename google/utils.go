@@ -5,11 +5,10 @@ package google
 import (
 	"fmt"
 	"log"
+	"reflect"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/errwrap"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	computeBeta "google.golang.org/api/compute/v0.beta"
@@ -142,6 +141,15 @@ func handleNotFoundError(err error, d *schema.ResourceData, resource string) err
 	return fmt.Errorf("Error reading %s: %s", resource, err)
 }
 
+// clearIdUnlessStopped removes a resource whose creation failed while waiting
+// for its operation from the state. The resource is kept if the provider was
+// stopped, as the operation may still be running and create it.
+func clearIdUnlessStopped(d *schema.ResourceData, config *Config) {
+	if !config.stopped() {
+		d.SetId("")
+	}
+}
+
 func isGoogleApiErrorWithCode(err error, errCode int) bool {
 	gerr, ok := errwrap.GetType(err, &googleapi.Error{}).(*googleapi.Error)
 	return ok && gerr != nil && gerr.Code == errCode
@@ -178,6 +186,18 @@ func isConflictError(err error) bool {
 	return false
 }
 
+func isPreconditionFailedError(err error) bool {
+	if e, ok := err.(*googleapi.Error); ok && e.Code == 412 {
+		return true
+	} else if !ok && errwrap.ContainsType(err, &googleapi.Error{}) {
+		e := errwrap.GetType(err, &googleapi.Error{}).(*googleapi.Error)
+		if e.Code == 412 {
+			return true
+		}
+	}
+	return false
+}
+
 func linkDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
 	if GetResourceNameFromSelfLink(old) == new {
 		return true
@@ -248,9 +268,92 @@ func rfc3339TimeDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
 	return false
 }
 
-// expandLabels pulls the value of "labels" out of a schema.ResourceData as a map[string]string.
-func expandLabels(d *schema.ResourceData) map[string]string {
-	return expandStringMap(d, "labels")
+// expandLabels pulls the value of "labels" out of a schema.ResourceData as a map[string]string,
+// merged on top of the default labels of the provider.
+func expandLabels(d *schema.ResourceData, config *Config) map[string]string {
+	return mergeDefaultLabels(config, expandStringMap(d, "labels"))
+}
+
+// mergeDefaultLabels returns the default labels of the provider overridden by labels.
+func mergeDefaultLabels(config *Config, labels map[string]string) map[string]string {
+	m := make(map[string]string)
+	for k, v := range config.DefaultLabels {
+		m[k] = v
+	}
+	for k, v := range labels {
+		m[k] = v
+	}
+	return m
+}
+
+// setLabels stores the labels of a resource as read from the API in "effective_labels",
+// and in "labels" without the labels inherited from the default labels of the provider.
+// A default label is only kept in "labels" if it was already there, e.g. because the
+// resource sets it explicitly, so that inherited labels don't show up as a diff.
+func setLabels(d *schema.ResourceData, config *Config, labels map[string]string) error {
+	current := expandStringMap(d, "labels")
+	flattened := make(map[string]string)
+	for k, v := range labels {
+		if dv, ok := config.DefaultLabels[k]; ok && dv == v {
+			if _, ok := current[k]; !ok {
+				continue
+			}
+		}
+		flattened[k] = v
+	}
+
+	if err := d.Set("labels", flattened); err != nil {
+		return err
+	}
+	return d.Set("effective_labels", labels)
+}
+
+// hasLabelsChange returns whether the labels of a resource must be updated, either
+// because its own labels or the default labels of the provider changed.
+func hasLabelsChange(d *schema.ResourceData) bool {
+	return d.HasChange("labels") || d.HasChange("effective_labels")
+}
+
+// effectiveLabelsSchema returns the schema of the "effective_labels" attribute of a
+// labelable resource, holding every label present on the resource.
+func effectiveLabelsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+// effectiveLabelsCustomizeDiff plans a change of "effective_labels" whenever the labels
+// of the resource or the default labels of the provider change, so that updating the
+// default labels updates every resource. Resources whose labels can't be updated in
+// place should set forceNew.
+func effectiveLabelsCustomizeDiff(forceNew bool) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		config := meta.(*Config)
+
+		// The new labels may not be known until apply time.
+		if d.HasChange("labels") {
+			return d.SetNewComputed("effective_labels")
+		}
+
+		labels := mergeDefaultLabels(config, convertStringMap(d.Get("labels").(map[string]interface{})))
+		effective := convertStringMap(d.Get("effective_labels").(map[string]interface{}))
+		if reflect.DeepEqual(labels, effective) {
+			return nil
+		}
+
+		// Labels removed from the default labels are only missing from
+		// the new labels.
+		log.Printf("[DEBUG] Default labels changed, updating effective_labels from %v to %v", effective, labels)
+		if err := d.SetNew("effective_labels", labels); err != nil {
+			return err
+		}
+		if forceNew && d.Id() != "" {
+			return d.ForceNew("effective_labels")
+		}
+		return nil
+	}
 }
 
 // expandStringMap pulls the value of key out of a schema.ResourceData as a map[string]string.
@@ -329,23 +432,6 @@ func mergeResourceMaps(ms ...map[string]*schema.Resource) map[string]*schema.Res
 	return merged
 }
 
-func retry(retryFunc func() error) error {
-	return retryTime(retryFunc, 1)
-}
-
-func retryTime(retryFunc func() error, minutes int) error {
-	return resource.Retry(time.Duration(minutes)*time.Minute, func() *resource.RetryError {
-		err := retryFunc()
-		if err == nil {
-			return nil
-		}
-		if gerr, ok := err.(*googleapi.Error); ok && (gerr.Code == 429 || gerr.Code == 500 || gerr.Code == 502 || gerr.Code == 503) {
-			return resource.RetryableError(gerr)
-		}
-		return resource.NonRetryableError(err)
-	})
-}
-
 func extractFirstMapConfig(m []interface{}) map[string]interface{} {
 	if len(m) == 0 {
 		return map[string]interface{}{}
@@ -376,3 +462,17 @@ func serviceAccountFQN(serviceAccount, project string) string {
 	}
 	return serviceAccount
 }
+
+func mergeMaps(a, b map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{})
+
+	for k, v := range a {
+		merged[k] = v
+	}
+
+	for k, v := range b {
+		merged[k] = v
+	}
+
+	return merged
+}
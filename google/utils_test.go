@@ -5,7 +5,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestConvertStringArr(t *testing.T) {
@@ -471,3 +473,140 @@ func TestServiceAccountFQN(t *testing.T) {
 		}
 	}
 }
+
+func TestMergeDefaultLabels(t *testing.T) {
+	config := &Config{
+		DefaultLabels: map[string]string{"env": "test", "team": "infra"},
+	}
+
+	expected := map[string]string{"env": "prod", "team": "infra", "app": "web"}
+	actual := mergeDefaultLabels(config, map[string]string{"env": "prod", "app": "web"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("%v did not match expected value: %v", actual, expected)
+	}
+
+	expected = map[string]string{"env": "test", "team": "infra"}
+	actual = mergeDefaultLabels(config, nil)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("%v did not match expected value: %v", actual, expected)
+	}
+}
+
+func TestSetLabels(t *testing.T) {
+	config := &Config{
+		DefaultLabels: map[string]string{"env": "test", "team": "infra"},
+	}
+	cases := map[string]struct {
+		Labels            map[string]interface{}
+		Remote            map[string]string
+		ExpectedLabels    map[string]string
+		ExpectedEffective map[string]string
+	}{
+		"inherited labels are not read into labels": {
+			Labels:            map[string]interface{}{"app": "web"},
+			Remote:            map[string]string{"app": "web", "env": "test", "team": "infra"},
+			ExpectedLabels:    map[string]string{"app": "web"},
+			ExpectedEffective: map[string]string{"app": "web", "env": "test", "team": "infra"},
+		},
+		"labels set to the default value are kept": {
+			Labels:            map[string]interface{}{"env": "test"},
+			Remote:            map[string]string{"env": "test", "team": "infra"},
+			ExpectedLabels:    map[string]string{"env": "test"},
+			ExpectedEffective: map[string]string{"env": "test", "team": "infra"},
+		},
+		"overridden default labels are read into labels": {
+			Labels:            map[string]interface{}{},
+			Remote:            map[string]string{"env": "prod", "team": "infra"},
+			ExpectedLabels:    map[string]string{"env": "prod"},
+			ExpectedEffective: map[string]string{"env": "prod", "team": "infra"},
+		},
+	}
+
+	for tn, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceStorageBucket().Schema, map[string]interface{}{
+			"name":   "foo",
+			"labels": tc.Labels,
+		})
+		if err := setLabels(d, config, tc.Remote); err != nil {
+			t.Fatalf("bad: %s, error setting labels: %s", tn, err)
+		}
+		if actual := expandStringMap(d, "labels"); !reflect.DeepEqual(tc.ExpectedLabels, actual) {
+			t.Errorf("bad: %s, labels %v did not match expected value: %v", tn, actual, tc.ExpectedLabels)
+		}
+		if actual := expandStringMap(d, "effective_labels"); !reflect.DeepEqual(tc.ExpectedEffective, actual) {
+			t.Errorf("bad: %s, effective_labels %v did not match expected value: %v", tn, actual, tc.ExpectedEffective)
+		}
+	}
+}
+
+func TestEffectiveLabelsCustomizeDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "foo",
+		Attributes: map[string]string{
+			"name":                  "foo",
+			"labels.%":              "1",
+			"labels.app":            "web",
+			"effective_labels.%":    "2",
+			"effective_labels.app":  "web",
+			"effective_labels.team": "infra",
+		},
+	}
+	cases := map[string]struct {
+		DefaultLabels     map[string]string
+		ExpectedEffective map[string]string
+	}{
+		"unchanged default labels": {
+			DefaultLabels: map[string]string{"team": "infra"},
+		},
+		"added default label": {
+			DefaultLabels:     map[string]string{"team": "infra", "env": "test"},
+			ExpectedEffective: map[string]string{"app": "web", "team": "infra", "env": "test"},
+		},
+		"changed default label": {
+			DefaultLabels:     map[string]string{"team": "platform"},
+			ExpectedEffective: map[string]string{"app": "web", "team": "platform"},
+		},
+		"removed default label": {
+			DefaultLabels:     map[string]string{},
+			ExpectedEffective: map[string]string{"app": "web"},
+		},
+	}
+
+	for tn, tc := range cases {
+		raw, err := config.NewRawConfig(map[string]interface{}{
+			"name":   "foo",
+			"labels": map[string]interface{}{"app": "web"},
+		})
+		if err != nil {
+			t.Fatalf("bad: %s, error parsing config: %s", tn, err)
+		}
+		diff, err := resourceStorageBucket().Diff(state, terraform.NewResourceConfig(raw), &Config{DefaultLabels: tc.DefaultLabels})
+		if err != nil {
+			t.Fatalf("bad: %s, error diffing: %s", tn, err)
+		}
+
+		// Apply the diff of effective_labels to the labels in the state.
+		actual := map[string]string{"app": "web", "team": "infra"}
+		changed := false
+		for k, attr := range diff.Attributes {
+			if !strings.HasPrefix(k, "effective_labels.") || k == "effective_labels.%" {
+				continue
+			}
+			changed = true
+			if attr.NewRemoved {
+				delete(actual, strings.TrimPrefix(k, "effective_labels."))
+			} else {
+				actual[strings.TrimPrefix(k, "effective_labels.")] = attr.New
+			}
+		}
+		if tc.ExpectedEffective == nil {
+			if changed {
+				t.Errorf("bad: %s, expected no change of effective_labels, got %v", tn, actual)
+			}
+			continue
+		}
+		if !reflect.DeepEqual(tc.ExpectedEffective, actual) {
+			t.Errorf("bad: %s, effective_labels %v did not match expected value: %v", tn, actual, tc.ExpectedEffective)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -42,6 +43,9 @@ var (
 	}
 	ServiceAccountLinkRegex = ServiceAccountLinkRegexPrefix + "(" + strings.Join(PossibleServiceAccountNames, "|") + ")"
 
+	// Format of a service account given either as an email or as a fully qualified name
+	ServiceAccountEmailOrLinkRegex = "^(projects/[^/]+/serviceAccounts/)?[^/@]+@[^/@]+$"
+
 	// Format of service accounts created through the API
 	CreatedServiceAccountNameRegex = fmt.Sprintf(RFC1035NameTemplate, 4, 28) + "@" + ProjectNameInDNSFormRegex + "\\.iam\\.gserviceaccount\\.com$"
 	ProjectNameInDNSFormRegex      = "[-a-z0-9\\.]{1,63}"
@@ -115,6 +119,28 @@ func validateRFC3339Time(v interface{}, k string) (warnings []string, errors []e
 	return
 }
 
+func validatePositiveFloat(v interface{}, k string) (ws []string, errors []error) {
+	if v.(float64) <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be greater than 0, got %v", k, v))
+	}
+	return
+}
+
+func validateDuration() schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if _, err := time.ParseDuration(v); err != nil {
+			es = append(es, fmt.Errorf("expected %s to be a duration, got: %s", k, err))
+		}
+		return
+	}
+}
+
 func validateRFC1035Name(min, max int) schema.SchemaValidateFunc {
 	if min < 2 || max < min {
 		return func(i interface{}, k string) (s []string, errors []error) {
@@ -0,0 +1,276 @@
+package google
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Acceptance tests record the HTTP interactions of the provider in cassettes,
+// or replay them without network access, when VCR_MODE is set to one of these
+// modes. Cassettes are stored in VCR_PATH, one JSON file per test.
+const (
+	vcrModeEnvVar = "VCR_MODE"
+	vcrPathEnvVar = "VCR_PATH"
+
+	vcrModeRecording = "RECORDING"
+	vcrModeReplaying = "REPLAYING"
+
+	vcrDefaultPath = "testdata/vcr"
+)
+
+// vcr is set by the acceptance tests when VCR_MODE is set, and installed by
+// Config in its HTTP client while a test is running.
+var vcr *vcrRecorder
+
+type vcrRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type vcrResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+type vcrInteraction struct {
+	Request  vcrRequest  `json:"request"`
+	Response vcrResponse `json:"response"`
+}
+
+// A vcrCassette holds the interactions of a single test, and the seed of the
+// random values generated by the test so that it uses the same names when
+// replayed.
+type vcrCassette struct {
+	Seed         int64             `json:"seed"`
+	Interactions []*vcrInteraction `json:"interactions"`
+
+	mu   sync.Mutex
+	rand *rand.Rand
+	// Number of interactions replayed for each request.
+	replayed map[string]int
+}
+
+func (c *vcrCassette) record(i *vcrInteraction) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Interactions = append(c.Interactions, i)
+}
+
+// replay returns the response recorded for req. Identical requests, such as
+// the polls of an operation, get the responses recorded for them in order,
+// and the last one once they run out.
+func (c *vcrCassette) replay(req vcrRequest) (*vcrResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := req.Method + " " + req.URL + " " + req.Body
+	var matches []*vcrInteraction
+	for _, i := range c.Interactions {
+		if i.Request == req {
+			matches = append(matches, i)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no interaction recorded for %s %s", req.Method, req.URL)
+	}
+
+	n := c.replayed[key]
+	c.replayed[key] = n + 1
+	if n >= len(matches) {
+		n = len(matches) - 1
+	}
+	return &matches[n].Response, nil
+}
+
+// Int63 returns the next random value of the test using the cassette.
+func (c *vcrCassette) Int63() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.rand.Int63()
+}
+
+// vcrRecorder manages the cassettes of the tests being run. Only one test
+// may use it at a time, as the provider under test is shared by all tests.
+type vcrRecorder struct {
+	mode string
+	dir  string
+
+	mu        sync.Mutex
+	cassettes map[string]*vcrCassette
+	current   *vcrCassette
+}
+
+func newVcrRecorder(mode, dir string) (*vcrRecorder, error) {
+	if mode != vcrModeRecording && mode != vcrModeReplaying {
+		return nil, fmt.Errorf("%s must be %s or %s, got %q", vcrModeEnvVar, vcrModeRecording, vcrModeReplaying, mode)
+	}
+	if dir == "" {
+		dir = vcrDefaultPath
+	}
+	return &vcrRecorder{
+		mode:      mode,
+		dir:       dir,
+		cassettes: make(map[string]*vcrCassette),
+	}, nil
+}
+
+func (r *vcrRecorder) replaying() bool {
+	return r.mode == vcrModeReplaying
+}
+
+func (r *vcrRecorder) path(name string) string {
+	return filepath.Join(r.dir, strings.Replace(name, "/", "_", -1)+".json")
+}
+
+// cassette returns the cassette of the named test, which is read from disk
+// when replaying or created with a new seed when recording.
+func (r *vcrRecorder) cassette(name string) (*vcrCassette, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if c, ok := r.cassettes[name]; ok {
+		return c, nil
+	}
+
+	c := &vcrCassette{
+		Seed:     time.Now().UnixNano(),
+		replayed: make(map[string]int),
+	}
+	if r.replaying() {
+		b, err := ioutil.ReadFile(r.path(name))
+		if err != nil {
+			return nil, fmt.Errorf("Error reading cassette of %s: %s", name, err)
+		}
+		if err := json.Unmarshal(b, c); err != nil {
+			return nil, fmt.Errorf("Error parsing cassette of %s: %s", name, err)
+		}
+	}
+	c.rand = rand.New(rand.NewSource(c.Seed))
+
+	r.cassettes[name] = c
+	return c, nil
+}
+
+// start makes the cassette of the named test the one requests are recorded
+// in or replayed from.
+func (r *vcrRecorder) start(name string) error {
+	c, err := r.cassette(name)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.current = c
+	return nil
+}
+
+// stop saves the cassette of the named test when recording.
+func (r *vcrRecorder) stop(name string) error {
+	r.mu.Lock()
+	c := r.cassettes[name]
+	if r.current == c {
+		r.current = nil
+	}
+	delete(r.cassettes, name)
+	r.mu.Unlock()
+
+	if c == nil || r.replaying() {
+		return nil
+	}
+
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(r.dir, 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path(name), b, 0644)
+}
+
+func (r *vcrRecorder) currentCassette() *vcrCassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.current
+}
+
+// vcrTransport records requests sent to base in the current cassette, or
+// replays them from it without calling base.
+type vcrTransport struct {
+	recorder *vcrRecorder
+	base     http.RoundTripper
+}
+
+func (t *vcrTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	c := t.recorder.currentCassette()
+	if c == nil {
+		return nil, fmt.Errorf("No cassette is in use to %s %s", strings.ToLower(t.recorder.mode), req.URL)
+	}
+
+	recorded := vcrRequest{
+		Method: req.Method,
+		URL:    req.URL.String(),
+	}
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		recorded.Body = string(body)
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	if t.recorder.replaying() {
+		res, err := c.replay(recorded)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("[DEBUG] Replaying %s %s", req.Method, req.URL)
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", res.StatusCode, http.StatusText(res.StatusCode)),
+			StatusCode:    res.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        res.Header,
+			Body:          ioutil.NopCloser(strings.NewReader(res.Body)),
+			ContentLength: int64(len(res.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	res, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	c.record(&vcrInteraction{
+		Request: recorded,
+		Response: vcrResponse{
+			StatusCode: res.StatusCode,
+			Header:     res.Header,
+			Body:       string(body),
+		},
+	})
+	return res, nil
+}
@@ -0,0 +1,203 @@
+package google
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+)
+
+func init() {
+	mode := os.Getenv(vcrModeEnvVar)
+	if mode == "" {
+		return
+	}
+
+	r, err := newVcrRecorder(mode, os.Getenv(vcrPathEnvVar))
+	if err != nil {
+		panic(err)
+	}
+	vcr = r
+}
+
+var (
+	// vcrTestMu guards vcrTest, the test whose cassette is in use. Tests using
+	// the VCR run one at a time, as they share testAccProvider, so a test's
+	// cassette is saved when the next one starts, or by vcrStopCurrent once
+	// every test ran.
+	vcrTestMu sync.Mutex
+	vcrTest   string
+)
+
+func vcrReplaying() bool {
+	return vcr != nil && vcr.replaying()
+}
+
+// vcrStart records or replays the requests of t in its cassette until the
+// next test starts. It is called by testAccPreCheck.
+func vcrStart(t *testing.T) {
+	if vcr == nil {
+		return
+	}
+
+	vcrTestMu.Lock()
+	defer vcrTestMu.Unlock()
+	if vcrTest == t.Name() {
+		return
+	}
+
+	if vcrTest != "" {
+		if err := vcr.stop(vcrTest); err != nil {
+			t.Fatalf("Error saving cassette of %s: %s", vcrTest, err)
+		}
+	}
+	vcrTest = t.Name()
+	if err := vcr.start(t.Name()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// vcrStopCurrent saves the cassette of the last test started with vcrStart.
+func vcrStopCurrent() error {
+	if vcr == nil {
+		return nil
+	}
+
+	vcrTestMu.Lock()
+	defer vcrTestMu.Unlock()
+	if vcrTest == "" {
+		return nil
+	}
+	name := vcrTest
+	vcrTest = ""
+	return vcr.stop(name)
+}
+
+// vcrCassetteForTest returns the cassette of t, or nil when the VCR isn't
+// used.
+func vcrCassetteForTest(t *testing.T) *vcrCassette {
+	if vcr == nil {
+		return nil
+	}
+	c, err := vcr.cassette(t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+// randString generates a random alphanumeric string like acctest.RandString,
+// which is the same when t is replayed.
+func randString(t *testing.T, length int) string {
+	c := vcrCassetteForTest(t)
+	if c == nil {
+		return acctest.RandString(length)
+	}
+
+	result := make([]byte, length)
+	for i := range result {
+		result[i] = acctest.CharSetAlphaNum[c.Int63()%int64(len(acctest.CharSetAlphaNum))]
+	}
+	return string(result)
+}
+
+// randInt generates a random integer like acctest.RandInt, which is the same
+// when t is replayed.
+func randInt(t *testing.T) int {
+	c := vcrCassetteForTest(t)
+	if c == nil {
+		return acctest.RandInt()
+	}
+	return int(c.Int63())
+}
+
+// randomWithPrefix generates a random name like acctest.RandomWithPrefix,
+// which is the same when t is replayed.
+func randomWithPrefix(t *testing.T, prefix string) string {
+	return fmt.Sprintf("%s-%d", prefix, randInt(t))
+}
+
+func TestVcrRecorder(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/operations/1234":
+			polls++
+			if polls < 3 {
+				w.Write([]byte(`{"done": false}`))
+				return
+			}
+			w.Write([]byte(`{"done": true}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	dir, err := ioutil.TempDir("", "vcr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	send := func(r *vcrRecorder, path string) (int, string) {
+		client := &http.Client{Transport: &vcrTransport{recorder: r, base: http.DefaultTransport}}
+		res, err := client.Get(server.URL + path)
+		if err != nil {
+			t.Fatalf("error: %s", err)
+		}
+		defer res.Body.Close()
+		var body [64]byte
+		n, _ := res.Body.Read(body[:])
+		return res.StatusCode, string(body[:n])
+	}
+
+	recorder, err := newVcrRecorder(vcrModeRecording, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := recorder.start("TestFoo/bar"); err != nil {
+		t.Fatal(err)
+	}
+	recorded, _ := recorder.cassette("TestFoo/bar")
+	name := recorded.Int63()
+	var bodies []string
+	for i := 0; i < 3; i++ {
+		_, body := send(recorder, "/operations/1234")
+		bodies = append(bodies, body)
+	}
+	send(recorder, "/missing")
+	if err := recorder.stop("TestFoo/bar"); err != nil {
+		t.Fatal(err)
+	}
+	server.Close()
+
+	replayer, err := newVcrRecorder(vcrModeReplaying, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := replayer.start("TestFoo/bar"); err != nil {
+		t.Fatal(err)
+	}
+	replayed, _ := replayer.cassette("TestFoo/bar")
+	if v := replayed.Int63(); v != name {
+		t.Errorf("expected the random values of the test to be replayed, got %d instead of %d", v, name)
+	}
+	for i, expected := range append(bodies, bodies[2]) {
+		if _, body := send(replayer, "/operations/1234"); body != expected {
+			t.Errorf("expected poll %d to return %q, got %q", i, expected, body)
+		}
+	}
+	if status, _ := send(replayer, "/missing"); status != http.StatusNotFound {
+		t.Errorf("expected the recorded status to be replayed, got %d", status)
+	}
+
+	client := &http.Client{Transport: &vcrTransport{recorder: replayer, base: http.DefaultTransport}}
+	if _, err := client.Get(server.URL + "/unknown"); err == nil {
+		t.Errorf("expected requests that weren't recorded to fail")
+	}
+}
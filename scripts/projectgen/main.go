@@ -0,0 +1,87 @@
+// Generates the Terraform configuration and state of the resources of an
+// existing project, so that a project built by hand can be managed with
+// Terraform without recreating its resources.
+//
+// The resources are read with the provider, which is configured from the
+// same environment variables as in Terraform, e.g. GOOGLE_CREDENTIALS. Obtain
+// credentials via gcloud:
+//
+//   gcloud auth application-default login
+//
+// Usage example (from root dir):
+//
+//   go run ./scripts/projectgen -project my-project -region us-central1
+//
+// This will output a `main.tf` configuration and the matching
+// `terraform.tfstate` in the directory given by -out, the current directory
+// by default. Resources which couldn't be read are logged. Review the
+// configuration, and check that `terraform plan` finds no changes, before
+// applying it.
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-google/google"
+)
+
+func main() {
+	project := flag.String("project", "", "project to generate")
+	region := flag.String("region", "", "default region of the provider")
+	out := flag.String("out", ".", "directory to write main.tf and terraform.tfstate to")
+	flag.Parse()
+
+	if *project == "" {
+		flag.PrintDefaults()
+		log.Fatal("usage: go run ./scripts/projectgen -project $PROJECT [-region $REGION] [-out $DIR]")
+	}
+
+	// Never overwrite an existing configuration or state.
+	configPath := filepath.Join(*out, "main.tf")
+	statePath := filepath.Join(*out, "terraform.tfstate")
+	for _, path := range []string{configPath, statePath} {
+		if _, err := os.Stat(path); err == nil {
+			log.Fatalf("%s already exists", path)
+		}
+	}
+
+	providerConfig := map[string]interface{}{"project": *project}
+	if *region != "" {
+		providerConfig["region"] = *region
+	}
+	raw, err := config.NewRawConfig(providerConfig)
+	if err != nil {
+		log.Fatal(err)
+	}
+	p := google.Provider().(*schema.Provider)
+	if err := p.Configure(terraform.NewResourceConfig(raw)); err != nil {
+		log.Fatal(fmt.Errorf("Error configuring provider: %v", err))
+	}
+
+	var hcl, state bytes.Buffer
+	fmt.Fprintf(&hcl, "provider \"google\" {\n  project = %q\n", *project)
+	if *region != "" {
+		fmt.Fprintf(&hcl, "  region  = %q\n", *region)
+	}
+	hcl.WriteString("}\n\n")
+	if err := google.GenerateProject(p, *project, &hcl, &state); err != nil {
+		log.Fatal(fmt.Errorf("Error generating project: %v", err))
+	}
+
+	if err := ioutil.WriteFile(configPath, hcl.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(statePath, state.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
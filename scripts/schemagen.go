@@ -1,33 +1,51 @@
-// Generates an initial version of a schema for a new resource type.
+// Generates an initial version of a new resource type from the discovery
+// document of its API.
 //
 // This script draws heavily from https://github.com/radeksimko/terraform-gen,
 // but uses GCP's discovery API instead of the struct definition to generate
 // the schemas.
 //
-// This is not meant to be a definitive source of truth for resource schemas,
-// just a starting point. It has some notable deficiencies, such as:
-// 	* No way to differentiate between fields that are/are not updateable.
-// 	* Required/Optional/Computed are set based on keywords in the description.
+// This is not meant to be a definitive source of truth for resources, just a
+// starting point. It generates the schema, the expand and flatten functions
+// of each field, CRUD functions sending requests with transport.go, an
+// importer using parseImportId and an acceptance test stub. It has some
+// notable deficiencies, such as:
+// 	* Required/Optional/Computed are set based on keywords in the description
+// 	  and on the `annotations` of the discovery document.
+// 	* Fields are updatable as soon as the resource has a `patch` or `update`
+// 	  method, though APIs often don't allow updating some of them.
+// 	* Only Compute operations are waited on; other APIs need their own
+// 	  operation waiter.
 //
-// Usage requires credentials. Obtain via gcloud:
+// The discovery document is read from the Discovery API, which requires
+// credentials. Obtain via gcloud:
 //
 //   gcloud auth application-default login
 //
+// Alternatively, a discovery document downloaded beforehand, e.g. from
+// https://www.googleapis.com/discovery/v1/apis/pubsub/v1/rest, can be given
+// with -discovery, which needs neither credentials nor network access.
+//
 // Usage example (from root dir):
 //
 //   go run ./scripts/schemagen.go -api pubsub -resource Subscription -version v1
+//   go run ./scripts/schemagen.go -discovery pubsub-v1.json -resource Subscription
 //
-// This will output a file in the directory from which the script is run named `gen_resource_[api]_[resource].go`.
+// This will output files in the directory from which the script is run named
+// `gen_resource_[api]_[resource].go` and `gen_resource_[api]_[resource]_test.go`.
+// The resource must then be added to the provider's ResourcesMap, and the API
+// to custom_endpoints.go if it isn't there yet.
 
 package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"go/format"
+	"io/ioutil"
 	"log"
-	"os"
 	"regexp"
 	"sort"
 	"strings"
@@ -43,73 +61,412 @@ func main() {
 	api := flag.String("api", "", "api to query")
 	resource := flag.String("resource", "", "resource to generate")
 	version := flag.String("version", "v1", "api version to query")
+	discoveryFile := flag.String("discovery", "", "discovery document to read instead of querying the Discovery API")
 	flag.Parse()
 
-	if *api == "" || *resource == "" {
+	if *resource == "" || (*api == "" && *discoveryFile == "") {
 		flag.PrintDefaults()
-		log.Fatal("usage: go run schemagen.go -api $API -resource $RESOURCE -version $VERSION")
+		log.Fatal("usage: go run schemagen.go [-api $API -version $VERSION | -discovery $FILE] -resource $RESOURCE")
+	}
+
+	doc, err := readDiscovery(*discoveryFile, *api, *version)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *api == "" {
+		*api = doc.Name
+	}
+
+	r, err := newResourceData(doc, *api, *resource)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fileName := fmt.Sprintf("gen_resource_%s_%s", *api, underscore(*resource))
+	for name, tmpl := range map[string]*template.Template{
+		fileName + ".go":      googleTemplate,
+		fileName + "_test.go": testTemplate,
+	} {
+		src, err := generateSource(tmpl, r)
+		if err != nil {
+			log.Printf("Formatting error: %s", err)
+		}
+		if err := ioutil.WriteFile(name, src, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// readDiscovery reads the discovery document of an API from path, or from
+// the Discovery API if path is empty.
+func readDiscovery(path, api, version string) (*discovery.RestDescription, error) {
+	if path != "" {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		doc := &discovery.RestDescription{}
+		if err := json.Unmarshal(b, doc); err != nil {
+			return nil, fmt.Errorf("Error reading discovery document %s: %v", path, err)
+		}
+		return doc, nil
 	}
 
 	// Discovery API doesn't need authentication
 	client, err := google.DefaultClient(oauth2.NoContext, []string{}...)
 	if err != nil {
-		log.Fatal(fmt.Errorf("Error creating client: %v", err))
+		return nil, fmt.Errorf("Error creating client: %v", err)
 	}
 
 	discoveryService, err := discovery.New(client)
 	if err != nil {
-		log.Fatal(fmt.Errorf("Error creating service: %v", err))
+		return nil, fmt.Errorf("Error creating service: %v", err)
 	}
 
-	resp, err := discoveryService.Apis.GetRest(*api, *version).Fields("schemas").Do()
+	doc, err := discoveryService.Apis.GetRest(api, version).Do()
 	if err != nil {
-		log.Fatal(fmt.Errorf("Error reading API: %v", err))
+		return nil, fmt.Errorf("Error reading API: %v", err)
 	}
+	return doc, nil
+}
 
-	fileName := fmt.Sprintf("gen_resource_%s_%s.go", *api, underscore(*resource))
-	f, err := os.Create(fileName)
-	defer f.Close()
+// generateSource executes tmpl with r, and formats the result. The result
+// is returned unformatted along with the error if it isn't valid Go.
+func generateSource(tmpl *template.Template, r *resourceData) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := tmpl.Execute(buf, r); err != nil {
+		return nil, err
+	}
+
+	fmtd, err := format.Source(buf.Bytes())
 	if err != nil {
-		log.Fatal(err)
+		return buf.Bytes(), err
 	}
+	return fmtd, nil
+}
 
-	required, optional, computed := generateFields(resp.Schemas, *resource)
+// resourceData holds everything the templates need to generate a resource.
+type resourceData struct {
+	// e.g. ComputeNetwork, Network and google_compute_network
+	TypeName      string
+	Resource      string
+	TerraformName string
 
-	buf := &bytes.Buffer{}
-	err = googleTemplate.Execute(buf, struct {
-		TypeName  string
-		ReqFields map[string]string
-		OptFields map[string]string
-		ComFields map[string]string
-	}{
+	ReqFields map[string]string
+	OptFields map[string]string
+	ComFields map[string]string
+
+	// Top-level fields of the API resource.
+	Properties []*property
+
+	// URL templates of each method, e.g.
+	// `{{ComputeBasePath}}projects/{{project}}/global/networks/{{name}}`.
+	CreateUrl string
+	ReadUrl   string
+	UpdateUrl string
+	DeleteUrl string
+
+	// Set when the resource can be updated in place, with the PATCH or PUT
+	// UpdateVerb. UpdateMask is set when PATCH takes an update mask.
+	Updatable  bool
+	UpdateVerb string
+	UpdateMask bool
+
+	// Set when methods return an operation to wait on rather than the
+	// resource. ComputeOperation is set when they are Compute operations.
+	Operation        bool
+	ComputeOperation bool
+
+	// Fields defaulting to the provider's, among project, region and zone.
+	// HasProject is set when project is one of them.
+	DefaultFields []string
+	HasProject    bool
+
+	// Template of the id of the resource, and the id formats the importer
+	// accepts from the most to the least specific.
+	IdFormat      string
+	ImportFormats []string
+
+	// Required fields of the resource other than `name`, listed in the test
+	// configuration.
+	TestArguments []string
+
+	HasInt bool
+}
+
+// property is a field of the API resource or of one of its nested objects,
+// with the names of the functions converting it between Terraform and API
+// values.
+type property struct {
+	Name     string
+	ApiName  string
+	FuncName string
+	Output   bool
+
+	// Updatable top-level fields are sent in update requests.
+	Updatable bool
+
+	// Set for the `name` of resources identified by their full resource
+	// name, e.g. `projects/my-project/topics/my-topic`, given as NameFormat.
+	NameFormat string
+
+	Int        bool
+	Map        bool
+	Object     bool
+	ObjectList bool
+	Properties []*property
+}
+
+// restCollection holds the methods of the collection of a resource.
+type restCollection struct {
+	create, get, update, delete *discovery.RestMethod
+	updateVerb                  string
+}
+
+// findCollection returns the collection whose `get` method returns the
+// resource, looking through nested resources.
+func findCollection(resources map[string]discovery.RestResource, resource string) *restCollection {
+	names := make([]string, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		r := resources[name]
+		if get, ok := r.Methods["get"]; ok && get.Response != nil && get.Response.Ref == resource {
+			c := &restCollection{get: &get}
+			for _, m := range []string{"insert", "create"} {
+				if create, ok := r.Methods[m]; ok {
+					c.create = &create
+					break
+				}
+			}
+			if update, ok := r.Methods["patch"]; ok {
+				c.update, c.updateVerb = &update, "PATCH"
+			} else if update, ok := r.Methods["update"]; ok {
+				c.update, c.updateVerb = &update, update.HttpMethod
+			}
+			if del, ok := r.Methods["delete"]; ok {
+				c.delete = &del
+			}
+			return c
+		}
+		if c := findCollection(r.Resources, resource); c != nil {
+			return c
+		}
+	}
+	return nil
+}
+
+func newResourceData(doc *discovery.RestDescription, api, resource string) (*resourceData, error) {
+	if _, ok := doc.Schemas[resource]; !ok {
+		return nil, fmt.Errorf("Resource %s not found in the %s API", resource, api)
+	}
+	c := findCollection(doc.Resources, resource)
+	if c == nil || c.create == nil || c.delete == nil {
+		return nil, fmt.Errorf("Resource %s doesn't have get, create and delete methods", resource)
+	}
+
+	basePath := strings.ToUpper(api[0:1]) + api[1:]
+	r := &resourceData{
 		// Capitalize the first letter of the api name, then concatenate the resource name onto it.
 		// e.g. compute, instance -> ComputeInstance
-		TypeName:  strings.ToUpper((*api)[0:1]) + (*api)[1:] + *resource,
-		ReqFields: required,
-		OptFields: optional,
-		ComFields: computed,
+		TypeName:      basePath + resource,
+		Resource:      resource,
+		TerraformName: fmt.Sprintf("google_%s_%s", underscore(api), underscore(resource)),
+		Updatable:     c.update != nil,
+		UpdateVerb:    c.updateVerb,
+		Operation:     c.create.Response != nil && c.create.Response.Ref == "Operation",
+	}
+	r.ComputeOperation = r.Operation && api == "compute"
+	if c.update != nil {
+		_, r.UpdateMask = c.update.Parameters["updateMask"]
+	}
+
+	// The last parameter of `get` identifies the resource; the others are
+	// the parents of the resource.
+	identifier := c.get.ParameterOrder[len(c.get.ParameterOrder)-1]
+	var vars []string
+	r.ReadUrl, vars = methodUrl(doc, c.get, basePath, identifier)
+	r.CreateUrl, _ = methodUrl(doc, c.create, basePath, identifier)
+	r.DeleteUrl, _ = methodUrl(doc, c.delete, basePath, identifier)
+	if c.update != nil {
+		r.UpdateUrl, _ = methodUrl(doc, c.update, basePath, identifier)
+	}
+	for _, name := range sortedKeys(c.create.Parameters) {
+		if p := c.create.Parameters[name]; p.Location == "query" && p.Required {
+			r.CreateUrl += fmt.Sprintf("?%s={{name}}", name)
+		}
+	}
+
+	// The variables of the URL identify the resource, so they can't be
+	// updated. Those which aren't fields of the API resource are added to
+	// the schema.
+	identity := make(map[string]bool)
+	for _, v := range vars {
+		identity[v] = true
+	}
+	r.ReqFields, r.OptFields, r.ComFields = generateResourceFields(doc.Schemas, resource, fieldOptions{
+		Updatable: r.Updatable,
+		Identity:  identity,
 	})
-	if err != nil {
-		log.Fatal(err)
+	for _, v := range vars {
+		switch v {
+		case "project", "region", "zone":
+			r.DefaultFields = append(r.DefaultFields, v)
+			r.HasProject = r.HasProject || v == "project"
+			r.OptFields[v] = "{\nType: schema.TypeString,\nOptional: true,\nComputed: true,\nForceNew: true,\n}"
+		default:
+			if _, ok := r.ReqFields[v]; !ok {
+				r.ReqFields[v] = "{\nType: schema.TypeString,\nRequired: true,\nForceNew: true,\n}"
+			}
+		}
+	}
+	for _, name := range sortedKeys(r.ReqFields) {
+		if name != "name" {
+			r.TestArguments = append(r.TestArguments, name)
+		}
 	}
 
-	fmtd, err := format.Source(buf.Bytes())
-	if err != nil {
-		log.Printf("Formatting error: %s", err)
+	var nameFormat string
+	if c.get.Parameters[identifier].Pattern != "" {
+		// The resource is identified by its full resource name.
+		nameFormat = strings.TrimPrefix(r.ReadUrl, fmt.Sprintf("{{%sBasePath}}", basePath))
+	}
+	r.Properties = generateProperties(doc.Schemas, resource, r.TypeName)
+	for _, p := range r.Properties {
+		p.Updatable = r.Updatable && !p.Output && !identity[p.Name]
+		if p.Name == "name" {
+			p.NameFormat = nameFormat
+		}
+		r.HasInt = r.HasInt || p.hasInt()
 	}
 
-	if _, err := f.Write(fmtd); err != nil {
-		log.Fatal(err)
+	r.IdFormat = "{{" + strings.Join(vars, "}}/{{") + "}}"
+	r.ImportFormats = importFormats(r.ReadUrl, basePath, vars)
+
+	return r, nil
+}
+
+func (p *property) hasInt() bool {
+	if p.Int {
+		return true
+	}
+	for _, nested := range p.Properties {
+		if nested.hasInt() {
+			return true
+		}
+	}
+	return false
+}
+
+var urlParamRegexp = regexp.MustCompile(`\{(\+?)([^}]+)\}`)
+
+// methodUrl returns the URL template of a method relative to the base path
+// of the API, along with the variables of the template. Parameters given as
+// `{+name}` hold several segments, e.g. `projects/my-project/topics/my-topic`,
+// whose variables are named after the collection of each segment.
+func methodUrl(doc *discovery.RestDescription, m *discovery.RestMethod, basePath, identifier string) (string, []string) {
+	path := doc.ServicePath + m.Path
+	// Base paths include the API version, e.g. `https://www.googleapis.com/compute/v1/`.
+	for _, prefix := range []string{doc.Name + "/" + doc.Version + "/", doc.Version + "/"} {
+		if strings.HasPrefix(path, prefix) {
+			path = strings.TrimPrefix(path, prefix)
+			break
+		}
+	}
+
+	var vars []string
+	path = urlParamRegexp.ReplaceAllStringFunc(path, func(s string) string {
+		match := urlParamRegexp.FindStringSubmatch(s)
+		multiSegment, name := match[1] == "+", match[2]
+
+		pattern := strings.TrimSuffix(strings.TrimPrefix(m.Parameters[name].Pattern, "^"), "$")
+		if !multiSegment || pattern == "" {
+			v := underscore(name)
+			if name == identifier {
+				v = "name"
+			}
+			vars = append(vars, v)
+			return "{{" + v + "}}"
+		}
+
+		// Segments matching any value are variables, e.g. the second segment
+		// of `projects/[^/]+`.
+		segments := strings.Split(strings.Replace(pattern, "[^/]+", "*", -1), "/")
+		for i, segment := range segments {
+			if segment != "*" {
+				continue
+			}
+			v := "name"
+			if i > 0 && (name != identifier || i < len(segments)-1) {
+				v = singular(underscore(segments[i-1]))
+			}
+			vars = append(vars, v)
+			segments[i] = "{{" + v + "}}"
+		}
+		return strings.Join(segments, "/")
+	})
+
+	return fmt.Sprintf("{{%sBasePath}}%s", basePath, path), vars
+}
+
+// importFormats returns the id formats accepted by the importer, from the
+// URL of the resource to its name alone. Formats omitting fields are only
+// accepted when the fields default to the provider's.
+func importFormats(readUrl, basePath string, vars []string) []string {
+	full := strings.TrimPrefix(readUrl, fmt.Sprintf("{{%sBasePath}}", basePath))
+	full = regexp.MustCompile(`\{\{([[:word:]]+)\}\}`).ReplaceAllString(full, "(?P<$1>[^/]+)")
+	formats := []string{full}
+
+	for i := range vars {
+		rest := vars[i:]
+		groups := make([]string, len(rest))
+		for j, v := range rest {
+			groups[j] = fmt.Sprintf("(?P<%s>[^/]+)", v)
+		}
+		format := strings.Join(groups, "/")
+		if format != formats[len(formats)-1] {
+			formats = append(formats, format)
+		}
+		switch vars[i] {
+		case "project", "region", "zone":
+		default:
+			return formats
+		}
 	}
+	return formats
+}
+
+// fieldOptions tells how the fields of a resource are generated.
+type fieldOptions struct {
+	// Updatable is set when the resource has an update method, so that its
+	// fields can be updated in place rather than recreating the resource.
+	Updatable bool
+
+	// Identity holds the top-level fields identifying the resource in its
+	// URL, which are required and can't be updated.
+	Identity map[string]bool
 }
 
+// generateFields returns the fields of a resource that can't be updated.
 func generateFields(jsonSchemas map[string]discovery.JsonSchema, property string) (required, optional, computed map[string]string) {
+	return generateResourceFields(jsonSchemas, property, fieldOptions{})
+}
+
+func generateResourceFields(jsonSchemas map[string]discovery.JsonSchema, property string, opts fieldOptions) (required, optional, computed map[string]string) {
 	required = make(map[string]string, 0)
 	optional = make(map[string]string, 0)
 	computed = make(map[string]string, 0)
 
 	for k, v := range jsonSchemas[property].Properties {
-		content, err := generateField(jsonSchemas, k, v, false)
+		fieldOpts := opts
+		fieldOpts.Identity = nil
+		if opts.Identity[underscore(k)] {
+			fieldOpts.Identity = map[string]bool{underscore(k): true}
+		}
+		content, err := generateField(jsonSchemas, k, v, false, fieldOpts)
 		if err != nil {
 			log.Printf("ERROR: %s", err)
 		} else {
@@ -128,14 +485,17 @@ func generateFields(jsonSchemas map[string]discovery.JsonSchema, property string
 	return
 }
 
-func generateField(jsonSchemas map[string]discovery.JsonSchema, field string, v discovery.JsonSchema, isNested bool) (string, error) {
+func generateField(jsonSchemas map[string]discovery.JsonSchema, field string, v discovery.JsonSchema, isNested bool, opts fieldOptions) (string, error) {
 	s := &schema.Schema{
 		Description: v.Description,
 	}
 	if field != "" {
-		setProperties(v, s)
+		setProperties(field, v, s, opts)
 	}
 
+	// Nested fields never identify the resource.
+	nestedOpts := fieldOptions{Updatable: opts.Updatable}
+
 	// JSON field types: https://tools.ietf.org/html/draft-zyp-json-schema-03#section-5.1
 	switch v.Type {
 	case "integer":
@@ -144,11 +504,15 @@ func generateField(jsonSchemas map[string]discovery.JsonSchema, field string, v
 		s.Type = schema.TypeFloat
 	case "string":
 		s.Type = schema.TypeString
+		// 64-bit integers are formatted as strings in JSON.
+		if v.Format == "int64" || v.Format == "uint64" {
+			s.Type = schema.TypeInt
+		}
 	case "boolean":
 		s.Type = schema.TypeBool
 	case "array":
 		s.Type = schema.TypeList
-		elem, err := generateField(jsonSchemas, "", *v.Items, true)
+		elem, err := generateField(jsonSchemas, "", *v.Items, true, nestedOpts)
 		if err != nil {
 			return "", fmt.Errorf("Unable to generate Elem for %q: %s", field, err)
 		}
@@ -160,7 +524,7 @@ func generateField(jsonSchemas map[string]discovery.JsonSchema, field string, v
 		s.MaxItems = 1
 
 		elem := "&schema.Resource{\nSchema: map[string]*schema.Schema{\n"
-		required, optional, computed := generateFields(jsonSchemas, v.Ref)
+		required, optional, computed := generateResourceFields(jsonSchemas, v.Ref, nestedOpts)
 		elem += generateNestedElem(required)
 		elem += generateNestedElem(optional)
 		elem += generateNestedElem(computed)
@@ -177,18 +541,73 @@ func generateField(jsonSchemas map[string]discovery.JsonSchema, field string, v
 	return schemaCode(s, isNested)
 }
 
-func setProperties(v discovery.JsonSchema, s *schema.Schema) {
+func setProperties(field string, v discovery.JsonSchema, s *schema.Schema, opts fieldOptions) {
+	if opts.Identity[underscore(field)] {
+		s.Required = true
+		s.ForceNew = true
+		return
+	}
+
 	if v.ReadOnly || strings.HasPrefix(v.Description, "Output-only") || strings.HasPrefix(v.Description, "[Output Only]") {
 		s.Computed = true
 	} else {
-		if v.Required || strings.HasPrefix(v.Description, "Required") {
+		if v.Required || strings.HasPrefix(v.Description, "Required") || (v.Annotations != nil && len(v.Annotations.Required) > 0) {
 			s.Required = true
 		} else {
 			s.Optional = true
 		}
 	}
 
-	s.ForceNew = true
+	s.ForceNew = !opts.Updatable
+}
+
+// generateProperties returns the properties of an API schema, sorted by
+// name, whose functions are named after prefix.
+func generateProperties(jsonSchemas map[string]discovery.JsonSchema, schemaName, prefix string) []*property {
+	var properties []*property
+	for _, k := range sortedKeys(jsonSchemas[schemaName].Properties) {
+		v := jsonSchemas[schemaName].Properties[k]
+		p := &property{
+			Name:     underscore(k),
+			ApiName:  k,
+			FuncName: prefix + strings.ToUpper(k[0:1]) + k[1:],
+			Output:   v.ReadOnly || strings.HasPrefix(v.Description, "Output-only") || strings.HasPrefix(v.Description, "[Output Only]"),
+		}
+		switch v.Type {
+		case "integer":
+			p.Int = true
+		case "string":
+			p.Int = v.Format == "int64" || v.Format == "uint64"
+		case "object":
+			p.Map = true
+		case "array":
+			if v.Items != nil && v.Items.Ref != "" {
+				p.ObjectList = true
+				p.Properties = generateProperties(jsonSchemas, v.Items.Ref, p.FuncName)
+			}
+		case "":
+			p.Object = true
+			p.Properties = generateProperties(jsonSchemas, v.Ref, p.FuncName)
+		}
+		properties = append(properties, p)
+	}
+	return properties
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch m := m.(type) {
+	case map[string]discovery.JsonSchema:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]string:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
 }
 
 func generateNestedElem(fields map[string]string) (elem string) {
@@ -235,6 +654,15 @@ func underscore(name string) string {
 	return strings.ToLower(allCap)
 }
 
+// singular returns the singular of the name of a collection, e.g. `project`
+// for `projects`.
+func singular(name string) string {
+	if strings.HasSuffix(name, "ies") {
+		return strings.TrimSuffix(name, "ies") + "y"
+	}
+	return strings.TrimSuffix(name, "s")
+}
+
 var schemaTemplate = template.Must(template.New("schema").Parse(`{{if .IsNested}}&schema.Schema{{end}}{{"{"}}{{if not .IsNested}}
 {{end}}Type: schema.{{.Schema.Type}},{{if ne .Schema.Description ""}}
 Description: {{printf "%q" .Schema.Description}},{{end}}{{if .Schema.Required}}
@@ -246,20 +674,33 @@ MaxItems: {{.Schema.MaxItems}},{{end}}{{if .Schema.Elem}}
 Elem: {{.Schema.Elem}},{{end}}{{if not .IsNested}}
 {{end}}{{"}"}}`))
 
-var googleTemplate = template.Must(template.New("google").Parse(`package google
+var googleTemplate = template.Must(template.New("google").Funcs(template.FuncMap{"title": strings.Title}).Parse(`package google
 
 import(
-	"github.com/hashicorp/terraform/helper/schema"
+	"fmt"
+	"log"{{if .HasInt}}
+	"strconv"{{end}}
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"{{if .ComputeOperation}}
+	compute "google.golang.org/api/compute/v1"{{end}}
 )
 
 func resource{{.TypeName}}() *schema.Resource {
 	return &schema.Resource{
 		Create: resource{{.TypeName}}Create,
-		Read:   resource{{.TypeName}}Read,
-		Update: resource{{.TypeName}}Update,
+		Read:   resource{{.TypeName}}Read,{{if .Updatable}}
+		Update: resource{{.TypeName}}Update,{{end}}
 		Delete: resource{{.TypeName}}Delete,
+
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resource{{.TypeName}}Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),{{if .Updatable}}
+			Update: schema.DefaultTimeout(4 * time.Minute),{{end}}
+			Delete: schema.DefaultTimeout(4 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{ {{range $name, $schema := .ReqFields}}
@@ -275,37 +716,363 @@ func resource{{.TypeName}}() *schema.Resource {
 
 func resource{{.TypeName}}Create(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
+{{template "project" .}}
+	obj := make(map[string]interface{})
+{{- range .Properties}}{{if not .Output}}
+	{{.ApiName}}Prop, err := expand{{.FuncName}}(d.Get("{{.Name}}"), d, config)
+	if err != nil {
+		return err
+	}
+	obj["{{.ApiName}}"] = {{.ApiName}}Prop
+{{- end}}{{end}}
 
-	project, err := getProject(d, config)
+	url, err := replaceVars(d, config, "{{.CreateUrl}}")
 	if err != nil {
 		return err
 	}
+
+	log.Printf("[DEBUG] Creating new {{.Resource}}: %#v", obj)
+	res, err := Post(config, url, project, obj)
+	if err != nil {
+		return fmt.Errorf("Error creating {{.Resource}}: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "{{.IdFormat}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+{{if .ComputeOperation}}
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	waitErr := computeOperationWaitTime(
+		config, op, project, "Creating {{.Resource}}",
+		d.Timeout(schema.TimeoutCreate))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create {{.Resource}}: %s", waitErr)
+	}
+{{else if .Operation}}
+	// TODO: Wait for the operation in res to complete with the operation
+	// waiter of the API, see OperationWait.
+{{end}}
+	log.Printf("[DEBUG] Finished creating {{.Resource}} %q: %#v", d.Id(), res)
+
+	return resource{{.TypeName}}Read(d, meta)
 }
 
 func resource{{.TypeName}}Read(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
+{{template "project" .}}
+	url, err := replaceVars(d, config, "{{.ReadUrl}}")
+	if err != nil {
+		return err
+	}
 
-	project, err := getProject(d, config)
+	res, err := Get(config, url, project)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("{{.TypeName}} %q", d.Id()))
+	}
+{{range .Properties}}
+	if err := d.Set("{{.Name}}", flatten{{.FuncName}}(res["{{.ApiName}}"])); err != nil {
+		return fmt.Errorf("Error reading {{$.Resource}}: %s", err)
+	}
+{{- end}}
+{{- range .DefaultFields}}{{if ne . "project"}}
+	{{.}}, err := get{{if eq . "region"}}Region{{else}}Zone{{end}}(d, config)
 	if err != nil {
 		return err
+	}{{end}}
+	if err := d.Set("{{.}}", {{.}}); err != nil {
+		return fmt.Errorf("Error reading {{$.Resource}}: %s", err)
 	}
-}
+{{- end}}
 
+	return nil
+}
+{{if .Updatable}}
 func resource{{.TypeName}}Update(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
+{{template "project" .}}
+	obj := make(map[string]interface{})
+{{- range .Properties}}{{if .Updatable}}
+	{{.ApiName}}Prop, err := expand{{.FuncName}}(d.Get("{{.Name}}"), d, config)
+	if err != nil {
+		return err
+	}
+	obj["{{.ApiName}}"] = {{.ApiName}}Prop
+{{- end}}{{end}}
+{{if .UpdateMask}}
+	updateMask := buildUpdateMask(d, map[string]string{
+{{- range .Properties}}{{if .Updatable}}
+		"{{.Name}}": "{{.ApiName}}",
+{{- end}}{{end}}
+	})
+{{end}}
+	url, err := replaceVars(d, config, "{{.UpdateUrl}}")
+	if err != nil {
+		return err
+	}
 
-	project, err := getProject(d, config)
+	log.Printf("[DEBUG] Updating {{.Resource}} %q: %#v", d.Id(), obj)
+	{{if eq .UpdateVerb "PATCH"}}res, err := Patch(config, url, project, obj, {{if .UpdateMask}}updateMask{{else}}nil{{end}}){{else}}res, err := Put(config, url, project, obj){{end}}
+	if err != nil {
+		return fmt.Errorf("Error updating {{.Resource}} %q: %s", d.Id(), err)
+	}
+{{if .ComputeOperation}}
+	op := &compute.Operation{}
+	err = Convert(res, op)
 	if err != nil {
 		return err
 	}
-}
 
+	err = computeOperationWaitTime(
+		config, op, project, "Updating {{.Resource}}",
+		d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return err
+	}
+{{else if .Operation}}
+	// TODO: Wait for the operation in res to complete with the operation
+	// waiter of the API, see OperationWait.
+{{end}}
+	log.Printf("[DEBUG] Finished updating {{.Resource}} %q: %#v", d.Id(), res)
+
+	return resource{{.TypeName}}Read(d, meta)
+}
+{{end}}
 func resource{{.TypeName}}Delete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
+{{template "project" .}}
+	url, err := replaceVars(d, config, "{{.DeleteUrl}}")
+	if err != nil {
+		return err
+	}
 
+	log.Printf("[DEBUG] Deleting {{.Resource}} %q", d.Id())
+	res, err := Delete(config, url, project)
+	if err != nil {
+		return handleNotFoundError(err, d, "{{.Resource}}")
+	}
+{{if .ComputeOperation}}
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	err = computeOperationWaitTime(
+		config, op, project, "Deleting {{.Resource}}",
+		d.Timeout(schema.TimeoutDelete))
+
+	if err != nil {
+		return err
+	}
+{{else if .Operation}}
+	// TODO: Wait for the operation in res to complete with the operation
+	// waiter of the API, see OperationWait.
+{{end}}
+	log.Printf("[DEBUG] Finished deleting {{.Resource}} %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resource{{.TypeName}}Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{ {{- range $i, $f := .ImportFormats}}{{if $i}}, {{end}}"{{$f}}"{{end -}} }, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{.IdFormat}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+{{range .Properties}}{{template "flatten" .}}{{end}}
+{{- range .Properties}}{{if not .Output}}{{template "expand" .}}{{end}}{{end}}
+{{- define "project"}}{{if .HasProject}}
 	project, err := getProject(d, config)
 	if err != nil {
 		return err
 	}
+{{else}}
+	project := ""
+{{end}}{{end}}
+{{- define "flatten"}}
+func flatten{{.FuncName}}(v interface{}) interface{} {
+{{- if .NameFormat}}
+	return NameFromSelfLinkStateFunc(v)
+{{- else if .Int}}
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+{{- else if .Object}}
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	transformed := make(map[string]interface{})
+{{- range .Properties}}
+	transformed["{{.Name}}"] =
+		flatten{{.FuncName}}(original["{{.ApiName}}"])
+{{- end}}
+	return []interface{}{transformed}
+{{- else if .ObjectList}}
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		transformed = append(transformed, map[string]interface{}{
+{{- range .Properties}}
+			"{{.Name}}": flatten{{.FuncName}}(original["{{.ApiName}}"]),
+{{- end}}
+		})
+	}
+	return transformed
+{{- else}}
+	return v
+{{- end}}
+}
+{{range .Properties}}{{template "flatten" .}}{{end}}
+{{- end}}
+{{- define "expand"}}
+func expand{{.FuncName}}(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+{{- if .NameFormat}}
+	return replaceVars(d, config, "{{.NameFormat}}")
+{{- else if .Map}}
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+{{- else if .Object}}
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	original := l[0].(map[string]interface{})
+	transformed := make(map[string]interface{})
+{{range .Properties}}{{if not .Output}}
+	transformed{{title .ApiName}}, err := expand{{.FuncName}}(original["{{.Name}}"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	transformed["{{.ApiName}}"] = transformed{{title .ApiName}}
+{{end}}{{end}}
+	return transformed, nil
+{{- else if .ObjectList}}
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+{{range .Properties}}{{if not .Output}}
+		transformed{{title .ApiName}}, err := expand{{.FuncName}}(original["{{.Name}}"], d, config)
+		if err != nil {
+			return nil, err
+		}
+		transformed["{{.ApiName}}"] = transformed{{title .ApiName}}
+{{end}}{{end}}
+		req = append(req, transformed)
+	}
+	return req, nil
+{{- else}}
+	return v, nil
+{{- end}}
+}
+{{range .Properties}}{{if not .Output}}{{template "expand" .}}{{end}}{{end}}
+{{- end}}`))
+
+var testTemplate = template.Must(template.New("test").Parse(`package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAcc{{.TypeName}}_basic(t *testing.T) {
+	t.Parallel()
+
+	name := fmt.Sprintf("tf-test-%s", randString(t, 10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheck{{.TypeName}}Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAcc{{.TypeName}}_basic(name),
+			},
+			resource.TestStep{
+				ResourceName:      "{{.TerraformName}}.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheck{{.TypeName}}Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "{{.TerraformName}}" {
+			continue
+		}
+
+		url, err := replaceVars(resource{{.TypeName}}().Data(rs.Primary), config, "{{.ReadUrl}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = Get(config, url, rs.Primary.Attributes["project"])
+		if err == nil {
+			return fmt.Errorf("{{.Resource}} %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAcc{{.TypeName}}_basic(name string) string {
+	return fmt.Sprintf(` + "`" + `
+resource "{{.TerraformName}}" "default" {
+  name = "%s"
+{{- if .TestArguments}}
+
+  # TODO: Set the other required arguments.
+{{- range .TestArguments}}
+  # {{.}} = ""
+{{- end}}
+{{- end}}
+}
+` + "`" + `, name)
 }
 `))
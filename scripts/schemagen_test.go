@@ -1,8 +1,13 @@
 package main
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
+	"text/template"
 
 	"google.golang.org/api/discovery/v1"
 )
@@ -199,3 +204,287 @@ func TestUnderscore(t *testing.T) {
 		}
 	}
 }
+
+// A discovery document of a Compute-like API, whose resources are
+// identified by the parameters of their URL and updated with PATCH.
+const testComputeDiscovery = `{
+  "name": "compute",
+  "version": "v1",
+  "servicePath": "compute/v1/projects/",
+  "schemas": {
+    "Operation": {"id": "Operation", "type": "object"},
+    "Widget": {
+      "id": "Widget",
+      "type": "object",
+      "properties": {
+        "name": {"type": "string", "annotations": {"required": ["compute.widgets.insert"]}},
+        "description": {"type": "string"},
+        "sizeGb": {"type": "string", "format": "int64"},
+        "count": {"type": "integer"},
+        "selfLink": {"type": "string", "description": "[Output Only] Server-defined URL."}
+      }
+    }
+  },
+  "resources": {
+    "widgets": {
+      "methods": {
+        "get": {
+          "httpMethod": "GET",
+          "path": "{project}/regions/{region}/widgets/{widget}",
+          "parameterOrder": ["project", "region", "widget"],
+          "parameters": {
+            "project": {"type": "string", "location": "path", "required": true},
+            "region": {"type": "string", "location": "path", "required": true},
+            "widget": {"type": "string", "location": "path", "required": true}
+          },
+          "response": {"$ref": "Widget"}
+        },
+        "insert": {
+          "httpMethod": "POST",
+          "path": "{project}/regions/{region}/widgets",
+          "parameterOrder": ["project", "region"],
+          "request": {"$ref": "Widget"},
+          "response": {"$ref": "Operation"}
+        },
+        "patch": {
+          "httpMethod": "PATCH",
+          "path": "{project}/regions/{region}/widgets/{widget}",
+          "parameterOrder": ["project", "region", "widget"],
+          "request": {"$ref": "Widget"},
+          "response": {"$ref": "Operation"}
+        },
+        "delete": {
+          "httpMethod": "DELETE",
+          "path": "{project}/regions/{region}/widgets/{widget}",
+          "parameterOrder": ["project", "region", "widget"],
+          "response": {"$ref": "Operation"}
+        }
+      }
+    }
+  }
+}`
+
+// A discovery document of an API whose resources are identified by their
+// full resource name, and created with their id as a query parameter.
+const testNamedDiscovery = `{
+  "name": "gadgets",
+  "version": "v1beta1",
+  "servicePath": "",
+  "schemas": {
+    "Operation": {"id": "Operation", "type": "object"},
+    "Gadget": {
+      "id": "Gadget",
+      "type": "object",
+      "properties": {
+        "name": {"type": "string", "description": "Required. The resource name of the gadget."},
+        "labels": {"type": "object", "additionalProperties": {"type": "string"}},
+        "config": {"$ref": "GadgetConfig"},
+        "parts": {"type": "array", "items": {"$ref": "GadgetConfig"}},
+        "state": {"type": "string", "description": "Output-only. The state of the gadget."}
+      }
+    },
+    "GadgetConfig": {
+      "id": "GadgetConfig",
+      "type": "object",
+      "properties": {
+        "enabled": {"type": "boolean"},
+        "size": {"type": "integer"}
+      }
+    }
+  },
+  "resources": {
+    "projects": {
+      "resources": {
+        "locations": {
+          "resources": {
+            "gadgets": {
+              "methods": {
+                "get": {
+                  "httpMethod": "GET",
+                  "path": "v1beta1/{+name}",
+                  "parameterOrder": ["name"],
+                  "parameters": {
+                    "name": {"type": "string", "location": "path", "required": true, "pattern": "^projects/[^/]+/locations/[^/]+/gadgets/[^/]+$"}
+                  },
+                  "response": {"$ref": "Gadget"}
+                },
+                "create": {
+                  "httpMethod": "POST",
+                  "path": "v1beta1/{+parent}/gadgets",
+                  "parameterOrder": ["parent"],
+                  "parameters": {
+                    "parent": {"type": "string", "location": "path", "required": true, "pattern": "^projects/[^/]+/locations/[^/]+$"},
+                    "gadgetId": {"type": "string", "location": "query", "required": true}
+                  },
+                  "request": {"$ref": "Gadget"},
+                  "response": {"$ref": "Operation"}
+                },
+                "delete": {
+                  "httpMethod": "DELETE",
+                  "path": "v1beta1/{+name}",
+                  "parameterOrder": ["name"],
+                  "parameters": {
+                    "name": {"type": "string", "location": "path", "required": true, "pattern": "^projects/[^/]+/locations/[^/]+/gadgets/[^/]+$"}
+                  },
+                  "response": {"$ref": "Operation"}
+                }
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+func testResourceData(t *testing.T, path, doc, resource string) *resourceData {
+	dir, err := ioutil.TempDir("", "schemagen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path = filepath.Join(dir, path)
+	if err := ioutil.WriteFile(path, []byte(doc), 0644); err != nil {
+		t.Fatal(err)
+	}
+	d, err := readDiscovery(path, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := newResourceData(d, d.Name, resource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestNewResourceData_compute(t *testing.T) {
+	r := testResourceData(t, "compute.json", testComputeDiscovery, "Widget")
+
+	urls := map[string]string{
+		r.CreateUrl: "{{ComputeBasePath}}projects/{{project}}/regions/{{region}}/widgets",
+		r.ReadUrl:   "{{ComputeBasePath}}projects/{{project}}/regions/{{region}}/widgets/{{name}}",
+		r.UpdateUrl: "{{ComputeBasePath}}projects/{{project}}/regions/{{region}}/widgets/{{name}}",
+		r.DeleteUrl: "{{ComputeBasePath}}projects/{{project}}/regions/{{region}}/widgets/{{name}}",
+	}
+	for url, expected := range urls {
+		if url != expected {
+			t.Errorf("Expected URL %q, got %q", expected, url)
+		}
+	}
+	if !r.Updatable || r.UpdateVerb != "PATCH" || r.UpdateMask {
+		t.Errorf("Expected the resource to be updatable with PATCH without update mask, got %+v", r)
+	}
+	if !r.ComputeOperation {
+		t.Errorf("Expected the resource to wait on Compute operations")
+	}
+
+	expectedFormats := []string{
+		"projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/widgets/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)",
+		"(?P<region>[^/]+)/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	}
+	if !reflect.DeepEqual(r.ImportFormats, expectedFormats) {
+		t.Errorf("Expected import formats %v, got %v", expectedFormats, r.ImportFormats)
+	}
+	if r.IdFormat != "{{project}}/{{region}}/{{name}}" {
+		t.Errorf("Expected id format %q, got %q", "{{project}}/{{region}}/{{name}}", r.IdFormat)
+	}
+
+	// The name identifies the resource, so it can't be updated unlike the
+	// other fields.
+	expectedSchemas := map[string]string{
+		"name":        "{\nType: schema.TypeString,\nRequired: true,\nForceNew: true,\n}",
+		"description": "{\nType: schema.TypeString,\nOptional: true,\n}",
+		"size_gb":     "{\nType: schema.TypeInt,\nOptional: true,\n}",
+		"region":      "{\nType: schema.TypeString,\nOptional: true,\nComputed: true,\nForceNew: true,\n}",
+		"self_link":   "{\nType: schema.TypeString,\nDescription: \"[Output Only] Server-defined URL.\",\nComputed: true,\n}",
+	}
+	fields := make(map[string]string)
+	for _, m := range []map[string]string{r.ReqFields, r.OptFields, r.ComFields} {
+		for k, v := range m {
+			fields[k] = v
+		}
+	}
+	for name, expected := range expectedSchemas {
+		if fields[name] != expected {
+			t.Errorf("Expected the schema of %s to be %q, got %q", name, expected, fields[name])
+		}
+	}
+	for _, p := range r.Properties {
+		if expected := p.Name == "count" || p.Name == "description" || p.Name == "size_gb"; p.Updatable != expected {
+			t.Errorf("Expected %s to be updatable: %t", p.Name, expected)
+		}
+	}
+}
+
+func TestNewResourceData_fullResourceName(t *testing.T) {
+	r := testResourceData(t, "gadgets.json", testNamedDiscovery, "Gadget")
+
+	if expected := "{{GadgetsBasePath}}projects/{{project}}/locations/{{location}}/gadgets?gadgetId={{name}}"; r.CreateUrl != expected {
+		t.Errorf("Expected create URL %q, got %q", expected, r.CreateUrl)
+	}
+	if expected := "{{GadgetsBasePath}}projects/{{project}}/locations/{{location}}/gadgets/{{name}}"; r.ReadUrl != expected {
+		t.Errorf("Expected read URL %q, got %q", expected, r.ReadUrl)
+	}
+	if r.Updatable {
+		t.Errorf("Expected the resource not to be updatable")
+	}
+	if !reflect.DeepEqual(r.TestArguments, []string{"location"}) {
+		t.Errorf("Expected location to be the only other required argument, got %v", r.TestArguments)
+	}
+
+	expectedFormats := []string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/gadgets/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	}
+	if !reflect.DeepEqual(r.ImportFormats, expectedFormats) {
+		t.Errorf("Expected import formats %v, got %v", expectedFormats, r.ImportFormats)
+	}
+
+	for _, p := range r.Properties {
+		if p.Name == "name" && p.NameFormat != "projects/{{project}}/locations/{{location}}/gadgets/{{name}}" {
+			t.Errorf("Expected the name to be sent as the full resource name, got %q", p.NameFormat)
+		}
+	}
+}
+
+func TestGenerateSource(t *testing.T) {
+	for _, c := range []struct {
+		path, doc, resource string
+		expected            []string
+	}{
+		{"compute.json", testComputeDiscovery, "Widget", []string{
+			"Update: resourceComputeWidgetUpdate,",
+			"res, err := Patch(config, url, project, obj, nil)",
+			"computeOperationWaitTime(",
+			`parseImportId([]string{"projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/widgets/(?P<name>[^/]+)", `,
+			"func flattenComputeWidgetSizeGb(v interface{}) interface{} {\n\t// Handles the string fixed64 format",
+		}},
+		{"gadgets.json", testNamedDiscovery, "Gadget", []string{
+			"func flattenGadgetsGadgetConfigEnabled(v interface{}) interface{} {",
+			"func expandGadgetsGadgetParts(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {",
+			`return replaceVars(d, config, "projects/{{project}}/locations/{{location}}/gadgets/{{name}}")`,
+			"return NameFromSelfLinkStateFunc(v)",
+		}},
+	} {
+		r := testResourceData(t, c.path, c.doc, c.resource)
+		for _, tmpl := range []*template.Template{googleTemplate, testTemplate} {
+			src, err := generateSource(tmpl, r)
+			if err != nil {
+				t.Fatalf("Expected the %s of %s to be valid Go, got %s:\n%s", tmpl.Name(), c.resource, err, src)
+			}
+			if tmpl != googleTemplate {
+				continue
+			}
+			for _, expected := range c.expected {
+				if !strings.Contains(string(src), expected) {
+					t.Errorf("Expected the %s resource to contain %q, got:\n%s", c.resource, expected, src)
+				}
+			}
+		}
+	}
+}